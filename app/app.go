@@ -8,7 +8,6 @@ import (
 	"path/filepath"
 
 	"github.com/gorilla/mux"
-	zmq "github.com/pebbe/zmq4"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
 
@@ -94,6 +93,10 @@ import (
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	"github.com/terra-money/core/app/feed"
+	"github.com/terra-money/core/app/feed/addressbook"
+	"github.com/terra-money/core/app/feed/decoder"
+	"github.com/terra-money/core/app/feed/filewatch"
 	terraappparams "github.com/terra-money/core/app/params"
 
 	customauth "github.com/terra-money/core/custom/auth"
@@ -260,14 +263,31 @@ type TerraApp struct { // nolint: golint
 
 	// the configurator
 	configurator module.Configurator
-	pubZmq       *zmq.Socket
 
-	walletMap   map[string]string
-	mirrorToken map[string]map[string]string
-	mirrorPair  map[string]map[string]string
+	// the ante handler, run by ReplayBlock the way CheckTx does
+	anteHandler sdk.AnteHandler
 
-	terraToken map[string]map[string]string
-	terraPair  map[string]map[string]string
+	// the home directory the feed paths are resolved against
+	homePath string
+
+	// the sink the mempool/state feed is published through, its config,
+	// the worker publishing the block level updates and their state
+	feedSink   feed.EventSink
+	feedConfig *feed.Config
+	feedWorker *feed.Worker
+	feedBlock  *feedBlockState
+
+	// the admin server, the config file watchers and the SIGHUP channel
+	// of the started feed, see StartFeed
+	feedAdmin    *feed.AdminServer
+	feedWatchers []*filewatch.Watcher
+	feedSignals  chan os.Signal
+
+	// the decoders turning contract executes into swap intents
+	decoders *decoder.Registry
+
+	// the wallets and contracts the feed tracks
+	addressBook *addressbook.Book
 }
 
 func init() {
@@ -302,9 +322,12 @@ func NewTerraApp(
 		oracletypes.StoreKey, markettypes.StoreKey, treasurytypes.StoreKey,
 		wasmtypes.StoreKey, authzkeeper.StoreKey, feegrant.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, markettypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
+	// the feed config files are read and the sink is created by StartFeed
+	feedConfig := feed.GetConfig(appOpts)
+
 	var app = &TerraApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -314,12 +337,12 @@ func NewTerraApp(
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
-		pubZmq:            NewPubZmq(),
-		walletMap:         LoadWallet(),
-		mirrorToken:       LoadConfig("mirrorToken.json"),
-		mirrorPair:        LoadConfig("mirrorPair.json"),
-		terraToken:        LoadConfig("terraToken.json"),
-		terraPair:         LoadConfig("terraPair.json"),
+		homePath:          homePath,
+		feedSink:          feed.NopSink{},
+		feedConfig:        feedConfig,
+		feedBlock:         newFeedBlockState(),
+		decoders:          decoder.NewFileRegistry(feed.ResolvePath(homePath, feedConfig.DecodersPath)),
+		addressBook:       addressbook.New(AddressBookDir(homePath, feedConfig)),
 	}
 
 	// init params keeper and subspaces
@@ -403,7 +426,7 @@ func NewTerraApp(
 		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, &stakingKeeper, distrtypes.ModuleName,
 	)
 	app.MarketKeeper = marketkeeper.NewKeeper(
-		appCodec, keys[markettypes.StoreKey],
+		appCodec, keys[markettypes.StoreKey], tkeys[markettypes.TStoreKey],
 		app.GetSubspace(markettypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.OracleKeeper,
 	)
@@ -445,7 +468,7 @@ func NewTerraApp(
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, oracle.NewParamChangeProposalHandler(app.OracleKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
@@ -526,6 +549,7 @@ func NewTerraApp(
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
+	app.registerUpgradeHandlers()
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
@@ -582,6 +606,7 @@ func NewTerraApp(
 	}
 
 	app.SetAnteHandler(anteHandler)
+	app.anteHandler = anteHandler
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -605,12 +630,8 @@ func (app *TerraApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) a
 
 // EndBlocker application updates every end block
 func (app *TerraApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	app.SendMirrorBalances(ctx)
-	app.SendTerraBalances(ctx)
-	app.SendTerraAccount(ctx)
-	app.SendPools(ctx, "mirror")
-	app.SendPools(ctx, "terra")
-	app.SendAncRate(ctx)
+	// the feed is published from the committed state, see Commit
+	app.feedBlock.header = ctx.BlockHeader()
 	return app.mm.EndBlock(ctx, req)
 }
 
@@ -621,6 +642,7 @@ func (app *TerraApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abc
 		panic(err)
 	}
 
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
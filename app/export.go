@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	markettypes "github.com/terra-money/core/x/market/types"
 	oracletypes "github.com/terra-money/core/x/oracle/types"
 )
 
@@ -220,4 +221,24 @@ func (app *TerraApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 
 	// clear all market pools
 	app.MarketKeeper.SetTerraPoolDelta(ctx, sdk.ZeroDec())
+
+	var denomPools []string
+	app.MarketKeeper.IterateDenomPoolDeltas(ctx, func(denom string, _ sdk.Dec) bool {
+		denomPools = append(denomPools, denom)
+		return false
+	})
+
+	for _, denom := range denomPools {
+		app.MarketKeeper.DeleteDenomPoolDelta(ctx, denom)
+	}
+
+	var swapVolumes []markettypes.SwapVolume
+	app.MarketKeeper.IterateSwapVolumes(ctx, func(volume markettypes.SwapVolume) bool {
+		swapVolumes = append(swapVolumes, volume)
+		return false
+	})
+
+	for _, volume := range swapVolumes {
+		app.MarketKeeper.DeleteSwapVolume(ctx, volume.DenomA, volume.DenomB)
+	}
 }
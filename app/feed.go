@@ -0,0 +1,208 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/terra-money/core/app/feed"
+	feedtypes "github.com/terra-money/core/app/feed/types"
+)
+
+// SetFeedSink replaces the sink the feed is published through,
+// closing the previous one
+func (app *TerraApp) SetFeedSink(sink feed.EventSink) {
+	if app.feedSink != nil {
+		app.feedSink.Close()
+	}
+
+	app.feedSink = sink
+}
+
+// SendFeedMessage wraps the message into a versioned frame and
+// publishes it on the topic through the configured sink
+func (app *TerraApp) SendFeedMessage(topic string, msg proto.Message) {
+	app.SendRoutedFeedMessage(topic, feedtypes.Routing{}, msg)
+}
+
+// SendRoutedFeedMessage publishes the message like SendFeedMessage,
+// attaching the routing keys subscribers filter the message by
+func (app *TerraApp) SendRoutedFeedMessage(topic string, routing feedtypes.Routing, msg proto.Message) {
+	frame, err := feedtypes.NewFrame(topic, msg)
+	if err != nil {
+		app.Logger().Error("failed to encode feed message", "topic", topic, "err", err)
+		return
+	}
+	frame.Routing = routing
+
+	bz, err := frame.Marshal()
+	if err != nil {
+		app.Logger().Error("failed to encode feed frame", "topic", topic, "err", err)
+		return
+	}
+
+	if err := app.feedSink.Send(topic, bz); err != nil {
+		app.Logger().Error("failed to send feed message", "topic", topic, "err", err)
+	}
+}
+
+// ReloadFeedConfig reloads the decoder registry and the address book from their files,
+// the current decoders or address book are kept when a file is invalid
+func (app *TerraApp) ReloadFeedConfig() error {
+	if err := app.decoders.Reload(); err != nil {
+		return err
+	}
+
+	app.Logger().Info("reloaded feed decoders", "decoders", app.decoders.Len())
+	return app.reloadAddressBook()
+}
+
+func (app *TerraApp) reloadAddressBook() error {
+	if err := app.addressBook.Reload(); err != nil {
+		return err
+	}
+
+	app.Logger().Info("reloaded address book", "dir", app.addressBook.Dir())
+	return app.setDefaultDecoders()
+}
+
+// StartFeed reads the feed config files and starts the configured sink, the worker
+// publishing the block level updates, the admin server and the reload of the config
+// files whenever they change and on SIGHUP. It is only called for the node run by
+// the start command, the other commands building the app publish nothing and bind
+// no listener.
+func (app *TerraApp) StartFeed() error {
+	if err := app.ReloadFeedConfig(); err != nil {
+		return err
+	}
+
+	sink, err := feed.NewSink(app.feedConfig, app.homePath)
+	if err != nil {
+		return err
+	}
+
+	app.SetFeedSink(feed.NewAsyncSink(sink, app.feedConfig.QueueSize, app.Logger()))
+	app.feedWorker = feed.NewWorker(app.feedConfig.BlockQueueSize, app.feedConfig.BlockTimeout, app.Logger())
+
+	if app.feedConfig.AdminAddress != "" {
+		app.feedAdmin, err = feed.NewAdminServer(app.feedConfig.AdminAddress, feedAdminServer{app})
+		if err != nil {
+			app.StopFeed()
+			return fmt.Errorf("failed to start feed admin server on %s: %w", app.feedConfig.AdminAddress, err)
+		}
+	}
+
+	if watcher, err := app.decoders.Watch(func(err error) {
+		if err != nil {
+			app.Logger().Error("failed to reload feed decoders", "err", err)
+			return
+		}
+
+		app.Logger().Info("reloaded feed decoders", "decoders", app.decoders.Len())
+	}); err != nil {
+		app.Logger().Error("failed to watch feed decoders, reload with SIGHUP", "path", app.feedConfig.DecodersPath, "err", err)
+	} else {
+		app.feedWatchers = append(app.feedWatchers, watcher)
+	}
+
+	if watcher, err := app.addressBook.Watch(func(err error) {
+		if err != nil {
+			app.Logger().Error("failed to reload address book", "err", err)
+			return
+		}
+
+		app.Logger().Info("reloaded address book", "dir", app.addressBook.Dir())
+		if err := app.setDefaultDecoders(); err != nil {
+			app.Logger().Error("failed to set the default feed decoders", "err", err)
+		}
+	}); err != nil {
+		app.Logger().Error("failed to watch address book, reload with SIGHUP", "dir", app.addressBook.Dir(), "err", err)
+	} else {
+		app.feedWatchers = append(app.feedWatchers, watcher)
+	}
+
+	app.feedSignals = make(chan os.Signal, 1)
+	signal.Notify(app.feedSignals, syscall.SIGHUP)
+	go app.reloadFeedConfigOnSignal(app.feedSignals)
+
+	return nil
+}
+
+// StopFeed stops what StartFeed started, the queued block updates
+// and messages are published before the sink is closed
+func (app *TerraApp) StopFeed() {
+	if app.feedSignals != nil {
+		signal.Stop(app.feedSignals)
+		close(app.feedSignals)
+		app.feedSignals = nil
+	}
+
+	for _, watcher := range app.feedWatchers {
+		watcher.Close()
+	}
+	app.feedWatchers = nil
+
+	if app.feedAdmin != nil {
+		app.feedAdmin.Close()
+		app.feedAdmin = nil
+	}
+
+	if app.feedWorker != nil {
+		app.feedWorker.Stop()
+		app.feedWorker = nil
+	}
+
+	app.SetFeedSink(feed.NopSink{})
+}
+
+// reloadFeedConfigOnSignal reloads the feed config every time SIGHUP is received on sigs
+func (app *TerraApp) reloadFeedConfigOnSignal(sigs <-chan os.Signal) {
+	for range sigs {
+		if err := app.ReloadFeedConfig(); err != nil {
+			app.Logger().Error("failed to reload feed config", "err", err)
+		}
+	}
+}
+
+var _ feedtypes.AdminServer = feedAdminServer{}
+
+// feedAdminServer implements the feed Admin service
+type feedAdminServer struct {
+	app *TerraApp
+}
+
+// AddressBook implements feedtypes.AdminServer
+func (s feedAdminServer) AddressBook(context.Context, *feedtypes.QueryAddressBookRequest) (*feedtypes.QueryAddressBookResponse, error) {
+	snapshot := s.app.addressBook.Snapshot()
+
+	res := &feedtypes.QueryAddressBookResponse{
+		Dir:      snapshot.Dir,
+		LoadedAt: snapshot.LoadedAt,
+		Wallets:  sortedEntries(snapshot.Wallets),
+	}
+
+	for name, list := range snapshot.Lists {
+		res.Lists = append(res.Lists, feedtypes.AddressList{
+			Name:    name,
+			Entries: sortedEntries(list["normal"]),
+		})
+	}
+	sort.Slice(res.Lists, func(i, j int) bool { return res.Lists[i].Name < res.Lists[j].Name })
+
+	return res, nil
+}
+
+func sortedEntries(addresses map[string]string) []feedtypes.AddressEntry {
+	entries := make([]feedtypes.AddressEntry, 0, len(addresses))
+	for name, address := range addresses {
+		entries = append(entries, feedtypes.AddressEntry{Name: name, Address: address})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+
+	return entries
+}
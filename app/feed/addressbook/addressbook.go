@@ -0,0 +1,233 @@
+package addressbook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WalletFile is the file of the address book holding the wallets
+const WalletFile = "wallet.json"
+
+// Lists maps every address list of the address book to its file
+var Lists = map[string]string{
+	"mirrorToken": "mirrorToken.json",
+	"mirrorPair":  "mirrorPair.json",
+	"terraToken":  "terraToken.json",
+	"terraPair":   "terraPair.json",
+}
+
+// Keys of the address list maps
+const (
+	KeyNormal  = "normal"
+	KeyReverse = "reverse"
+)
+
+// Contract is an entry of a list file
+type Contract struct {
+	AssetName string `json:"assetName"`
+	Address   string `json:"address"`
+}
+
+// Contracts is the layout of a list file
+type Contracts struct {
+	Contracts []Contract `json:"contracts"`
+}
+
+// Wallet is an entry of the wallet file
+type Wallet struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// Wallets is the layout of the wallet file
+type Wallets struct {
+	Addresses []Wallet `json:"wallets"`
+}
+
+// Snapshot is an immutable, validated version of the address book
+type Snapshot struct {
+	Dir      string
+	LoadedAt time.Time
+
+	// Wallets maps the wallet names to their addresses
+	Wallets map[string]string
+
+	// Lists maps the list names to their "normal" (name to address)
+	// and "reverse" (address to name) maps
+	Lists map[string]map[string]map[string]string
+}
+
+// List returns the address map of the list, a missing list is empty
+func (s *Snapshot) List(name string) map[string]map[string]string {
+	if list, ok := s.Lists[name]; ok {
+		return list
+	}
+
+	return map[string]map[string]string{
+		KeyNormal:  {},
+		KeyReverse: {},
+	}
+}
+
+// LoadSnapshot reads and validates the address book in dir;
+// missing files result in empty wallets or lists
+func LoadSnapshot(dir string) (*Snapshot, error) {
+	snapshot := &Snapshot{
+		Dir:      dir,
+		LoadedAt: time.Now().UTC(),
+		Wallets:  make(map[string]string),
+		Lists:    make(map[string]map[string]map[string]string),
+	}
+
+	var wallets Wallets
+	if err := readFile(filepath.Join(dir, WalletFile), &wallets); err != nil {
+		return nil, err
+	}
+
+	for _, wallet := range wallets.Addresses {
+		if wallet.Name == "" {
+			return nil, fmt.Errorf("%s: empty wallet name", WalletFile)
+		}
+
+		if _, ok := snapshot.Wallets[wallet.Name]; ok {
+			return nil, fmt.Errorf("%s: duplicate wallet %s", WalletFile, wallet.Name)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(wallet.Address); err != nil {
+			return nil, fmt.Errorf("%s: invalid address of wallet %s: %w", WalletFile, wallet.Name, err)
+		}
+
+		snapshot.Wallets[wallet.Name] = wallet.Address
+	}
+
+	for name, fileName := range Lists {
+		var contracts Contracts
+		if err := readFile(filepath.Join(dir, fileName), &contracts); err != nil {
+			return nil, err
+		}
+
+		normalMap := make(map[string]string)
+		reverseMap := make(map[string]string)
+		for _, contract := range contracts.Contracts {
+			if contract.AssetName == "" {
+				return nil, fmt.Errorf("%s: empty asset name", fileName)
+			}
+
+			if _, ok := normalMap[contract.AssetName]; ok {
+				return nil, fmt.Errorf("%s: duplicate asset %s", fileName, contract.AssetName)
+			}
+
+			if _, ok := reverseMap[contract.Address]; ok {
+				return nil, fmt.Errorf("%s: duplicate address %s", fileName, contract.Address)
+			}
+
+			if err := validateAddress(contract.Address); err != nil {
+				return nil, fmt.Errorf("%s: invalid address of %s: %w", fileName, contract.AssetName, err)
+			}
+
+			normalMap[contract.AssetName] = contract.Address
+			reverseMap[contract.Address] = contract.AssetName
+		}
+
+		snapshot.Lists[name] = map[string]map[string]string{
+			KeyNormal:  normalMap,
+			KeyReverse: reverseMap,
+		}
+	}
+
+	return snapshot, nil
+}
+
+// validateAddress accepts bech32 account addresses and,
+// for the native assets of the token lists, coin denoms
+func validateAddress(address string) error {
+	if strings.HasPrefix(address, sdk.GetConfig().GetBech32AccountAddrPrefix()+"1") {
+		_, err := sdk.AccAddressFromBech32(address)
+		return err
+	}
+
+	return sdk.ValidateDenom(address)
+}
+
+func readFile(path string, v interface{}) error {
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(bz, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Book holds the current snapshot of the address book in a directory,
+// it is safe for concurrent use and can be reloaded at runtime
+type Book struct {
+	dir string
+
+	mtx      sync.RWMutex
+	snapshot *Snapshot
+}
+
+// New creates an empty book of the address book in dir,
+// the address book is read by Reload
+func New(dir string) *Book {
+	return &Book{
+		dir: dir,
+		snapshot: &Snapshot{
+			Dir:     dir,
+			Wallets: make(map[string]string),
+			Lists:   make(map[string]map[string]map[string]string),
+		},
+	}
+}
+
+// Load creates a book from the address book in dir
+func Load(dir string) (*Book, error) {
+	book := New(dir)
+	if err := book.Reload(); err != nil {
+		return nil, err
+	}
+
+	return book, nil
+}
+
+// Dir returns the directory the address book is loaded from
+func (b *Book) Dir() string {
+	return b.dir
+}
+
+// Snapshot returns the current snapshot, which must not be modified
+func (b *Book) Snapshot() *Snapshot {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	return b.snapshot
+}
+
+// Reload reads the address book again and atomically swaps the snapshot;
+// the current snapshot is kept when the address book is invalid
+func (b *Book) Reload() error {
+	snapshot, err := LoadSnapshot(b.dir)
+	if err != nil {
+		return err
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.snapshot = snapshot
+	return nil
+}
@@ -0,0 +1,112 @@
+package addressbook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newAddress() string {
+	return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+}
+
+func writeJSON(t *testing.T, path string, v interface{}) {
+	bz, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, bz, 0600))
+}
+
+func TestLoadSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	wallet := newAddress()
+	aust := newAddress()
+
+	writeJSON(t, filepath.Join(dir, WalletFile), Wallets{Addresses: []Wallet{{Name: "terraWallet", Address: wallet}}})
+	writeJSON(t, filepath.Join(dir, Lists["terraToken"]), Contracts{Contracts: []Contract{
+		{AssetName: "UST", Address: "uusd"},
+		{AssetName: "AUST", Address: aust},
+	}})
+
+	snapshot, err := LoadSnapshot(dir)
+	require.NoError(t, err)
+	require.Equal(t, dir, snapshot.Dir)
+	require.Equal(t, map[string]string{"terraWallet": wallet}, snapshot.Wallets)
+	require.Equal(t, aust, snapshot.List("terraToken")[KeyNormal]["AUST"])
+	require.Equal(t, "UST", snapshot.List("terraToken")[KeyReverse]["uusd"])
+
+	// missing files result in empty lists
+	require.Empty(t, snapshot.List("mirrorPair")[KeyNormal])
+	require.Empty(t, snapshot.List("unknown")[KeyReverse])
+}
+
+func TestLoadSnapshotInvalid(t *testing.T) {
+	prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	for name, contracts := range map[string][]Contract{
+		"bad checksum":    {{AssetName: "AUST", Address: prefix + "1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq"}},
+		"bad denom":       {{AssetName: "UST", Address: "1usd"}},
+		"empty name":      {{AssetName: "", Address: "uusd"}},
+		"duplicate asset": {{AssetName: "UST", Address: "uusd"}, {AssetName: "UST", Address: "uluna"}},
+	} {
+		dir := t.TempDir()
+		writeJSON(t, filepath.Join(dir, Lists["terraToken"]), Contracts{Contracts: contracts})
+
+		_, err := LoadSnapshot(dir)
+		require.Error(t, err, name)
+	}
+
+	dir := t.TempDir()
+	writeJSON(t, filepath.Join(dir, WalletFile), Wallets{Addresses: []Wallet{{Name: "terraWallet", Address: "uusd"}}})
+	_, err := LoadSnapshot(dir)
+	require.Error(t, err)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, WalletFile), []byte("{"), 0600))
+	_, err = LoadSnapshot(dir)
+	require.Error(t, err)
+}
+
+func TestBookReload(t *testing.T) {
+	dir := t.TempDir()
+	book, err := Load(dir)
+	require.NoError(t, err)
+	require.Empty(t, book.Snapshot().Wallets)
+
+	wallet := newAddress()
+	writeJSON(t, filepath.Join(dir, WalletFile), Wallets{Addresses: []Wallet{{Name: "terraWallet", Address: wallet}}})
+	require.NoError(t, book.Reload())
+	require.Equal(t, wallet, book.Snapshot().Wallets["terraWallet"])
+
+	// invalid address books keep the current snapshot
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, WalletFile), []byte("{"), 0600))
+	require.Error(t, book.Reload())
+	require.Equal(t, wallet, book.Snapshot().Wallets["terraWallet"])
+}
+
+func TestBookWatch(t *testing.T) {
+	dir := t.TempDir()
+	book, err := Load(dir)
+	require.NoError(t, err)
+
+	reloaded := make(chan error, 16)
+	watcher, err := book.Watch(func(err error) { reloaded <- err })
+	require.NoError(t, err)
+	defer watcher.Close()
+
+	wallet := newAddress()
+	writeJSON(t, filepath.Join(dir, WalletFile), Wallets{Addresses: []Wallet{{Name: "terraWallet", Address: wallet}}})
+
+	select {
+	case err := <-reloaded:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("address book was not reloaded")
+	}
+	require.Equal(t, wallet, book.Snapshot().Wallets["terraWallet"])
+}
@@ -0,0 +1,25 @@
+package addressbook
+
+import (
+	"github.com/terra-money/core/app/feed/filewatch"
+)
+
+// Watch starts watching the directory of the book, onReload is
+// called with the result of every reload triggered by a change
+func (b *Book) Watch(onReload func(error)) (*filewatch.Watcher, error) {
+	return filewatch.Watch(b.dir, isBookFile, func() { onReload(b.Reload()) })
+}
+
+func isBookFile(name string) bool {
+	if name == WalletFile {
+		return true
+	}
+
+	for _, fileName := range Lists {
+		if name == fileName {
+			return true
+		}
+	}
+
+	return false
+}
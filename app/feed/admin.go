@@ -0,0 +1,46 @@
+package feed
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/terra-money/core/app/feed/types"
+)
+
+// AdminServer is the local gRPC server of the feed Admin service
+type AdminServer struct {
+	server   *grpc.Server
+	listener net.Listener
+}
+
+// NewAdminServer starts serving the Admin service on the address
+func NewAdminServer(address string, srv types.AdminServer) (*AdminServer, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpc.NewServer()
+	types.RegisterAdminServer(server, srv)
+	reflection.Register(server)
+
+	go server.Serve(listener) // nolint: errcheck
+
+	return &AdminServer{
+		server:   server,
+		listener: listener,
+	}, nil
+}
+
+// Addr returns the address the server listens on
+func (s *AdminServer) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Close stops the server
+func (s *AdminServer) Close() error {
+	s.server.Stop()
+	return nil
+}
@@ -0,0 +1,100 @@
+package feed
+
+import (
+	"fmt"
+	"sync"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var _ EventSink = &AsyncSink{}
+
+// AsyncSink publishes through the wrapped sink from a background goroutine,
+// so a slow or blocked transport never blocks the caller. Messages are
+// dropped once the bounded queue is full.
+type AsyncSink struct {
+	sink   EventSink
+	logger log.Logger
+
+	mtx    sync.RWMutex
+	queue  chan Message
+	closed bool
+	done   chan struct{}
+}
+
+// NewAsyncSink wraps the sink with a queue of queueSize messages
+func NewAsyncSink(sink EventSink, queueSize int, logger log.Logger) *AsyncSink {
+	s := &AsyncSink{
+		sink:   sink,
+		logger: logger,
+		queue:  make(chan Message, queueSize),
+		done:   make(chan struct{}),
+	}
+
+	go s.run()
+	return s
+}
+
+// Send implements EventSink, it never blocks
+func (s *AsyncSink) Send(topic string, payload []byte) error {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	if s.closed {
+		return fmt.Errorf("feed sink closed")
+	}
+
+	select {
+	case s.queue <- Message{Topic: topic, Payload: payload}:
+		return nil
+	default:
+		telemetry.IncrCounterWithLabels([]string{"feed", "messages", "dropped"}, 1, []metrics.Label{telemetry.NewLabel("topic", topic)})
+		return fmt.Errorf("feed queue full, message dropped")
+	}
+}
+
+// Close implements EventSink, the queued messages are sent before
+// the wrapped sink is closed
+func (s *AsyncSink) Close() error {
+	s.mtx.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mtx.Unlock()
+
+	<-s.done
+	return s.sink.Close()
+}
+
+// Len returns the number of queued messages
+func (s *AsyncSink) Len() int {
+	return len(s.queue)
+}
+
+func (s *AsyncSink) run() {
+	defer close(s.done)
+
+	for msg := range s.queue {
+		s.send(msg)
+	}
+}
+
+func (s *AsyncSink) send(msg Message) {
+	defer func() {
+		if r := recover(); r != nil {
+			telemetry.IncrCounter(1, "feed", "messages", "panics")
+			s.logger.Error("feed sink panicked", "topic", msg.Topic, "err", r)
+		}
+	}()
+
+	if err := s.sink.Send(msg.Topic, msg.Payload); err != nil {
+		telemetry.IncrCounterWithLabels([]string{"feed", "messages", "failed"}, 1, []metrics.Label{telemetry.NewLabel("topic", msg.Topic)})
+		s.logger.Error("failed to send feed message", "topic", msg.Topic, "err", err)
+		return
+	}
+
+	telemetry.IncrCounter(1, "feed", "messages", "sent")
+}
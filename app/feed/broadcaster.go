@@ -0,0 +1,178 @@
+package feed
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"github.com/terra-money/core/app/feed/types"
+)
+
+// ErrSlowConsumer is the reason a subscriber is disconnected
+// when it does not keep up with the feed
+var ErrSlowConsumer = errors.New("slow consumer: too many messages dropped")
+
+// SubscriberConfig is the backpressure policy applied to every subscriber
+type SubscriberConfig struct {
+	// HighWaterMark is the number of messages buffered per subscriber,
+	// messages are dropped for the subscriber once its buffer is full
+	HighWaterMark int
+
+	// SlowConsumerDrops is the number of consecutive messages dropped
+	// after which a subscriber is disconnected, zero never disconnects
+	SlowConsumerDrops uint64
+}
+
+// subscriber is a single consumer attached to a broadcaster
+type subscriber struct {
+	// guarded by the broadcaster
+	filter *Filter
+	err    error
+
+	ch chan Message
+
+	// messages dropped since the last one buffered, and in total
+	pending uint64
+	dropped uint64
+}
+
+// Err returns why the channel of the subscriber was closed,
+// nil when it unsubscribed or the broadcaster closed
+func (s *subscriber) Err() error {
+	return s.err
+}
+
+// Dropped returns the number of messages dropped for the subscriber
+func (s *subscriber) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// broadcaster fans out messages to the subscribers of the streaming sinks;
+// name identifies the sink in the telemetry
+type broadcaster struct {
+	name   string
+	config SubscriberConfig
+
+	mtx         sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+func newBroadcaster(name string, config SubscriberConfig) *broadcaster {
+	return &broadcaster{
+		name:        name,
+		config:      config,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// subscribe registers a new subscriber with the filter
+func (b *broadcaster) subscribe(filter *Filter) *subscriber {
+	sub := &subscriber{
+		filter: filter,
+		ch:     make(chan Message, b.config.HighWaterMark),
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.subscribers[sub] = struct{}{}
+	b.setSubscribersGauge()
+
+	return sub
+}
+
+// setFilter replaces the filter of the subscriber
+func (b *broadcaster) setFilter(sub *subscriber, filter *Filter) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	sub.filter = filter
+}
+
+// unsubscribe removes the subscriber and closes its channel
+func (b *broadcaster) unsubscribe(sub *subscriber) {
+	b.remove(sub, nil)
+}
+
+// remove closes the channel of the subscriber for the reason
+func (b *broadcaster) remove(sub *subscriber, reason error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		sub.err = reason
+		close(sub.ch)
+		b.setSubscribersGauge()
+	}
+}
+
+// broadcast delivers the message to every interested subscriber without blocking,
+// disconnecting the subscribers which dropped too many messages in a row
+func (b *broadcaster) broadcast(msg Message) {
+	var slow []*subscriber
+
+	b.mtx.RLock()
+
+	var (
+		routing types.Routing
+		decoded bool
+	)
+	for sub := range b.subscribers {
+		if sub.filter.needsRouting() && !decoded {
+			routing = messageRouting(msg)
+			decoded = true
+		}
+
+		if !sub.filter.Matches(msg.Topic, routing) {
+			continue
+		}
+
+		// the message reports the drops since the previous one buffered
+		subMsg := msg
+		subMsg.Dropped = atomic.LoadUint64(&sub.pending)
+
+		select {
+		case sub.ch <- subMsg:
+			// subtract the reported drops, keeping those of concurrent broadcasts
+			atomic.AddUint64(&sub.pending, ^(subMsg.Dropped - 1))
+		default:
+			atomic.AddUint64(&sub.dropped, 1)
+			telemetry.IncrCounterWithLabels([]string{"feed", "subscriber", "dropped"}, 1, b.labels(msg.Topic))
+
+			if pending := atomic.AddUint64(&sub.pending, 1); b.config.SlowConsumerDrops != 0 && pending >= b.config.SlowConsumerDrops {
+				slow = append(slow, sub)
+			}
+		}
+	}
+
+	b.mtx.RUnlock()
+
+	for _, sub := range slow {
+		telemetry.IncrCounterWithLabels([]string{"feed", "subscriber", "disconnected"}, 1, []metrics.Label{telemetry.NewLabel("sink", b.name)})
+		b.remove(sub, ErrSlowConsumer)
+	}
+}
+
+// close removes every subscriber
+func (b *broadcaster) close() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for sub := range b.subscribers {
+		delete(b.subscribers, sub)
+		close(sub.ch)
+	}
+	b.setSubscribersGauge()
+}
+
+func (b *broadcaster) labels(topic string) []metrics.Label {
+	return []metrics.Label{telemetry.NewLabel("sink", b.name), telemetry.NewLabel("topic", topic)}
+}
+
+func (b *broadcaster) setSubscribersGauge() {
+	telemetry.SetGaugeWithLabels([]string{"feed", "subscribers"}, float32(len(b.subscribers)), []metrics.Label{telemetry.NewLabel("sink", b.name)})
+}
@@ -0,0 +1,106 @@
+package feed
+
+import (
+	"strings"
+	"sync"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	wasmtypes "github.com/terra-money/core/x/wasm/types"
+)
+
+// Changes holds the contracts and accounts written in a block
+type Changes struct {
+	Contracts map[string]bool
+	Accounts  map[string]bool
+}
+
+// Contract reports whether the storage of the contract was written
+func (c Changes) Contract(address string) bool {
+	return c.Contracts[address]
+}
+
+// Account reports whether the balance of the account was changed
+func (c Changes) Account(address string) bool {
+	return c.Accounts[address]
+}
+
+// ChangeTracker collects the contracts and accounts written in a block
+// from the events of its txs
+type ChangeTracker struct {
+	mtx     sync.Mutex
+	changes Changes
+}
+
+// NewChangeTracker creates an empty tracker
+func NewChangeTracker() *ChangeTracker {
+	t := &ChangeTracker{}
+	t.reset()
+
+	return t
+}
+
+// TrackEvents records the contracts executed through the wasm events and
+// the accounts spending or receiving coins through the bank events
+func (t *ChangeTracker) TrackEvents(events []abci.Event) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	for _, event := range events {
+		switch {
+		case event.Type == wasmtypes.EventTypeWasmPrefix || strings.HasPrefix(event.Type, wasmtypes.EventTypeWasmPrefix+"-"):
+			t.trackAttribute(event, wasmtypes.AttributeKeyContractAddress, t.changes.Contracts)
+		case event.Type == banktypes.EventTypeCoinSpent:
+			t.trackAttribute(event, banktypes.AttributeKeySpender, t.changes.Accounts)
+		case event.Type == banktypes.EventTypeCoinReceived:
+			t.trackAttribute(event, banktypes.AttributeKeyReceiver, t.changes.Accounts)
+		}
+	}
+}
+
+// Flush returns the changes tracked since the last flush and resets the tracker
+func (t *ChangeTracker) Flush() Changes {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	changes := t.changes
+	t.reset()
+
+	return changes
+}
+
+func (t *ChangeTracker) trackAttribute(event abci.Event, key string, set map[string]bool) {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key {
+			set[string(attr.Value)] = true
+		}
+	}
+}
+
+func (t *ChangeTracker) reset() {
+	t.changes = Changes{
+		Contracts: make(map[string]bool),
+		Accounts:  make(map[string]bool),
+	}
+}
+
+// Sequencer numbers the updates published on every topic
+type Sequencer struct {
+	mtx       sync.Mutex
+	sequences map[string]uint64
+}
+
+// NewSequencer creates a sequencer starting every topic at 1
+func NewSequencer() *Sequencer {
+	return &Sequencer{sequences: make(map[string]uint64)}
+}
+
+// Next returns the sequence of the next update of the topic
+func (s *Sequencer) Next(topic string) uint64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.sequences[topic]++
+	return s.sequences[topic]
+}
@@ -0,0 +1,44 @@
+package feed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func event(eventType string, attrs ...string) abci.Event {
+	event := abci.Event{Type: eventType}
+	for i := 0; i < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: []byte(attrs[i]), Value: []byte(attrs[i+1])})
+	}
+
+	return event
+}
+
+func TestChangeTracker(t *testing.T) {
+	tracker := NewChangeTracker()
+	tracker.TrackEvents([]abci.Event{
+		event("wasm", "contract_address", "pair", "action", "swap", "contract_address", "token", "action", "transfer"),
+		event("wasm-swap", "contract_address", "router"),
+		event("coin_spent", "spender", "alice", "amount", "1uusd"),
+		event("coin_received", "receiver", "bob", "amount", "1uusd"),
+		event("message", "sender", "carol"),
+	})
+
+	changes := tracker.Flush()
+	require.Equal(t, map[string]bool{"pair": true, "token": true, "router": true}, changes.Contracts)
+	require.Equal(t, map[string]bool{"alice": true, "bob": true}, changes.Accounts)
+	require.True(t, changes.Contract("pair"))
+	require.False(t, changes.Account("carol"))
+
+	// flushing resets the tracker
+	require.Empty(t, tracker.Flush().Contracts)
+}
+
+func TestSequencer(t *testing.T) {
+	sequencer := NewSequencer()
+	require.Equal(t, uint64(1), sequencer.Next("terraUpdateReserve"))
+	require.Equal(t, uint64(2), sequencer.Next("terraUpdateReserve"))
+	require.Equal(t, uint64(1), sequencer.Next("mirrorUpdateReserve"))
+}
@@ -0,0 +1,281 @@
+package feed
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// Supported sink types
+const (
+	SinkTypeNone      = "none"
+	SinkTypeZmq       = "zmq"
+	SinkTypeGRPC      = "grpc"
+	SinkTypeWebSocket = "websocket"
+	SinkTypeFile      = "file"
+)
+
+// config default values
+const (
+	DefaultSinkType         = SinkTypeNone
+	DefaultZmqAddress       = "ipc:///dev/shm/terracore"
+	DefaultGRPCAddress      = "127.0.0.1:9190"
+	DefaultWebSocketAddress = "127.0.0.1:9191"
+	DefaultFilePath         = "data/feed.ndjson"
+	DefaultDecodersPath     = "config/decoders.json"
+	DefaultAddressBookDir   = ""
+	DefaultAdminAddress     = ""
+	DefaultSimulate         = false
+	DefaultSimulateGasLimit = uint64(3_000_000)
+	DefaultSnapshotInterval = int64(100)
+	DefaultQueueSize        = 4096
+	DefaultBlockQueueSize   = 16
+	DefaultBlockTimeout     = 5 * time.Second
+	DefaultBlockGasLimit    = uint64(50_000_000)
+
+	DefaultSubscriberHighWaterMark = 1024
+	DefaultSlowConsumerDrops       = uint64(1024)
+)
+
+// Config is the extra config required for the mempool/state feed
+type Config struct {
+	// The transport the feed is published through;
+	// one of "none", "zmq", "grpc", "websocket" or "file"
+	Sink string `mapstructure:"sink"`
+
+	// The address the zmq PUB socket binds to
+	ZmqAddress string `mapstructure:"zmq-address"`
+
+	// The address the gRPC streaming server listens on
+	GRPCAddress string `mapstructure:"grpc-address"`
+
+	// The address the WebSocket server listens on
+	WebSocketAddress string `mapstructure:"websocket-address"`
+
+	// The NDJSON file the feed is appended to,
+	// relative paths are resolved against the node home
+	FilePath string `mapstructure:"file-path"`
+
+	// The JSON file declaring the contract execute decoders,
+	// relative paths are resolved against the node home
+	DecodersPath string `mapstructure:"decoders-path"`
+
+	// The directory holding the address book (wallet.json, mirrorToken.json,
+	// mirrorPair.json, terraToken.json and terraPair.json), empty means
+	// ~/workspace/arbitrage-config; relative paths are resolved against the node home
+	AddressBookDir string `mapstructure:"address-book-dir"`
+
+	// The address the local admin gRPC server listens on, empty disables the server
+	AdminAddress string `mapstructure:"admin-address"`
+
+	// Simulate the pending txs publishing swap intents on a branch of the
+	// CheckTx state and attach the predicted outcome to the intents
+	Simulate bool `mapstructure:"simulate"`
+
+	// The gas limit of a single simulation, txs with a lower gas limit run with theirs
+	SimulateGasLimit uint64 `mapstructure:"simulate-gas-limit"`
+
+	// The number of blocks between full snapshots of the reserves and balances,
+	// the blocks in between only publish the changed values
+	SnapshotInterval int64 `mapstructure:"snapshot-interval"`
+
+	// The number of messages queued for the sink,
+	// messages are dropped once the queue is full
+	QueueSize int `mapstructure:"queue-size"`
+
+	// The number of committed blocks queued for publishing,
+	// blocks are dropped once the queue is full
+	BlockQueueSize int `mapstructure:"block-queue-size"`
+
+	// The time allowed to publish the updates of a committed block
+	BlockTimeout time.Duration `mapstructure:"block-timeout"`
+
+	// The gas the queries publishing the updates of a committed block may consume
+	BlockGasLimit uint64 `mapstructure:"block-gas-limit"`
+
+	// The number of messages buffered per subscriber of the streaming sinks,
+	// messages are dropped for the subscriber once its buffer is full
+	SubscriberHighWaterMark int `mapstructure:"subscriber-high-water-mark"`
+
+	// The number of consecutive messages dropped after which a subscriber
+	// is disconnected as a slow consumer, zero never disconnects
+	SlowConsumerDrops uint64 `mapstructure:"slow-consumer-drops"`
+}
+
+// DefaultConfig returns the default settings for FeedConfig
+func DefaultConfig() *Config {
+	return &Config{
+		Sink:             DefaultSinkType,
+		ZmqAddress:       DefaultZmqAddress,
+		GRPCAddress:      DefaultGRPCAddress,
+		WebSocketAddress: DefaultWebSocketAddress,
+		FilePath:         DefaultFilePath,
+		DecodersPath:     DefaultDecodersPath,
+		AddressBookDir:   DefaultAddressBookDir,
+		AdminAddress:     DefaultAdminAddress,
+		Simulate:         DefaultSimulate,
+		SimulateGasLimit: DefaultSimulateGasLimit,
+		SnapshotInterval: DefaultSnapshotInterval,
+		QueueSize:        DefaultQueueSize,
+		BlockQueueSize:   DefaultBlockQueueSize,
+		BlockTimeout:     DefaultBlockTimeout,
+		BlockGasLimit:    DefaultBlockGasLimit,
+
+		SubscriberHighWaterMark: DefaultSubscriberHighWaterMark,
+		SlowConsumerDrops:       DefaultSlowConsumerDrops,
+	}
+}
+
+// GetConfig load config values from the app options,
+// falling back to the defaults for the values not set
+func GetConfig(appOpts servertypes.AppOptions) *Config {
+	config := DefaultConfig()
+
+	if v := appOpts.Get("feed.sink"); v != nil {
+		config.Sink = cast.ToString(v)
+	}
+	if v := appOpts.Get("feed.zmq-address"); v != nil {
+		config.ZmqAddress = cast.ToString(v)
+	}
+	if v := appOpts.Get("feed.grpc-address"); v != nil {
+		config.GRPCAddress = cast.ToString(v)
+	}
+	if v := appOpts.Get("feed.websocket-address"); v != nil {
+		config.WebSocketAddress = cast.ToString(v)
+	}
+	if v := appOpts.Get("feed.file-path"); v != nil {
+		config.FilePath = cast.ToString(v)
+	}
+	if v := appOpts.Get("feed.decoders-path"); v != nil {
+		config.DecodersPath = cast.ToString(v)
+	}
+	if v := appOpts.Get("feed.address-book-dir"); v != nil {
+		config.AddressBookDir = cast.ToString(v)
+	}
+	if v := appOpts.Get("feed.admin-address"); v != nil {
+		config.AdminAddress = cast.ToString(v)
+	}
+	if v := appOpts.Get("feed.simulate"); v != nil {
+		config.Simulate = cast.ToBool(v)
+	}
+	if v := appOpts.Get("feed.simulate-gas-limit"); v != nil {
+		config.SimulateGasLimit = cast.ToUint64(v)
+	}
+	if v := appOpts.Get("feed.snapshot-interval"); v != nil {
+		config.SnapshotInterval = cast.ToInt64(v)
+	}
+	if v := appOpts.Get("feed.queue-size"); v != nil {
+		config.QueueSize = cast.ToInt(v)
+	}
+	if v := appOpts.Get("feed.block-queue-size"); v != nil {
+		config.BlockQueueSize = cast.ToInt(v)
+	}
+	if v := appOpts.Get("feed.block-timeout"); v != nil {
+		config.BlockTimeout = cast.ToDuration(v)
+	}
+	if v := appOpts.Get("feed.block-gas-limit"); v != nil {
+		config.BlockGasLimit = cast.ToUint64(v)
+	}
+	if v := appOpts.Get("feed.subscriber-high-water-mark"); v != nil {
+		config.SubscriberHighWaterMark = cast.ToInt(v)
+	}
+	if v := appOpts.Get("feed.slow-consumer-drops"); v != nil {
+		config.SlowConsumerDrops = cast.ToUint64(v)
+	}
+
+	return config
+}
+
+// DefaultConfigTemplate default config template for the feed
+const DefaultConfigTemplate = `
+[feed]
+# The transport the mempool/state feed is published through;
+# one of "none", "zmq", "grpc", "websocket" or "file".
+# The feed is only served by a node run with the start command.
+sink = "{{ .FeedConfig.Sink }}"
+
+# The address the zmq PUB socket binds to
+zmq-address = "{{ .FeedConfig.ZmqAddress }}"
+
+# The address the gRPC streaming server listens on
+grpc-address = "{{ .FeedConfig.GRPCAddress }}"
+
+# The address the WebSocket server listens on
+websocket-address = "{{ .FeedConfig.WebSocketAddress }}"
+
+# The NDJSON file the feed is appended to,
+# relative paths are resolved against the node home
+file-path = "{{ .FeedConfig.FilePath }}"
+
+# The JSON file declaring the contract execute decoders, keyed by code ID or
+# contract address; relative paths are resolved against the node home.
+# The file is reloaded whenever it changes and on SIGHUP.
+decoders-path = "{{ .FeedConfig.DecodersPath }}"
+
+# The directory holding the address book (wallet.json, mirrorToken.json,
+# mirrorPair.json, terraToken.json and terraPair.json), empty means
+# ~/workspace/arbitrage-config; relative paths are resolved against the node home.
+# The address book is reloaded whenever one of its files changes and on SIGHUP.
+address-book-dir = "{{ .FeedConfig.AddressBookDir }}"
+
+# The address the local admin gRPC server listens on, e.g. "127.0.0.1:9192";
+# empty disables the server
+admin-address = "{{ .FeedConfig.AdminAddress }}"
+
+# Simulate the pending txs publishing swap intents on a branch of the CheckTx
+# state and attach the predicted wasm events, return amount and post-swap
+# reserves to the intents
+simulate = {{ .FeedConfig.Simulate }}
+
+# The gas limit of a single simulation, txs with a lower gas limit run with theirs
+simulate-gas-limit = {{ .FeedConfig.SimulateGasLimit }}
+
+# The number of blocks between full snapshots of the reserves and balances,
+# the blocks in between only publish the changed values
+snapshot-interval = {{ .FeedConfig.SnapshotInterval }}
+
+# The number of messages queued for the sink,
+# messages are dropped once the queue is full
+queue-size = {{ .FeedConfig.QueueSize }}
+
+# The number of committed blocks queued for publishing,
+# blocks are dropped once the queue is full and the next block is a full snapshot
+block-queue-size = {{ .FeedConfig.BlockQueueSize }}
+
+# The time allowed to publish the updates of a committed block, checked
+# between the contract queries; the rest of the updates is left to the next
+# block, which is then a full snapshot
+block-timeout = "{{ .FeedConfig.BlockTimeout }}"
+
+# The gas the contract queries publishing the updates of a committed block
+# may consume, bounding a single slow query the timeout cannot interrupt
+block-gas-limit = {{ .FeedConfig.BlockGasLimit }}
+
+# The number of messages buffered per subscriber of the zmq, gRPC and WebSocket
+# sinks, messages are dropped for the subscriber once its buffer is full
+subscriber-high-water-mark = {{ .FeedConfig.SubscriberHighWaterMark }}
+
+# The number of consecutive messages dropped after which a gRPC or WebSocket
+# subscriber is disconnected as a slow consumer, 0 never disconnects
+slow-consumer-drops = {{ .FeedConfig.SlowConsumerDrops }}
+`
+
+// SubscriberConfig returns the backpressure policy of the streaming sinks
+func (c *Config) SubscriberConfig() SubscriberConfig {
+	return SubscriberConfig{
+		HighWaterMark:     c.SubscriberHighWaterMark,
+		SlowConsumerDrops: c.SlowConsumerDrops,
+	}
+}
+
+// ResolvePath resolves the path against the node home unless it is absolute
+func ResolvePath(homePath, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(homePath, path)
+}
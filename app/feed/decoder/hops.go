@@ -0,0 +1,204 @@
+package decoder
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Hop operations
+const (
+	// OperationSwap is a swap executed directly on a pair
+	OperationSwap = "swap"
+
+	// WrapperCW20Send is the wrapper of the swaps found in a cw20 send hook
+	WrapperCW20Send = "cw20_send"
+)
+
+// Hop is a single swap executed by a contract execute
+type Hop struct {
+	// Contract is the pair or router executing the swap
+	Contract string
+
+	// Operation is OperationSwap for a pair or the router
+	// operation, e.g. "terra_swap", "astro_swap" or "native_swap"
+	Operation string
+
+	// Index is the position of the hop in the route and Hops its length
+	Index int
+	Hops  int
+
+	// OfferAsset and AskAsset are denoms or token addresses,
+	// empty when not given by the execute
+	OfferAsset string
+	AskAsset   string
+
+	// Amount is the offered amount, zero when it is only known
+	// once the previous hops are executed
+	Amount sdk.Int
+
+	BeliefPrice    string
+	MaxSpread      string
+	MinimumReceive string
+
+	// Wrappers are the wrappers the hop was found in, outermost first
+	Wrappers []string
+}
+
+// assetInfo is the terraswap/astroport AssetInfo
+type assetInfo struct {
+	Token *struct {
+		ContractAddr string `json:"contract_addr"`
+	} `json:"token"`
+	NativeToken *struct {
+		Denom string `json:"denom"`
+	} `json:"native_token"`
+}
+
+// id returns the denom or token address of the asset
+func (info *assetInfo) id() string {
+	switch {
+	case info == nil:
+		return ""
+	case info.Token != nil:
+		return info.Token.ContractAddr
+	case info.NativeToken != nil:
+		return info.NativeToken.Denom
+	default:
+		return ""
+	}
+}
+
+// swapMsg is the swap of a pair, either executed directly
+// or as the hook of a cw20 send (without offer asset)
+type swapMsg struct {
+	OfferAsset *struct {
+		Info   *assetInfo `json:"info"`
+		Amount sdk.Int    `json:"amount"`
+	} `json:"offer_asset"`
+	BeliefPrice string `json:"belief_price"`
+	MaxSpread   string `json:"max_spread"`
+}
+
+// swapOperationsMsg is the execute_swap_operations of a router
+type swapOperationsMsg struct {
+	Operations     []map[string]swapOperation `json:"operations"`
+	MinimumReceive string                     `json:"minimum_receive"`
+}
+
+// swapOperation holds the assets of the router operations,
+// native_swap gives denoms and the pair operations asset infos
+type swapOperation struct {
+	OfferAssetInfo *assetInfo `json:"offer_asset_info"`
+	AskAssetInfo   *assetInfo `json:"ask_asset_info"`
+	OfferDenom     string     `json:"offer_denom"`
+	AskDenom       string     `json:"ask_denom"`
+}
+
+func (op swapOperation) assets() (offer, ask string) {
+	if op.OfferDenom != "" || op.AskDenom != "" {
+		return op.OfferDenom, op.AskDenom
+	}
+
+	return op.OfferAssetInfo.id(), op.AskAssetInfo.id()
+}
+
+type executeMsg struct {
+	Swap                  *swapMsg           `json:"swap"`
+	ExecuteSwapOperations *swapOperationsMsg `json:"execute_swap_operations"`
+	Send                  *struct {
+		Contract string  `json:"contract"`
+		Amount   sdk.Int `json:"amount"`
+		Msg      []byte  `json:"msg"`
+	} `json:"send"`
+}
+
+// DecodeHops returns the swaps of the execute, one hop per swap: a pair swap
+// is a single hop and a router execute_swap_operations one hop per operation.
+// A cw20 send is unwrapped into the swaps of its hook, offering the token sent.
+func DecodeHops(execute Execute) []Hop {
+	var msg executeMsg
+	if err := json.Unmarshal(execute.Msg, &msg); err != nil {
+		return nil
+	}
+
+	if msg.Send != nil {
+		var hook executeMsg
+		if err := json.Unmarshal(msg.Send.Msg, &hook); err != nil || hook.Send != nil {
+			return nil
+		}
+
+		hops := decodeSwaps(msg.Send.Contract, hook, execute.Contract, msg.Send.Amount)
+		for i := range hops {
+			hops[i].Wrappers = append([]string{WrapperCW20Send}, hops[i].Wrappers...)
+		}
+
+		return hops
+	}
+
+	// the funds offered by a direct execute
+	var offerAsset string
+	offerAmount := sdk.ZeroInt()
+	if len(execute.Funds) != 0 {
+		offerAsset, offerAmount = execute.Funds[0].Denom, execute.Funds[0].Amount
+	}
+
+	return decodeSwaps(execute.Contract, msg, offerAsset, offerAmount)
+}
+
+// decodeSwaps returns the hops of the swap msgs executed on the contract
+func decodeSwaps(contract string, msg executeMsg, offerAsset string, offerAmount sdk.Int) []Hop {
+	if offerAmount.IsNil() {
+		offerAmount = sdk.ZeroInt()
+	}
+
+	switch {
+	case msg.Swap != nil:
+		hop := Hop{
+			Contract:    contract,
+			Operation:   OperationSwap,
+			Hops:        1,
+			OfferAsset:  offerAsset,
+			Amount:      offerAmount,
+			BeliefPrice: msg.Swap.BeliefPrice,
+			MaxSpread:   msg.Swap.MaxSpread,
+		}
+
+		if offer := msg.Swap.OfferAsset; offer != nil {
+			hop.OfferAsset = offer.Info.id()
+			if !offer.Amount.IsNil() {
+				hop.Amount = offer.Amount
+			}
+		}
+
+		return []Hop{hop}
+	case msg.ExecuteSwapOperations != nil:
+		var hops []Hop
+		operations := msg.ExecuteSwapOperations.Operations
+		for i, operation := range operations {
+			for name, op := range operation {
+				hop := Hop{
+					Contract:  contract,
+					Operation: name,
+					Index:     i,
+					Hops:      len(operations),
+					Amount:    sdk.ZeroInt(),
+				}
+				hop.OfferAsset, hop.AskAsset = op.assets()
+
+				if i == 0 {
+					hop.Amount = offerAmount
+				}
+				if i == len(operations)-1 {
+					hop.MinimumReceive = msg.ExecuteSwapOperations.MinimumReceive
+				}
+
+				hops = append(hops, hop)
+			}
+		}
+
+		return hops
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,87 @@
+package decoder
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const swapOperations = `{"execute_swap_operations":{"operations":[` +
+	`{"native_swap":{"offer_denom":"uluna","ask_denom":"uusd"}},` +
+	`{"terra_swap":{"offer_asset_info":{"native_token":{"denom":"uusd"}},"ask_asset_info":{"token":{"contract_addr":"token"}}}}` +
+	`],"minimum_receive":"90"}}`
+
+func TestDecodeHopsSwap(t *testing.T) {
+	hops := DecodeHops(Execute{
+		Contract: pairAddr,
+		Msg:      json.RawMessage(`{"swap":{"offer_asset":{"info":{"native_token":{"denom":"uusd"}},"amount":"100"},"belief_price":"1.5","max_spread":"0.01"}}`),
+		Funds:    sdk.NewCoins(sdk.NewInt64Coin("uusd", 100)),
+	})
+
+	require.Equal(t, []Hop{{
+		Contract:    pairAddr,
+		Operation:   OperationSwap,
+		Hops:        1,
+		OfferAsset:  "uusd",
+		Amount:      sdk.NewInt(100),
+		BeliefPrice: "1.5",
+		MaxSpread:   "0.01",
+	}}, hops)
+}
+
+func TestDecodeHopsRouter(t *testing.T) {
+	hops := DecodeHops(Execute{
+		Contract: pairAddr,
+		Msg:      json.RawMessage(swapOperations),
+		Funds:    sdk.NewCoins(sdk.NewInt64Coin("uluna", 10)),
+	})
+
+	require.Equal(t, []Hop{{
+		Contract:   pairAddr,
+		Operation:  "native_swap",
+		Hops:       2,
+		OfferAsset: "uluna",
+		AskAsset:   "uusd",
+		Amount:     sdk.NewInt(10),
+	}, {
+		Contract:       pairAddr,
+		Operation:      "terra_swap",
+		Index:          1,
+		Hops:           2,
+		OfferAsset:     "uusd",
+		AskAsset:       "token",
+		Amount:         sdk.ZeroInt(),
+		MinimumReceive: "90",
+	}}, hops)
+}
+
+func TestDecodeHopsCW20Send(t *testing.T) {
+	send := func(hook string) json.RawMessage {
+		return json.RawMessage(`{"send":{"contract":"` + pairAddr + `","amount":"7","msg":"` + base64.StdEncoding.EncodeToString([]byte(hook)) + `"}}`)
+	}
+
+	hops := DecodeHops(Execute{Contract: tokenAddr, Msg: send(`{"swap":{"belief_price":"2"}}`)})
+	require.Equal(t, []Hop{{
+		Contract:    pairAddr,
+		Operation:   OperationSwap,
+		Hops:        1,
+		OfferAsset:  tokenAddr,
+		Amount:      sdk.NewInt(7),
+		BeliefPrice: "2",
+		Wrappers:    []string{WrapperCW20Send},
+	}}, hops)
+
+	hops = DecodeHops(Execute{Contract: tokenAddr, Msg: send(swapOperations)})
+	require.Len(t, hops, 2)
+	require.Equal(t, sdk.NewInt(7), hops[0].Amount)
+	require.Equal(t, []string{WrapperCW20Send}, hops[1].Wrappers)
+
+	// other hooks and executes have no hops
+	require.Empty(t, DecodeHops(Execute{Contract: tokenAddr, Msg: send(`{"deposit":{}}`)}))
+	require.Empty(t, DecodeHops(Execute{Contract: tokenAddr, Msg: json.RawMessage(`{"transfer":{"amount":"1"}}`)}))
+	require.Empty(t, DecodeHops(Execute{Contract: tokenAddr, Msg: json.RawMessage(`invalid`)}))
+}
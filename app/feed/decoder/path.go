@@ -0,0 +1,146 @@
+package decoder
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Path sources resolved from the execute instead of its msg
+const (
+	SourceContract    = "$contract"
+	SourceSender      = "$sender"
+	SourceFundsDenom  = "$funds.denom"
+	SourceFundsAmount = "$funds.amount"
+)
+
+// Resolve evaluates the path expression against the execute.
+//
+// A path is either one of the $-prefixed sources, a literal quoted with
+// single quotes ('UST') or a dot separated path into the execute msg JSON
+// (swap.offer_asset.amount) where numeric segments index arrays. When a path
+// walks into a string, the string is decoded as base64 encoded JSON first, so
+// cw20 send hooks can be addressed as send.msg.swap.belief_price.
+// ok is false when the path does not resolve to a scalar value.
+func Resolve(path string, execute Execute) (value string, ok bool) {
+	switch path {
+	case SourceContract:
+		return execute.Contract, execute.Contract != ""
+	case SourceSender:
+		return execute.Sender, execute.Sender != ""
+	case SourceFundsDenom:
+		if len(execute.Funds) == 0 {
+			return "", false
+		}
+		return execute.Funds[0].Denom, true
+	case SourceFundsAmount:
+		if len(execute.Funds) == 0 {
+			return "", false
+		}
+		return execute.Funds[0].Amount.String(), true
+	}
+
+	if len(path) >= 2 && strings.HasPrefix(path, "'") && strings.HasSuffix(path, "'") {
+		return path[1 : len(path)-1], true
+	}
+
+	var msg interface{}
+	if err := json.Unmarshal(execute.Msg, &msg); err != nil {
+		return "", false
+	}
+
+	node, ok := walk(msg, strings.Split(path, "."))
+	if !ok {
+		return "", false
+	}
+
+	switch node := node.(type) {
+	case string:
+		return node, true
+	case float64:
+		return strconv.FormatFloat(node, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(node), true
+	default:
+		return "", false
+	}
+}
+
+// Exists reports whether the path resolves to any value, including objects
+func Exists(path string, execute Execute) bool {
+	var msg interface{}
+	if err := json.Unmarshal(execute.Msg, &msg); err != nil {
+		return false
+	}
+
+	node, ok := walk(msg, strings.Split(path, "."))
+	return ok && node != nil
+}
+
+func walk(node interface{}, segments []string) (interface{}, bool) {
+	for _, segment := range segments {
+		// step into base64 encoded JSON payloads, e.g. cw20 send hooks
+		if str, ok := node.(string); ok {
+			bz, err := base64.StdEncoding.DecodeString(str)
+			if err != nil {
+				return nil, false
+			}
+
+			if err := json.Unmarshal(bz, &node); err != nil {
+				return nil, false
+			}
+		}
+
+		switch current := node.(type) {
+		case map[string]interface{}:
+			next, ok := current[segment]
+			if !ok {
+				return nil, false
+			}
+			node = next
+		case []interface{}:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(current) {
+				return nil, false
+			}
+			node = current[idx]
+		default:
+			return nil, false
+		}
+	}
+
+	return node, true
+}
+
+// validatePath checks the path expression is well formed
+func validatePath(path string) error {
+	if path == "" {
+		return fmt.Errorf("empty path")
+	}
+
+	if strings.HasPrefix(path, "$") {
+		switch path {
+		case SourceContract, SourceSender, SourceFundsDenom, SourceFundsAmount:
+			return nil
+		default:
+			return fmt.Errorf("unknown source: %s", path)
+		}
+	}
+
+	if strings.HasPrefix(path, "'") {
+		if len(path) < 2 || !strings.HasSuffix(path, "'") {
+			return fmt.Errorf("unterminated literal: %s", path)
+		}
+		return nil
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		if segment == "" {
+			return fmt.Errorf("empty segment in path: %s", path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,318 @@
+package decoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feedtypes "github.com/terra-money/core/app/feed/types"
+)
+
+// Execute is a contract execute to be decoded
+type Execute struct {
+	CodeID   uint64
+	Contract string
+	Sender   string
+	Msg      json.RawMessage
+	Funds    sdk.Coins
+}
+
+// Fields maps the execute into the fields of the swap intent,
+// every value is a path expression as understood by Resolve
+type Fields struct {
+	PairName  string `json:"pair_name"`
+	AssetIn   string `json:"asset_in"`
+	Amount    string `json:"amount"`
+	MaxSpread string `json:"max_spread,omitempty"`
+	Price     string `json:"price,omitempty"`
+}
+
+// Spec declares a decoder for the executes of the listed code IDs or addresses
+type Spec struct {
+	Name      string   `json:"name"`
+	CodeIDs   []uint64 `json:"code_ids,omitempty"`
+	Addresses []string `json:"addresses,omitempty"`
+
+	// Match is a path which must exist in the execute msg for the decoder
+	// to apply, e.g. "swap" or "send.msg.swap"
+	Match string `json:"match"`
+
+	Fields Fields `json:"fields"`
+
+	// Names maps the resolved pair name and asset in, e.g. a pair address
+	// or a denom, to the name published in the intent; values without a
+	// name are published as resolved
+	Names map[string]string `json:"names,omitempty"`
+}
+
+// Validate checks the spec is well formed
+func (spec Spec) Validate() error {
+	if spec.Name == "" {
+		return fmt.Errorf("decoder name cannot be empty")
+	}
+
+	if len(spec.CodeIDs) == 0 && len(spec.Addresses) == 0 {
+		return fmt.Errorf("decoder %s: neither code_ids nor addresses given", spec.Name)
+	}
+
+	for _, address := range spec.Addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("decoder %s: invalid address %s: %w", spec.Name, address, err)
+		}
+	}
+
+	if err := validatePath(spec.Match); err != nil {
+		return fmt.Errorf("decoder %s: match: %w", spec.Name, err)
+	}
+
+	for name, path := range map[string]string{
+		"pair_name": spec.Fields.PairName,
+		"asset_in":  spec.Fields.AssetIn,
+		"amount":    spec.Fields.Amount,
+	} {
+		if err := validatePath(path); err != nil {
+			return fmt.Errorf("decoder %s: %s: %w", spec.Name, name, err)
+		}
+	}
+
+	for name, path := range map[string]string{
+		"max_spread": spec.Fields.MaxSpread,
+		"price":      spec.Fields.Price,
+	} {
+		if path == "" {
+			continue
+		}
+
+		if err := validatePath(path); err != nil {
+			return fmt.Errorf("decoder %s: %s: %w", spec.Name, name, err)
+		}
+	}
+
+	return nil
+}
+
+// Decode maps the execute into a swap intent,
+// ok is false when the execute does not match the spec
+func (spec Spec) Decode(execute Execute) (intent feedtypes.SwapIntent, ok bool) {
+	if !Exists(spec.Match, execute) {
+		return intent, false
+	}
+
+	if intent.PairName, ok = Resolve(spec.Fields.PairName, execute); !ok {
+		return intent, false
+	}
+	intent.PairName = spec.name(intent.PairName)
+
+	if intent.AssetIn, ok = Resolve(spec.Fields.AssetIn, execute); !ok {
+		return intent, false
+	}
+	intent.AssetIn = spec.name(intent.AssetIn)
+
+	amount, ok := Resolve(spec.Fields.Amount, execute)
+	if !ok {
+		return intent, false
+	}
+
+	if intent.Amount, ok = sdk.NewIntFromString(amount); !ok {
+		return intent, false
+	}
+
+	// optional fields
+	if spec.Fields.MaxSpread != "" {
+		intent.MaxSpread, _ = Resolve(spec.Fields.MaxSpread, execute)
+	}
+	if spec.Fields.Price != "" {
+		intent.Price, _ = Resolve(spec.Fields.Price, execute)
+	}
+
+	return intent, true
+}
+
+// name returns the name the value is published as
+func (spec Spec) name(value string) string {
+	if name, ok := spec.Names[value]; ok {
+		return name
+	}
+
+	return value
+}
+
+// File is the layout of the decoder config file
+type File struct {
+	Decoders []Spec `json:"decoders"`
+}
+
+// Result is a swap intent decoded from an execute
+type Result struct {
+	Decoder string
+	Intent  feedtypes.SwapIntent
+}
+
+// Registry holds the default decoders and those loaded from the config file,
+// it is safe for concurrent use and can be reloaded at runtime
+type Registry struct {
+	path string
+
+	mtx         sync.RWMutex
+	defaults    []Spec
+	fileSpecs   []Spec
+	byCodeID    map[uint64][]Spec
+	byAddress   map[string][]Spec
+	numDecoders int
+}
+
+// NewRegistry creates a registry from the specs
+func NewRegistry(specs []Spec) (*Registry, error) {
+	registry := &Registry{}
+	if err := registry.set(nil, specs); err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+// NewFileRegistry creates an empty registry of the config file at path,
+// the decoders are read by Reload
+func NewFileRegistry(path string) *Registry {
+	return &Registry{path: path}
+}
+
+// LoadRegistry creates a registry from the config file at path,
+// a missing file results in an empty registry
+func LoadRegistry(path string) (*Registry, error) {
+	registry := NewFileRegistry(path)
+	if err := registry.Reload(); err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+// Reload reads the config file again and atomically replaces the decoders;
+// the current decoders are kept when the file is invalid
+func (r *Registry) Reload() error {
+	if r.path == "" {
+		return nil
+	}
+
+	var file File
+	bz, err := ioutil.ReadFile(r.path)
+	switch {
+	case os.IsNotExist(err):
+		// a missing file declares no decoders
+	case err != nil:
+		return err
+	default:
+		if err := json.Unmarshal(bz, &file); err != nil {
+			return fmt.Errorf("failed to parse decoder config %s: %w", r.path, err)
+		}
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return r.set(r.defaults, file.Decoders)
+}
+
+// SetDefaults atomically replaces the decoders applied besides those of the config
+// file, a decoder of the config file replaces the default of the same name;
+// the current decoders are kept when a default is invalid
+func (r *Registry) SetDefaults(defaults []Spec) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return r.set(defaults, r.fileSpecs)
+}
+
+// Len returns the number of decoders
+func (r *Registry) Len() int {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.numDecoders
+}
+
+// HasCodeIDs reports whether any decoder is keyed by code ID,
+// callers can skip the contract info lookup otherwise
+func (r *Registry) HasCodeIDs() bool {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return len(r.byCodeID) != 0
+}
+
+// Decode runs every decoder declared for the contract or its code ID
+// against the execute and returns the intents of the matching ones
+func (r *Registry) Decode(execute Execute) (results []Result) {
+	r.mtx.RLock()
+	specs := make([]Spec, 0, len(r.byAddress[execute.Contract])+len(r.byCodeID[execute.CodeID]))
+	specs = append(specs, r.byAddress[execute.Contract]...)
+	specs = append(specs, r.byCodeID[execute.CodeID]...)
+	r.mtx.RUnlock()
+
+	// a decoder keyed by both the address and the code ID applies once
+	seen := make(map[string]bool)
+	for _, spec := range specs {
+		if seen[spec.Name] {
+			continue
+		}
+		seen[spec.Name] = true
+
+		if intent, ok := spec.Decode(execute); ok {
+			results = append(results, Result{Decoder: spec.Name, Intent: intent})
+		}
+	}
+
+	return results
+}
+
+// set replaces the decoders by the defaults and the specs of the config file,
+// it is called with the lock held
+func (r *Registry) set(defaults, fileSpecs []Spec) error {
+	byCodeID := make(map[uint64][]Spec)
+	byAddress := make(map[string][]Spec)
+	names := make(map[string]bool)
+
+	overridden := make(map[string]bool)
+	for _, spec := range fileSpecs {
+		overridden[spec.Name] = true
+	}
+
+	var specs []Spec
+	for _, spec := range defaults {
+		if !overridden[spec.Name] {
+			specs = append(specs, spec)
+		}
+	}
+	specs = append(specs, fileSpecs...)
+
+	for _, spec := range specs {
+		if err := spec.Validate(); err != nil {
+			return err
+		}
+
+		if names[spec.Name] {
+			return fmt.Errorf("duplicate decoder name: %s", spec.Name)
+		}
+		names[spec.Name] = true
+
+		for _, codeID := range spec.CodeIDs {
+			byCodeID[codeID] = append(byCodeID[codeID], spec)
+		}
+		for _, address := range spec.Addresses {
+			byAddress[address] = append(byAddress[address], spec)
+		}
+	}
+
+	r.defaults = defaults
+	r.fileSpecs = fileSpecs
+	r.byCodeID = byCodeID
+	r.byAddress = byAddress
+	r.numDecoders = len(specs)
+
+	return nil
+}
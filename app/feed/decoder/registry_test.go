@@ -0,0 +1,252 @@
+package decoder
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	pairAddr  = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	tokenAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+)
+
+func pairSpec() Spec {
+	return Spec{
+		Name:      "pair",
+		Addresses: []string{pairAddr},
+		Match:     "swap",
+		Fields: Fields{
+			PairName:  SourceContract,
+			AssetIn:   SourceFundsDenom,
+			Amount:    SourceFundsAmount,
+			MaxSpread: "swap.max_spread",
+			Price:     "swap.belief_price",
+		},
+	}
+}
+
+func hookSpec() Spec {
+	return Spec{
+		Name:    "cw20-hook",
+		CodeIDs: []uint64{7},
+		Match:   "send.msg.swap",
+		Fields: Fields{
+			PairName: "send.contract",
+			AssetIn:  "'TOKEN'",
+			Amount:   "send.amount",
+			Price:    "send.msg.swap.belief_price",
+		},
+	}
+}
+
+func TestResolve(t *testing.T) {
+	hook := base64.StdEncoding.EncodeToString([]byte(`{"swap":{"belief_price":"1.5"}}`))
+	execute := Execute{
+		Contract: pairAddr,
+		Sender:   tokenAddr,
+		Msg:      json.RawMessage(`{"send":{"amount":"100","msg":"` + hook + `","path":[{"a":1},{"a":2}]}}`),
+		Funds:    sdk.NewCoins(sdk.NewInt64Coin("uusd", 5)),
+	}
+
+	for path, expected := range map[string]string{
+		SourceContract:               pairAddr,
+		SourceSender:                 tokenAddr,
+		SourceFundsDenom:             "uusd",
+		SourceFundsAmount:            "5",
+		"'UST'":                      "UST",
+		"send.amount":                "100",
+		"send.path.1.a":              "2",
+		"send.msg.swap.belief_price": "1.5",
+	} {
+		value, ok := Resolve(path, execute)
+		require.True(t, ok, path)
+		require.Equal(t, expected, value, path)
+	}
+
+	for _, path := range []string{"send.unknown", "send.path.5.a", "send.amount.x", "send"} {
+		_, ok := Resolve(path, execute)
+		require.False(t, ok, path)
+	}
+
+	require.True(t, Exists("send.msg.swap", execute))
+	require.False(t, Exists("swap", execute))
+}
+
+func TestRegistryDecode(t *testing.T) {
+	registry, err := NewRegistry([]Spec{pairSpec(), hookSpec()})
+	require.NoError(t, err)
+	require.Equal(t, 2, registry.Len())
+	require.True(t, registry.HasCodeIDs())
+
+	results := registry.Decode(Execute{
+		Contract: pairAddr,
+		Msg:      json.RawMessage(`{"swap":{"max_spread":"0.01","belief_price":"2"}}`),
+		Funds:    sdk.NewCoins(sdk.NewInt64Coin("uusd", 1000)),
+	})
+	require.Len(t, results, 1)
+	require.Equal(t, "pair", results[0].Decoder)
+	require.Equal(t, pairAddr, results[0].Intent.PairName)
+	require.Equal(t, "uusd", results[0].Intent.AssetIn)
+	require.Equal(t, sdk.NewInt(1000), results[0].Intent.Amount)
+	require.Equal(t, "0.01", results[0].Intent.MaxSpread)
+	require.Equal(t, "2", results[0].Intent.Price)
+
+	// no funds sent, the amount cannot be resolved
+	require.Empty(t, registry.Decode(Execute{
+		Contract: pairAddr,
+		Msg:      json.RawMessage(`{"swap":{}}`),
+	}))
+
+	hook := base64.StdEncoding.EncodeToString([]byte(`{"swap":{"belief_price":"3"}}`))
+	results = registry.Decode(Execute{
+		CodeID:   7,
+		Contract: tokenAddr,
+		Msg:      json.RawMessage(`{"send":{"contract":"` + pairAddr + `","amount":"42","msg":"` + hook + `"}}`),
+	})
+	require.Len(t, results, 1)
+	require.Equal(t, "cw20-hook", results[0].Decoder)
+	require.Equal(t, "TOKEN", results[0].Intent.AssetIn)
+	require.Equal(t, sdk.NewInt(42), results[0].Intent.Amount)
+	require.Equal(t, "3", results[0].Intent.Price)
+
+	// unknown code ID
+	require.Empty(t, registry.Decode(Execute{CodeID: 8, Contract: tokenAddr, Msg: json.RawMessage(`{}`)}))
+}
+
+func TestSpecNames(t *testing.T) {
+	spec := pairSpec()
+	spec.Names = map[string]string{pairAddr: "MIR-UST", "uusd": "UST"}
+
+	intent, ok := spec.Decode(Execute{
+		Contract: pairAddr,
+		Msg:      json.RawMessage(`{"swap":{}}`),
+		Funds:    sdk.NewCoins(sdk.NewInt64Coin("uusd", 1000)),
+	})
+	require.True(t, ok)
+	require.Equal(t, "MIR-UST", intent.PairName)
+	require.Equal(t, "UST", intent.AssetIn)
+
+	// values without a name are published as resolved
+	intent, ok = spec.Decode(Execute{
+		Contract: pairAddr,
+		Msg:      json.RawMessage(`{"swap":{}}`),
+		Funds:    sdk.NewCoins(sdk.NewInt64Coin("uluna", 1000)),
+	})
+	require.True(t, ok)
+	require.Equal(t, "uluna", intent.AssetIn)
+}
+
+func TestSpecValidate(t *testing.T) {
+	require.NoError(t, pairSpec().Validate())
+
+	spec := pairSpec()
+	spec.Name = ""
+	require.Error(t, spec.Validate())
+
+	spec = pairSpec()
+	spec.Addresses = []string{"invalid"}
+	require.Error(t, spec.Validate())
+
+	spec = pairSpec()
+	spec.Addresses = nil
+	require.Error(t, spec.Validate())
+
+	spec = pairSpec()
+	spec.Fields.Amount = "$unknown"
+	require.Error(t, spec.Validate())
+
+	spec = pairSpec()
+	spec.Fields.Price = "swap..price"
+	require.Error(t, spec.Validate())
+
+	_, err := NewRegistry([]Spec{pairSpec(), pairSpec()})
+	require.Error(t, err)
+}
+
+func TestRegistryReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "decoders.json")
+
+	// a missing file results in an empty registry
+	registry, err := LoadRegistry(path)
+	require.NoError(t, err)
+	require.Equal(t, 0, registry.Len())
+
+	bz, err := json.Marshal(File{Decoders: []Spec{pairSpec()}})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, bz, 0600))
+	require.NoError(t, registry.Reload())
+	require.Equal(t, 1, registry.Len())
+
+	// invalid files keep the current decoders
+	require.NoError(t, ioutil.WriteFile(path, []byte("{"), 0600))
+	require.Error(t, registry.Reload())
+	require.Equal(t, 1, registry.Len())
+}
+
+func TestRegistryDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "decoders.json")
+	registry, err := LoadRegistry(path)
+	require.NoError(t, err)
+
+	require.NoError(t, registry.SetDefaults([]Spec{pairSpec(), hookSpec()}))
+	require.Equal(t, 2, registry.Len())
+
+	// the config file replaces the default of the same name
+	override := pairSpec()
+	override.Fields.AssetIn = "'UST'"
+	bz, err := json.Marshal(File{Decoders: []Spec{override}})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, bz, 0600))
+	require.NoError(t, registry.Reload())
+	require.Equal(t, 2, registry.Len())
+
+	results := registry.Decode(Execute{
+		Contract: pairAddr,
+		Msg:      json.RawMessage(`{"swap":{}}`),
+		Funds:    sdk.NewCoins(sdk.NewInt64Coin("uusd", 1000)),
+	})
+	require.Len(t, results, 1)
+	require.Equal(t, "UST", results[0].Intent.AssetIn)
+
+	// invalid defaults keep the current decoders, the file decoders are kept across defaults
+	invalid := hookSpec()
+	invalid.CodeIDs = nil
+	require.Error(t, registry.SetDefaults([]Spec{invalid}))
+	require.Equal(t, 2, registry.Len())
+
+	require.NoError(t, registry.SetDefaults(nil))
+	require.Equal(t, 1, registry.Len())
+	require.False(t, registry.HasCodeIDs())
+}
+
+func TestRegistryWatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "decoders.json")
+	registry, err := LoadRegistry(path)
+	require.NoError(t, err)
+
+	reloaded := make(chan error, 16)
+	watcher, err := registry.Watch(func(err error) { reloaded <- err })
+	require.NoError(t, err)
+	defer watcher.Close()
+
+	bz, err := json.Marshal(File{Decoders: []Spec{pairSpec(), hookSpec()}})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, bz, 0600))
+
+	select {
+	case err := <-reloaded:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("decoders were not reloaded")
+	}
+	require.Equal(t, 2, registry.Len())
+}
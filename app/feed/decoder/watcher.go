@@ -0,0 +1,22 @@
+package decoder
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/terra-money/core/app/feed/filewatch"
+)
+
+// Watch starts watching the config file of the registry, onReload is
+// called with the result of every reload triggered by a change
+func (r *Registry) Watch(onReload func(error)) (*filewatch.Watcher, error) {
+	if r.path == "" {
+		return nil, fmt.Errorf("registry has no config file")
+	}
+
+	// the directory is watched, editors commonly replace the file on save
+	name := filepath.Base(r.path)
+	return filewatch.Watch(filepath.Dir(r.path), func(fileName string) bool {
+		return fileName == name
+	}, func() { onReload(r.Reload()) })
+}
@@ -0,0 +1,48 @@
+package feed
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var _ EventSink = &FileSink{}
+
+// FileSink appends the feed to a file as newline delimited JSON
+type FileSink struct {
+	mtx     sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// NewFileSink opens the file for appending, creating it if it does not exist
+func NewFileSink(path string) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileSink{file: file, encoder: json.NewEncoder(file)}, nil
+}
+
+// Send implements EventSink
+func (s *FileSink) Send(topic string, payload []byte) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// json.Encoder terminates each value with a newline
+	return s.encoder.Encode(Message{Topic: topic, Payload: payload})
+}
+
+// Close implements EventSink
+func (s *FileSink) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.file.Close()
+}
@@ -0,0 +1,73 @@
+package filewatch
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Debounce is the time waited for writes to settle before reloading,
+// editors commonly save a file with several events
+const Debounce = 200 * time.Millisecond
+
+// Watcher calls a reload function whenever one of the watched files changes
+type Watcher struct {
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+// Watch starts watching the files of dir whose name is accepted by match,
+// reload is called once the writes to them have settled
+func Watch(dir string, match func(name string) bool, reload func()) (*Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	w := &Watcher{
+		watcher: watcher,
+		done:    make(chan struct{}),
+	}
+
+	go w.run(match, reload)
+	return w, nil
+}
+
+// Close stops watching
+func (w *Watcher) Close() error {
+	err := w.watcher.Close()
+	<-w.done
+	return err
+}
+
+func (w *Watcher) run(match func(string) bool, reload func()) {
+	defer close(w.done)
+
+	timer := time.NewTimer(Debounce)
+	timer.Stop()
+
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+
+			if match(filepath.Base(event.Name)) {
+				timer.Reset(Debounce)
+			}
+		case _, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+		case <-timer.C:
+			reload()
+		}
+	}
+}
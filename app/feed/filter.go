@@ -0,0 +1,92 @@
+package feed
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/app/feed/types"
+)
+
+// Filter selects the messages delivered to a subscriber; a message is
+// delivered when it matches every non-empty criterion of the filter
+type Filter struct {
+	topics    map[string]bool
+	contracts map[string]bool
+	senders   map[string]bool
+	minAmount *sdk.Int
+}
+
+// NewFilter validates the subscribe request and returns its filter
+func NewFilter(req *types.SubscribeRequest) (*Filter, error) {
+	filter := &Filter{
+		topics:    toSet(req.Topics),
+		contracts: toSet(req.Contracts),
+		senders:   toSet(req.Senders),
+	}
+
+	for _, address := range append(append([]string{}, req.Contracts...), req.Senders...) {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return nil, fmt.Errorf("invalid address %s: %w", address, err)
+		}
+	}
+
+	if req.MinAmount != "" {
+		minAmount, ok := sdk.NewIntFromString(req.MinAmount)
+		if !ok || minAmount.IsNegative() {
+			return nil, fmt.Errorf("invalid min amount: %s", req.MinAmount)
+		}
+
+		filter.minAmount = &minAmount
+	}
+
+	return filter, nil
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+
+	return set
+}
+
+// needsRouting reports whether the filter matches on the routing keys,
+// which are only decoded from the payload when needed
+func (f *Filter) needsRouting() bool {
+	return len(f.contracts) != 0 || len(f.senders) != 0 || f.minAmount != nil
+}
+
+// Matches reports whether the message of the topic with the routing keys passes the filter;
+// a message without the key a criterion applies to does not match it
+func (f *Filter) Matches(topic string, routing types.Routing) bool {
+	if len(f.topics) != 0 && !f.topics[topic] {
+		return false
+	}
+
+	if len(f.contracts) != 0 && !f.contracts[routing.Contract] {
+		return false
+	}
+
+	if len(f.senders) != 0 && !f.senders[routing.Sender] {
+		return false
+	}
+
+	if f.minAmount != nil && (routing.Amount == nil || routing.Amount.LT(*f.minAmount)) {
+		return false
+	}
+
+	return true
+}
+
+// messageRouting decodes the routing keys from the frame of the message,
+// a payload which is not a frame has none
+func messageRouting(msg Message) types.Routing {
+	var frame types.Frame
+	if err := frame.Unmarshal(msg.Payload); err != nil {
+		return types.Routing{}
+	}
+
+	return frame.Routing
+}
@@ -0,0 +1,143 @@
+package feed
+
+import (
+	"io"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/terra-money/core/app/feed/types"
+)
+
+var (
+	_ EventSink        = &GRPCSink{}
+	_ types.FeedServer = &GRPCSink{}
+)
+
+// GRPCSink publishes the feed through the Feed/Subscribe and Feed/Stream RPCs,
+// the bidirectional Feed/Stream lets subscribers replace their filter at any time
+type GRPCSink struct {
+	*broadcaster
+
+	server   *grpc.Server
+	listener net.Listener
+}
+
+// NewGRPCSink starts a gRPC server listening on the address
+func NewGRPCSink(address string, config SubscriberConfig) (*GRPCSink, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	sink := &GRPCSink{
+		broadcaster: newBroadcaster(SinkTypeGRPC, config),
+		server:      grpc.NewServer(),
+		listener:    listener,
+	}
+
+	types.RegisterFeedServer(sink.server, sink)
+	go sink.server.Serve(listener) // nolint: errcheck
+
+	return sink, nil
+}
+
+// Addr returns the address the server listens on
+func (s *GRPCSink) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Send implements EventSink
+func (s *GRPCSink) Send(topic string, payload []byte) error {
+	s.broadcast(Message{Topic: topic, Payload: payload})
+	return nil
+}
+
+// Close implements EventSink
+func (s *GRPCSink) Close() error {
+	s.broadcaster.close()
+	s.server.Stop()
+	return nil
+}
+
+// Subscribe implements types.FeedServer
+func (s *GRPCSink) Subscribe(req *types.SubscribeRequest, stream types.Feed_SubscribeServer) error {
+	filter, err := NewFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sub := s.subscribe(filter)
+	defer s.unsubscribe(sub)
+
+	return s.stream(sub, stream, nil)
+}
+
+// Stream implements types.FeedServer, the first request sets the filter
+// of the subscriber and every following one replaces it
+func (s *GRPCSink) Stream(stream types.Feed_StreamServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	filter, err := NewFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sub := s.subscribe(filter)
+	defer s.unsubscribe(sub)
+
+	// the control channel; the stream ends once it fails
+	controlErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				// the subscriber keeps receiving with its last filter
+				return
+			} else if err != nil {
+				controlErr <- err
+				return
+			}
+
+			filter, err := NewFilter(req)
+			if err != nil {
+				controlErr <- status.Error(codes.InvalidArgument, err.Error())
+				return
+			}
+
+			s.setFilter(sub, filter)
+		}
+	}()
+
+	return s.stream(sub, stream, controlErr)
+}
+
+// stream sends the messages of the subscriber until the subscriber is
+// disconnected or the stream ends
+func (s *GRPCSink) stream(sub *subscriber, stream grpc.ServerStream, controlErr <-chan error) error {
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case err := <-controlErr:
+			return err
+		case msg, ok := <-sub.ch:
+			if !ok {
+				if sub.Err() != nil {
+					return status.Error(codes.ResourceExhausted, sub.Err().Error())
+				}
+
+				return nil
+			}
+
+			if err := stream.SendMsg(&types.FeedMessage{Topic: msg.Topic, Payload: msg.Payload, Dropped: msg.Dropped}); err != nil {
+				return err
+			}
+		}
+	}
+}
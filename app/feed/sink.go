@@ -0,0 +1,112 @@
+package feed
+
+import (
+	"fmt"
+	"sync"
+)
+
+// EventSink is the transport the mempool/state feed is published through
+type EventSink interface {
+	// Send publishes the payload on the topic
+	Send(topic string, payload []byte) error
+
+	// Close releases the resources held by the sink
+	Close() error
+}
+
+// Message is a single message published on the feed
+type Message struct {
+	Topic   string `json:"topic"`
+	Payload []byte `json:"payload"`
+
+	// Dropped is the number of messages dropped for the subscriber
+	// since the previous one, only set by the streaming sinks
+	Dropped uint64 `json:"dropped,omitempty"`
+}
+
+// NewSink creates the sink selected by the config,
+// homePath is used to resolve relative file paths
+func NewSink(config *Config, homePath string) (EventSink, error) {
+	switch config.Sink {
+	case SinkTypeNone, "":
+		return NopSink{}, nil
+	case SinkTypeZmq:
+		return NewZmqSink(config.ZmqAddress, config.SubscriberHighWaterMark)
+	case SinkTypeGRPC:
+		return NewGRPCSink(config.GRPCAddress, config.SubscriberConfig())
+	case SinkTypeWebSocket:
+		return NewWebSocketSink(config.WebSocketAddress, config.SubscriberConfig())
+	case SinkTypeFile:
+		return NewFileSink(ResolvePath(homePath, config.FilePath))
+	default:
+		return nil, fmt.Errorf("unknown feed sink type: %s", config.Sink)
+	}
+}
+
+var _ EventSink = NopSink{}
+
+// NopSink discards every message
+type NopSink struct{}
+
+// Send implements EventSink
+func (NopSink) Send(string, []byte) error { return nil }
+
+// Close implements EventSink
+func (NopSink) Close() error { return nil }
+
+var _ EventSink = &MemorySink{}
+
+// MemorySink keeps every message in memory,
+// this sink is only for test purpose
+type MemorySink struct {
+	mtx      sync.Mutex
+	messages []Message
+}
+
+// NewMemorySink returns an empty MemorySink
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+// Send implements EventSink
+func (s *MemorySink) Send(topic string, payload []byte) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.messages = append(s.messages, Message{Topic: topic, Payload: payload})
+	return nil
+}
+
+// Close implements EventSink
+func (s *MemorySink) Close() error { return nil }
+
+// Messages returns a copy of the messages received so far
+func (s *MemorySink) Messages() []Message {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return append([]Message{}, s.messages...)
+}
+
+// MessagesByTopic returns the messages received so far on the topic
+func (s *MemorySink) MessagesByTopic(topic string) []Message {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var messages []Message
+	for _, msg := range s.messages {
+		if msg.Topic == topic {
+			messages = append(messages, msg)
+		}
+	}
+
+	return messages
+}
+
+// Reset drops the messages received so far
+func (s *MemorySink) Reset() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.messages = nil
+}
@@ -0,0 +1,276 @@
+package feed
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/terra-money/core/app/feed/types"
+)
+
+func TestNewSink(t *testing.T) {
+	config := DefaultConfig()
+	config.Sink = SinkTypeNone
+	sink, err := NewSink(config, t.TempDir())
+	require.NoError(t, err)
+	require.IsType(t, NopSink{}, sink)
+
+	config.Sink = SinkTypeFile
+	sink, err = NewSink(config, t.TempDir())
+	require.NoError(t, err)
+	require.IsType(t, &FileSink{}, sink)
+	require.NoError(t, sink.Close())
+
+	config.Sink = "unknown"
+	_, err = NewSink(config, t.TempDir())
+	require.Error(t, err)
+}
+
+func TestMemorySink(t *testing.T) {
+	sink := NewMemorySink()
+	require.NoError(t, sink.Send("terraSwapStart", []byte("a")))
+	require.NoError(t, sink.Send("ancRate", []byte("b")))
+	require.NoError(t, sink.Send("terraSwapStart", []byte("c")))
+
+	require.Len(t, sink.Messages(), 3)
+	require.Equal(t, []Message{
+		{Topic: "terraSwapStart", Payload: []byte("a")},
+		{Topic: "terraSwapStart", Payload: []byte("c")},
+	}, sink.MessagesByTopic("terraSwapStart"))
+
+	sink.Reset()
+	require.Empty(t, sink.Messages())
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "feed.ndjson")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Send("terraSwapStart", []byte("a")))
+	require.NoError(t, sink.Send("ancRate", []byte("b")))
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var messages []Message
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var msg Message
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &msg))
+		messages = append(messages, msg)
+	}
+
+	require.Equal(t, []Message{
+		{Topic: "terraSwapStart", Payload: []byte("a")},
+		{Topic: "ancRate", Payload: []byte("b")},
+	}, messages)
+}
+
+func testFilter(t *testing.T, req types.SubscribeRequest) *Filter {
+	filter, err := NewFilter(&req)
+	require.NoError(t, err)
+	return filter
+}
+
+func testFrame(t *testing.T, topic string, routing types.Routing) Message {
+	frame := types.Frame{SchemaVersion: types.SchemaVersion, Topic: topic, Routing: routing}
+	bz, err := frame.Marshal()
+	require.NoError(t, err)
+	return Message{Topic: topic, Payload: bz}
+}
+
+func TestBroadcasterTopics(t *testing.T) {
+	b := newBroadcaster("test", SubscriberConfig{HighWaterMark: 16})
+	all := b.subscribe(testFilter(t, types.SubscribeRequest{}))
+	swaps := b.subscribe(testFilter(t, types.SubscribeRequest{Topics: []string{"terraSwapStart"}}))
+
+	b.broadcast(Message{Topic: "ancRate"})
+	b.broadcast(Message{Topic: "terraSwapStart"})
+
+	require.Len(t, all.ch, 2)
+	require.Len(t, swaps.ch, 1)
+	require.Equal(t, "terraSwapStart", (<-swaps.ch).Topic)
+
+	b.unsubscribe(swaps)
+	_, ok := <-swaps.ch
+	require.False(t, ok)
+	require.NoError(t, swaps.Err())
+
+	b.close()
+	require.Empty(t, b.subscribers)
+}
+
+func TestFilter(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+	sender := sdk.AccAddress([]byte("sender______________")).String()
+	amount := sdk.NewInt(100)
+	routing := types.Routing{Contract: contract, Sender: sender, Amount: &amount}
+
+	for _, tc := range []struct {
+		name    string
+		req     types.SubscribeRequest
+		routing types.Routing
+		matches bool
+	}{
+		{"empty", types.SubscribeRequest{}, types.Routing{}, true},
+		{"topic", types.SubscribeRequest{Topics: []string{"terraSwapStart"}}, types.Routing{}, true},
+		{"other topic", types.SubscribeRequest{Topics: []string{"ancRate"}}, routing, false},
+		{"contract", types.SubscribeRequest{Contracts: []string{contract}}, routing, true},
+		{"other contract", types.SubscribeRequest{Contracts: []string{sender}}, routing, false},
+		{"sender", types.SubscribeRequest{Senders: []string{sender}}, routing, true},
+		{"no routing", types.SubscribeRequest{Senders: []string{sender}}, types.Routing{}, false},
+		{"min amount", types.SubscribeRequest{MinAmount: "100"}, routing, true},
+		{"below min amount", types.SubscribeRequest{MinAmount: "101"}, routing, false},
+		{"no amount", types.SubscribeRequest{MinAmount: "1"}, types.Routing{Contract: contract}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.matches, testFilter(t, tc.req).Matches("terraSwapStart", tc.routing))
+		})
+	}
+
+	_, err := NewFilter(&types.SubscribeRequest{Contracts: []string{"invalid"}})
+	require.Error(t, err)
+
+	_, err = NewFilter(&types.SubscribeRequest{MinAmount: "-1"})
+	require.Error(t, err)
+}
+
+func TestBroadcasterRouting(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender______________")).String()
+	amount := sdk.NewInt(100)
+
+	b := newBroadcaster("test", SubscriberConfig{HighWaterMark: 16})
+	sub := b.subscribe(testFilter(t, types.SubscribeRequest{Senders: []string{sender}}))
+
+	b.broadcast(testFrame(t, "terraSwapStart", types.Routing{Sender: sender, Amount: &amount}))
+	b.broadcast(testFrame(t, "terraSwapStart", types.Routing{}))
+	b.broadcast(Message{Topic: "terraSwapStart", Payload: []byte("not a frame")})
+	require.Len(t, sub.ch, 1)
+
+	b.setFilter(sub, testFilter(t, types.SubscribeRequest{MinAmount: "101"}))
+	b.broadcast(testFrame(t, "terraSwapStart", types.Routing{Sender: sender, Amount: &amount}))
+	require.Len(t, sub.ch, 1)
+}
+
+func TestBroadcasterBackpressure(t *testing.T) {
+	b := newBroadcaster("test", SubscriberConfig{HighWaterMark: 2, SlowConsumerDrops: 3})
+	sub := b.subscribe(testFilter(t, types.SubscribeRequest{}))
+
+	// two messages fill the buffer, the next two are dropped
+	for i := 0; i < 4; i++ {
+		b.broadcast(Message{Topic: "ancRate"})
+	}
+	require.Equal(t, uint64(2), sub.Dropped())
+
+	// the next message delivered reports the drops
+	require.Equal(t, uint64(0), (<-sub.ch).Dropped)
+	b.broadcast(Message{Topic: "ancRate"})
+	require.Equal(t, uint64(0), (<-sub.ch).Dropped)
+	require.Equal(t, uint64(2), (<-sub.ch).Dropped)
+
+	// three drops in a row disconnect the subscriber
+	for i := 0; i < 5; i++ {
+		b.broadcast(Message{Topic: "ancRate"})
+	}
+	require.Equal(t, uint64(5), sub.Dropped())
+	require.Empty(t, b.subscribers)
+
+	for range sub.ch {
+	}
+	require.ErrorIs(t, sub.Err(), ErrSlowConsumer)
+}
+
+func TestGRPCSink(t *testing.T) {
+	sink, err := NewGRPCSink("127.0.0.1:0", DefaultConfig().SubscriberConfig())
+	require.NoError(t, err)
+	defer sink.Close()
+
+	conn, err := grpc.Dial(sink.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := types.NewFeedClient(conn).Subscribe(ctx, &types.SubscribeRequest{Topics: []string{"terraSwapStart"}})
+	require.NoError(t, err)
+
+	// wait for the subscription to be registered
+	require.Eventually(t, func() bool {
+		sink.mtx.RLock()
+		defer sink.mtx.RUnlock()
+		return len(sink.subscribers) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, sink.Send("ancRate", []byte("a")))
+	require.NoError(t, sink.Send("terraSwapStart", []byte("b")))
+
+	msg, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "terraSwapStart", msg.Topic)
+	require.Equal(t, []byte("b"), msg.Payload)
+}
+
+func TestGRPCSinkStream(t *testing.T) {
+	sink, err := NewGRPCSink("127.0.0.1:0", DefaultConfig().SubscriberConfig())
+	require.NoError(t, err)
+	defer sink.Close()
+
+	conn, err := grpc.Dial(sink.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := types.NewFeedClient(conn).Stream(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&types.SubscribeRequest{Topics: []string{"terraSwapStart"}}))
+
+	waitFilter := func(topic string) {
+		require.Eventually(t, func() bool {
+			sink.mtx.RLock()
+			defer sink.mtx.RUnlock()
+			for sub := range sink.subscribers {
+				return sub.filter.Matches(topic, types.Routing{})
+			}
+			return false
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	waitFilter("terraSwapStart")
+	require.NoError(t, sink.Send("ancRate", []byte("a")))
+	require.NoError(t, sink.Send("terraSwapStart", []byte("b")))
+
+	msg, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, []byte("b"), msg.Payload)
+
+	// replace the filter over the stream
+	require.NoError(t, stream.Send(&types.SubscribeRequest{Topics: []string{"ancRate"}}))
+	waitFilter("ancRate")
+	require.NoError(t, sink.Send("terraSwapStart", []byte("c")))
+	require.NoError(t, sink.Send("ancRate", []byte("d")))
+
+	msg, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, []byte("d"), msg.Payload)
+
+	// an invalid filter ends the stream
+	require.NoError(t, stream.Send(&types.SubscribeRequest{MinAmount: "invalid"}))
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,1553 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: terra/feed/v1/feed.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeRequest is the request type for the Feed/Subscribe and Feed/Stream
+// RPC methods. A message is received when it matches every non-empty filter;
+// messages without routing keys only match filters on topics.
+type SubscribeRequest struct {
+	// topics defines the topics to receive, an empty list receives all topics.
+	Topics []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	// contracts defines the contract addresses to receive the messages of.
+	Contracts []string `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// senders defines the sender addresses to receive the messages of.
+	Senders []string `protobuf:"bytes,3,rep,name=senders,proto3" json:"senders,omitempty"`
+	// min_amount defines the minimum amount of the messages to receive.
+	MinAmount string `protobuf:"bytes,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b23b5ff7b6d14b5, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetSenders() []string {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetMinAmount() string {
+	if m != nil {
+		return m.MinAmount
+	}
+	return ""
+}
+
+// FeedMessage is a single message published on the feed.
+type FeedMessage struct {
+	// topic defines the topic the message was published on.
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// payload defines the encoded Frame of the message.
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// dropped defines the number of messages dropped for the subscriber
+	// since the previous message received, because it did not keep up.
+	Dropped uint64 `protobuf:"varint,3,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (m *FeedMessage) Reset()         { *m = FeedMessage{} }
+func (m *FeedMessage) String() string { return proto.CompactTextString(m) }
+func (*FeedMessage) ProtoMessage()    {}
+func (*FeedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b23b5ff7b6d14b5, []int{1}
+}
+func (m *FeedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedMessage.Merge(m, src)
+}
+func (m *FeedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedMessage proto.InternalMessageInfo
+
+func (m *FeedMessage) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *FeedMessage) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *FeedMessage) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
+	}
+	return 0
+}
+
+// Frame is the envelope every feed message is published in. Consumers must
+// check schema_version before decoding body into the message of the topic.
+type Frame struct {
+	// schema_version defines the version of the feed schema body is encoded with.
+	SchemaVersion uint32 `protobuf:"varint,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// topic defines the topic the body belongs to.
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// body defines the protobuf encoded message of the topic.
+	Body []byte `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// routing defines the keys subscribers filter the message by.
+	Routing Routing `protobuf:"bytes,4,opt,name=routing,proto3" json:"routing"`
+}
+
+func (m *Frame) Reset()         { *m = Frame{} }
+func (m *Frame) String() string { return proto.CompactTextString(m) }
+func (*Frame) ProtoMessage()    {}
+func (*Frame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b23b5ff7b6d14b5, []int{2}
+}
+func (m *Frame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Frame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Frame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Frame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Frame.Merge(m, src)
+}
+func (m *Frame) XXX_Size() int {
+	return m.Size()
+}
+func (m *Frame) XXX_DiscardUnknown() {
+	xxx_messageInfo_Frame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Frame proto.InternalMessageInfo
+
+func (m *Frame) GetSchemaVersion() uint32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+func (m *Frame) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Frame) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *Frame) GetRouting() Routing {
+	if m != nil {
+		return m.Routing
+	}
+	return Routing{}
+}
+
+// Routing holds the keys subscribers filter the messages by,
+// empty for the messages not related to a single contract execute.
+type Routing struct {
+	// contract defines the address of the contract executed.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// sender defines the address of the sender of the execute.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount defines the amount of the execute, e.g. the offered amount of a swap.
+	Amount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount,omitempty"`
+}
+
+func (m *Routing) Reset()         { *m = Routing{} }
+func (m *Routing) String() string { return proto.CompactTextString(m) }
+func (*Routing) ProtoMessage()    {}
+func (*Routing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b23b5ff7b6d14b5, []int{3}
+}
+func (m *Routing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Routing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Routing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Routing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Routing.Merge(m, src)
+}
+func (m *Routing) XXX_Size() int {
+	return m.Size()
+}
+func (m *Routing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Routing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Routing proto.InternalMessageInfo
+
+func (m *Routing) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Routing) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "terra.feed.v1.SubscribeRequest")
+	proto.RegisterType((*FeedMessage)(nil), "terra.feed.v1.FeedMessage")
+	proto.RegisterType((*Frame)(nil), "terra.feed.v1.Frame")
+	proto.RegisterType((*Routing)(nil), "terra.feed.v1.Routing")
+}
+
+func init() { proto.RegisterFile("terra/feed/v1/feed.proto", fileDescriptor_7b23b5ff7b6d14b5) }
+
+var fileDescriptor_7b23b5ff7b6d14b5 = []byte{
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6b, 0xdb, 0x30,
+	0x14, 0x8e, 0x12, 0x37, 0x59, 0x5e, 0x9b, 0x31, 0x44, 0x29, 0x26, 0x6c, 0x4e, 0x08, 0x6c, 0x84,
+	0x41, 0xed, 0xb6, 0x83, 0xdd, 0x17, 0x46, 0xa0, 0x83, 0x5d, 0x5c, 0xd8, 0x60, 0x97, 0x22, 0xdb,
+	0x6f, 0xae, 0xd9, 0x24, 0x79, 0x92, 0x1c, 0xc8, 0x71, 0x87, 0xdd, 0xfb, 0x07, 0xf6, 0x7f, 0x72,
+	0xec, 0x71, 0xec, 0x10, 0x46, 0xf2, 0x47, 0x86, 0x65, 0xbb, 0xcd, 0x72, 0xdd, 0x49, 0xfa, 0xde,
+	0xf7, 0xf4, 0x9e, 0xbe, 0xef, 0x49, 0xe0, 0x1a, 0x54, 0x8a, 0x05, 0x9f, 0x11, 0x93, 0x60, 0x71,
+	0x6e, 0x57, 0x3f, 0x57, 0xd2, 0x48, 0x3a, 0xb0, 0x8c, 0x6f, 0x23, 0x8b, 0xf3, 0xe1, 0x71, 0x2a,
+	0x53, 0x69, 0x99, 0xa0, 0xdc, 0x55, 0x49, 0x93, 0xef, 0x04, 0x9e, 0x5c, 0x15, 0x91, 0x8e, 0x55,
+	0x16, 0x61, 0x88, 0xdf, 0x0a, 0xd4, 0x86, 0x9e, 0x40, 0xd7, 0xc8, 0x3c, 0x8b, 0xb5, 0x4b, 0xc6,
+	0x9d, 0x69, 0x3f, 0xac, 0x11, 0x7d, 0x0a, 0xfd, 0x58, 0x0a, 0xa3, 0x58, 0x6c, 0xb4, 0xdb, 0xb6,
+	0xd4, 0x43, 0x80, 0xba, 0xd0, 0xd3, 0x28, 0x12, 0x54, 0xda, 0xed, 0x58, 0xae, 0x81, 0xf4, 0x19,
+	0x00, 0xcf, 0xc4, 0x35, 0xe3, 0xb2, 0x10, 0xc6, 0x75, 0xc6, 0xa4, 0x3c, 0xc8, 0x33, 0xf1, 0xc6,
+	0x06, 0x26, 0x1f, 0xe1, 0x70, 0x8e, 0x98, 0xbc, 0x47, 0xad, 0x59, 0x8a, 0xf4, 0x18, 0x0e, 0x6c,
+	0x3f, 0x97, 0xd8, 0xc4, 0x0a, 0x94, 0xd5, 0x73, 0xb6, 0xfc, 0x2a, 0x59, 0xe2, 0xb6, 0xc7, 0x64,
+	0x7a, 0x14, 0x36, 0xb0, 0x64, 0x12, 0x25, 0xf3, 0x1c, 0x13, 0xb7, 0x33, 0x26, 0x53, 0x27, 0x6c,
+	0xe0, 0xe4, 0x96, 0xc0, 0xc1, 0x5c, 0x31, 0x8e, 0xf4, 0x39, 0x3c, 0xd6, 0xf1, 0x0d, 0x72, 0x76,
+	0xbd, 0x40, 0xa5, 0x33, 0x29, 0x6c, 0xf1, 0x41, 0x38, 0xa8, 0xa2, 0x1f, 0xaa, 0xe0, 0x43, 0xeb,
+	0xf6, 0x6e, 0x6b, 0x0a, 0x4e, 0x24, 0x93, 0xa5, 0xad, 0x7e, 0x14, 0xda, 0x3d, 0x7d, 0x0d, 0x3d,
+	0x25, 0x0b, 0x93, 0x89, 0xd4, 0xea, 0x39, 0xbc, 0x38, 0xf1, 0xff, 0xb1, 0xdb, 0x0f, 0x2b, 0x76,
+	0xe6, 0xac, 0xd6, 0xa3, 0x56, 0xd8, 0x24, 0x4f, 0x7e, 0x10, 0xe8, 0xd5, 0x14, 0x1d, 0xc2, 0xa3,
+	0xc6, 0xbd, 0x5a, 0xeb, 0x3d, 0x2e, 0x47, 0x50, 0xb9, 0x57, 0x5f, 0xa5, 0x46, 0x74, 0x0e, 0xdd,
+	0xda, 0xc6, 0xf2, 0x36, 0xfd, 0x99, 0xbf, 0x5a, 0x8f, 0xc8, 0xef, 0xf5, 0xe8, 0x45, 0x9a, 0x99,
+	0x9b, 0x22, 0xf2, 0x63, 0xc9, 0x83, 0x58, 0x6a, 0x2e, 0x75, 0xbd, 0x9c, 0xea, 0xe4, 0x4b, 0x60,
+	0x96, 0x39, 0x6a, 0xff, 0x52, 0x98, 0xb0, 0x3e, 0x7d, 0xf1, 0x93, 0x80, 0x53, 0x9a, 0x4e, 0xdf,
+	0x41, 0xff, 0x7e, 0xfe, 0x74, 0xb4, 0x27, 0x62, 0xff, 0x65, 0x0c, 0x87, 0x7b, 0x09, 0x3b, 0x73,
+	0x3b, 0x23, 0xf4, 0x12, 0xba, 0x57, 0x46, 0x21, 0xe3, 0xff, 0x55, 0x68, 0x4a, 0xce, 0xc8, 0xec,
+	0xed, 0x6a, 0xe3, 0x91, 0xbb, 0x8d, 0x47, 0xfe, 0x6c, 0x3c, 0x72, 0xbb, 0xf5, 0x5a, 0x77, 0x5b,
+	0xaf, 0xf5, 0x6b, 0xeb, 0xb5, 0x3e, 0xbd, 0xdc, 0x51, 0x6a, 0x6b, 0x9c, 0x72, 0x29, 0x70, 0x19,
+	0xc4, 0x52, 0x61, 0xc0, 0xf2, 0xbc, 0xfa, 0x0a, 0x56, 0x71, 0xd4, 0xb5, 0x8f, 0xfc, 0xd5, 0xdf,
+	0x01, 0x00, 0xb0, 0x44, 0x06, 0x2b, 0x25, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// FeedClient is the client API for Feed service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FeedClient interface {
+	// Subscribe streams the feed messages matching the filter of the request
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Feed_SubscribeClient, error)
+	// Stream streams the feed messages matching the filter of the last request
+	// received, subscribers replace their filter by sending a new request
+	Stream(ctx context.Context, opts ...grpc.CallOption) (Feed_StreamClient, error)
+}
+
+type feedClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewFeedClient(cc grpc1.ClientConn) FeedClient {
+	return &feedClient{cc}
+}
+
+func (c *feedClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Feed_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Feed_serviceDesc.Streams[0], "/terra.feed.v1.Feed/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &feedSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Feed_SubscribeClient interface {
+	Recv() (*FeedMessage, error)
+	grpc.ClientStream
+}
+
+type feedSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *feedSubscribeClient) Recv() (*FeedMessage, error) {
+	m := new(FeedMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *feedClient) Stream(ctx context.Context, opts ...grpc.CallOption) (Feed_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Feed_serviceDesc.Streams[1], "/terra.feed.v1.Feed/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &feedStreamClient{stream}
+	return x, nil
+}
+
+type Feed_StreamClient interface {
+	Send(*SubscribeRequest) error
+	Recv() (*FeedMessage, error)
+	grpc.ClientStream
+}
+
+type feedStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *feedStreamClient) Send(m *SubscribeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *feedStreamClient) Recv() (*FeedMessage, error) {
+	m := new(FeedMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FeedServer is the server API for Feed service.
+type FeedServer interface {
+	// Subscribe streams the feed messages matching the filter of the request
+	Subscribe(*SubscribeRequest, Feed_SubscribeServer) error
+	// Stream streams the feed messages matching the filter of the last request
+	// received, subscribers replace their filter by sending a new request
+	Stream(Feed_StreamServer) error
+}
+
+// UnimplementedFeedServer can be embedded to have forward compatible implementations.
+type UnimplementedFeedServer struct {
+}
+
+func (*UnimplementedFeedServer) Subscribe(req *SubscribeRequest, srv Feed_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedFeedServer) Stream(srv Feed_StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+
+func RegisterFeedServer(s grpc1.Server, srv FeedServer) {
+	s.RegisterService(&_Feed_serviceDesc, srv)
+}
+
+func _Feed_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FeedServer).Subscribe(m, &feedSubscribeServer{stream})
+}
+
+type Feed_SubscribeServer interface {
+	Send(*FeedMessage) error
+	grpc.ServerStream
+}
+
+type feedSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *feedSubscribeServer) Send(m *FeedMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Feed_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FeedServer).Stream(&feedStreamServer{stream})
+}
+
+type Feed_StreamServer interface {
+	Send(*FeedMessage) error
+	Recv() (*SubscribeRequest, error)
+	grpc.ServerStream
+}
+
+type feedStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *feedStreamServer) Send(m *FeedMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *feedStreamServer) Recv() (*SubscribeRequest, error) {
+	m := new(SubscribeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Feed_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "terra.feed.v1.Feed",
+	HandlerType: (*FeedServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Feed_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Stream",
+			Handler:       _Feed_Stream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "terra/feed/v1/feed.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinAmount) > 0 {
+		i -= len(m.MinAmount)
+		copy(dAtA[i:], m.MinAmount)
+		i = encodeVarintFeed(dAtA, i, uint64(len(m.MinAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintFeed(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintFeed(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintFeed(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dropped != 0 {
+		i = encodeVarintFeed(dAtA, i, uint64(m.Dropped))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintFeed(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintFeed(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Frame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Frame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Frame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Routing.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeed(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintFeed(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintFeed(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SchemaVersion != 0 {
+		i = encodeVarintFeed(dAtA, i, uint64(m.SchemaVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Routing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Routing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Routing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFeed(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintFeed(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintFeed(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeed(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeed(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovFeed(uint64(l))
+		}
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovFeed(uint64(l))
+		}
+	}
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovFeed(uint64(l))
+		}
+	}
+	l = len(m.MinAmount)
+	if l > 0 {
+		n += 1 + l + sovFeed(uint64(l))
+	}
+	return n
+}
+
+func (m *FeedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovFeed(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovFeed(uint64(l))
+	}
+	if m.Dropped != 0 {
+		n += 1 + sovFeed(uint64(m.Dropped))
+	}
+	return n
+}
+
+func (m *Frame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SchemaVersion != 0 {
+		n += 1 + sovFeed(uint64(m.SchemaVersion))
+	}
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovFeed(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovFeed(uint64(l))
+	}
+	l = m.Routing.Size()
+	n += 1 + l + sovFeed(uint64(l))
+	return n
+}
+
+func (m *Routing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovFeed(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovFeed(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovFeed(uint64(l))
+	}
+	return n
+}
+
+func sovFeed(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeed(x uint64) (n int) {
+	return sovFeed(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dropped", wireType)
+			}
+			m.Dropped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dropped |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Frame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Frame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Frame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
+			}
+			m.SchemaVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Routing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Routing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Routing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Routing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeed(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeed
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeed
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeed
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeed
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeed
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeed        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeed          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeed = fmt.Errorf("proto: unexpected end of group")
+)
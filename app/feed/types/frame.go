@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// SchemaVersion is the version of the feed schema the frames are encoded with;
+// it must be bumped on every breaking change of the topic messages
+const SchemaVersion uint32 = 2
+
+// Feed topics; mirrorSwapStart, terraSwapStart and factorySwap are no longer
+// published, their messages are kept to decode the frames recorded before
+const (
+	TopicCheckTx              = "CheckTx"
+	TopicMirrorSwapStart      = "mirrorSwapStart"
+	TopicMirrorReceiveShuttle = "mirrorReceiveShuttle"
+	TopicTerraSwapStart       = "terraSwapStart"
+	TopicFactorySwap          = "factorySwap"
+	TopicMirrorUpdateAccount  = "mirrorUpdateAccount"
+	TopicTerraUpdateAccount   = "terraAccount"
+	TopicTerraAccount         = "TerraAccount"
+	TopicAncRate              = "ancRate"
+	TopicMirrorUpdateReserve  = "mirrorUpdateReserve"
+	TopicTerraUpdateReserve   = "terraUpdateReserve"
+	TopicDecodedSwap          = "decodedSwap"
+	TopicSwapHop              = "swapHop"
+)
+
+// topicMessages maps each topic to the constructor of its message
+var topicMessages = map[string]func() proto.Message{
+	TopicCheckTx:              func() proto.Message { return &CheckTx{} },
+	TopicMirrorSwapStart:      func() proto.Message { return &MirrorSwapStart{} },
+	TopicMirrorReceiveShuttle: func() proto.Message { return &MirrorReceiveShuttle{} },
+	TopicTerraSwapStart:       func() proto.Message { return &TerraSwapStart{} },
+	TopicFactorySwap:          func() proto.Message { return &FactorySwap{} },
+	TopicMirrorUpdateAccount:  func() proto.Message { return &MirrorUpdateAccount{} },
+	TopicTerraUpdateAccount:   func() proto.Message { return &TerraUpdateAccount{} },
+	TopicTerraAccount:         func() proto.Message { return &TerraAccount{} },
+	TopicAncRate:              func() proto.Message { return &AncRate{} },
+	TopicMirrorUpdateReserve:  func() proto.Message { return &UpdateReserve{} },
+	TopicTerraUpdateReserve:   func() proto.Message { return &UpdateReserve{} },
+	TopicDecodedSwap:          func() proto.Message { return &DecodedSwap{} },
+	TopicSwapHop:              func() proto.Message { return &SwapHop{} },
+}
+
+// NewTopicMessage returns an empty message of the type published on the topic
+func NewTopicMessage(topic string) (proto.Message, error) {
+	newMsg, ok := topicMessages[topic]
+	if !ok {
+		return nil, fmt.Errorf("unknown feed topic: %s", topic)
+	}
+
+	return newMsg(), nil
+}
+
+// NewFrame wraps the message of the topic into a frame of the current schema version
+func NewFrame(topic string, msg proto.Message) (Frame, error) {
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return Frame{}, err
+	}
+
+	return Frame{
+		SchemaVersion: SchemaVersion,
+		Topic:         topic,
+		Body:          body,
+	}, nil
+}
+
+// DecodeFrame decodes the frame and returns the message of its topic,
+// the frame is rejected if it was encoded with another schema version
+func DecodeFrame(bz []byte) (Frame, proto.Message, error) {
+	var frame Frame
+	if err := proto.Unmarshal(bz, &frame); err != nil {
+		return Frame{}, nil, err
+	}
+
+	if frame.SchemaVersion != SchemaVersion {
+		return frame, nil, fmt.Errorf("unsupported feed schema version: %d, expected %d", frame.SchemaVersion, SchemaVersion)
+	}
+
+	msg, err := NewTopicMessage(frame.Topic)
+	if err != nil {
+		return frame, nil, err
+	}
+
+	if err := proto.Unmarshal(frame.Body, msg); err != nil {
+		return frame, nil, err
+	}
+
+	return frame, msg, nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFrameRoundTrip(t *testing.T) {
+	msg := &TerraSwapStart{
+		Data: SwapIntent{
+			PairName: "UST-LUNA",
+			AssetIn:  "UST",
+			Amount:   sdk.NewInt(1000000),
+		},
+		Hash: "ABCD",
+	}
+
+	frame, err := NewFrame(TopicTerraSwapStart, msg)
+	require.NoError(t, err)
+	require.Equal(t, SchemaVersion, frame.SchemaVersion)
+
+	bz, err := frame.Marshal()
+	require.NoError(t, err)
+
+	decodedFrame, decodedMsg, err := DecodeFrame(bz)
+	require.NoError(t, err)
+	require.Equal(t, TopicTerraSwapStart, decodedFrame.Topic)
+	require.Equal(t, msg, decodedMsg)
+}
+
+func TestDecodeFrameRejectsUnknown(t *testing.T) {
+	frame := Frame{SchemaVersion: SchemaVersion + 1, Topic: TopicAncRate}
+	bz, err := proto.Marshal(&frame)
+	require.NoError(t, err)
+
+	_, _, err = DecodeFrame(bz)
+	require.Error(t, err)
+
+	frame = Frame{SchemaVersion: SchemaVersion, Topic: "unknown"}
+	bz, err = proto.Marshal(&frame)
+	require.NoError(t, err)
+
+	_, _, err = DecodeFrame(bz)
+	require.Error(t, err)
+}
@@ -0,0 +1,6197 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: terra/feed/v1/topics.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MirrorSwapType defines how a mirror swap was submitted
+type MirrorSwapType int32
+
+const (
+	// MIRROR_SWAP_TYPE_UNSPECIFIED defines an unknown swap type
+	MirrorSwapTypeUnspecified MirrorSwapType = 0
+	// MIRROR_SWAP_TYPE_NORMAL defines a swap executed on a pair contract
+	MirrorSwapTypeNormal MirrorSwapType = 1
+	// MIRROR_SWAP_TYPE_MINT defines a short position minted and sold on a pair contract
+	MirrorSwapTypeMint MirrorSwapType = 2
+)
+
+var MirrorSwapType_name = map[int32]string{
+	0: "MIRROR_SWAP_TYPE_UNSPECIFIED",
+	1: "MIRROR_SWAP_TYPE_NORMAL",
+	2: "MIRROR_SWAP_TYPE_MINT",
+}
+
+var MirrorSwapType_value = map[string]int32{
+	"MIRROR_SWAP_TYPE_UNSPECIFIED": 0,
+	"MIRROR_SWAP_TYPE_NORMAL":      1,
+	"MIRROR_SWAP_TYPE_MINT":        2,
+}
+
+func (x MirrorSwapType) String() string {
+	return proto.EnumName(MirrorSwapType_name, int32(x))
+}
+
+func (MirrorSwapType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{0}
+}
+
+// FactoryType defines the dex the factory swap is routed through
+type FactoryType int32
+
+const (
+	// FACTORY_TYPE_UNSPECIFIED defines an unknown factory
+	FactoryTypeUnspecified FactoryType = 0
+	// FACTORY_TYPE_TERRA defines the terraswap factory
+	FactoryTypeTerra FactoryType = 1
+	// FACTORY_TYPE_ASTRO defines the astroport factory
+	FactoryTypeAstro FactoryType = 2
+)
+
+var FactoryType_name = map[int32]string{
+	0: "FACTORY_TYPE_UNSPECIFIED",
+	1: "FACTORY_TYPE_TERRA",
+	2: "FACTORY_TYPE_ASTRO",
+}
+
+var FactoryType_value = map[string]int32{
+	"FACTORY_TYPE_UNSPECIFIED": 0,
+	"FACTORY_TYPE_TERRA":       1,
+	"FACTORY_TYPE_ASTRO":       2,
+}
+
+func (x FactoryType) String() string {
+	return proto.EnumName(FactoryType_name, int32(x))
+}
+
+func (FactoryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{1}
+}
+
+// SwapIntent defines a pending swap against a pair contract
+type SwapIntent struct {
+	PairName  string                                 `protobuf:"bytes,1,opt,name=pair_name,json=pairName,proto3" json:"pair_name,omitempty"`
+	AssetIn   string                                 `protobuf:"bytes,2,opt,name=asset_in,json=assetIn,proto3" json:"asset_in,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	MaxSpread string                                 `protobuf:"bytes,4,opt,name=max_spread,json=maxSpread,proto3" json:"max_spread,omitempty"`
+	Price     string                                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *SwapIntent) Reset()         { *m = SwapIntent{} }
+func (m *SwapIntent) String() string { return proto.CompactTextString(m) }
+func (*SwapIntent) ProtoMessage()    {}
+func (*SwapIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{0}
+}
+func (m *SwapIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapIntent.Merge(m, src)
+}
+func (m *SwapIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapIntent proto.InternalMessageInfo
+
+func (m *SwapIntent) GetPairName() string {
+	if m != nil {
+		return m.PairName
+	}
+	return ""
+}
+
+func (m *SwapIntent) GetAssetIn() string {
+	if m != nil {
+		return m.AssetIn
+	}
+	return ""
+}
+
+func (m *SwapIntent) GetMaxSpread() string {
+	if m != nil {
+		return m.MaxSpread
+	}
+	return ""
+}
+
+func (m *SwapIntent) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+// Attribute defines a key/value attribute of an event
+type Attribute struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Attribute) Reset()         { *m = Attribute{} }
+func (m *Attribute) String() string { return proto.CompactTextString(m) }
+func (*Attribute) ProtoMessage()    {}
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{1}
+}
+func (m *Attribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attribute.Merge(m, src)
+}
+func (m *Attribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attribute proto.InternalMessageInfo
+
+func (m *Attribute) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Attribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// WasmEvent defines an event emitted by a contract
+type WasmEvent struct {
+	Type            string      `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ContractAddress string      `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Attributes      []Attribute `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes"`
+}
+
+func (m *WasmEvent) Reset()         { *m = WasmEvent{} }
+func (m *WasmEvent) String() string { return proto.CompactTextString(m) }
+func (*WasmEvent) ProtoMessage()    {}
+func (*WasmEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{2}
+}
+func (m *WasmEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WasmEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WasmEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WasmEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmEvent.Merge(m, src)
+}
+func (m *WasmEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *WasmEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmEvent proto.InternalMessageInfo
+
+func (m *WasmEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WasmEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *WasmEvent) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// Simulation defines the predicted outcome of a pending tx, simulated
+// on a branch of the CheckTx state the tx was accepted on
+type Simulation struct {
+	// error defines the reason the tx failed in simulation, empty on success
+	Error   string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// wasm_events defines the events emitted by the contracts
+	WasmEvents []WasmEvent `protobuf:"bytes,3,rep,name=wasm_events,json=wasmEvents,proto3" json:"wasm_events"`
+	// return_amount defines the amount returned by the last swap of the tx
+	ReturnAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=return_amount,json=returnAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"return_amount"`
+	// reserves defines the reserves of the pairs swapped on after the tx
+	Reserves []PoolReserve `protobuf:"bytes,5,rep,name=reserves,proto3" json:"reserves"`
+}
+
+func (m *Simulation) Reset()         { *m = Simulation{} }
+func (m *Simulation) String() string { return proto.CompactTextString(m) }
+func (*Simulation) ProtoMessage()    {}
+func (*Simulation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{3}
+}
+func (m *Simulation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Simulation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Simulation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Simulation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Simulation.Merge(m, src)
+}
+func (m *Simulation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Simulation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Simulation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Simulation proto.InternalMessageInfo
+
+func (m *Simulation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *Simulation) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *Simulation) GetWasmEvents() []WasmEvent {
+	if m != nil {
+		return m.WasmEvents
+	}
+	return nil
+}
+
+func (m *Simulation) GetReserves() []PoolReserve {
+	if m != nil {
+		return m.Reserves
+	}
+	return nil
+}
+
+// CheckTx is published on the CheckTx topic for every tx entering the mempool
+type CheckTx struct {
+	Tx   []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *CheckTx) Reset()         { *m = CheckTx{} }
+func (m *CheckTx) String() string { return proto.CompactTextString(m) }
+func (*CheckTx) ProtoMessage()    {}
+func (*CheckTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{4}
+}
+func (m *CheckTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckTx.Merge(m, src)
+}
+func (m *CheckTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckTx proto.InternalMessageInfo
+
+func (m *CheckTx) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *CheckTx) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// MirrorSwapStart was published on the mirrorSwapStart topic, the Mirror swaps are
+// published on the decodedSwap topic by the default decoders since
+type MirrorSwapStart struct {
+	Data  SwapIntent     `protobuf:"bytes,1,opt,name=data,proto3" json:"data"`
+	Enemy bool           `protobuf:"varint,2,opt,name=enemy,proto3" json:"enemy,omitempty"`
+	Type  MirrorSwapType `protobuf:"varint,3,opt,name=type,proto3,enum=terra.feed.v1.MirrorSwapType" json:"type,omitempty"`
+	Hash  string         `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// simulation defines the predicted outcome of the tx, set when simulation is enabled
+	Simulation *Simulation `protobuf:"bytes,5,opt,name=simulation,proto3" json:"simulation,omitempty"`
+}
+
+func (m *MirrorSwapStart) Reset()         { *m = MirrorSwapStart{} }
+func (m *MirrorSwapStart) String() string { return proto.CompactTextString(m) }
+func (*MirrorSwapStart) ProtoMessage()    {}
+func (*MirrorSwapStart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{5}
+}
+func (m *MirrorSwapStart) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MirrorSwapStart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MirrorSwapStart.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MirrorSwapStart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorSwapStart.Merge(m, src)
+}
+func (m *MirrorSwapStart) XXX_Size() int {
+	return m.Size()
+}
+func (m *MirrorSwapStart) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorSwapStart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorSwapStart proto.InternalMessageInfo
+
+func (m *MirrorSwapStart) GetData() SwapIntent {
+	if m != nil {
+		return m.Data
+	}
+	return SwapIntent{}
+}
+
+func (m *MirrorSwapStart) GetEnemy() bool {
+	if m != nil {
+		return m.Enemy
+	}
+	return false
+}
+
+func (m *MirrorSwapStart) GetType() MirrorSwapType {
+	if m != nil {
+		return m.Type
+	}
+	return MirrorSwapTypeUnspecified
+}
+
+func (m *MirrorSwapStart) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MirrorSwapStart) GetSimulation() *Simulation {
+	if m != nil {
+		return m.Simulation
+	}
+	return nil
+}
+
+// MirrorReceiveShuttle is published on the mirrorReceiveShuttle topic
+type MirrorReceiveShuttle struct {
+	AssetName string                                 `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Hash      string                                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *MirrorReceiveShuttle) Reset()         { *m = MirrorReceiveShuttle{} }
+func (m *MirrorReceiveShuttle) String() string { return proto.CompactTextString(m) }
+func (*MirrorReceiveShuttle) ProtoMessage()    {}
+func (*MirrorReceiveShuttle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{6}
+}
+func (m *MirrorReceiveShuttle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MirrorReceiveShuttle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MirrorReceiveShuttle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MirrorReceiveShuttle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorReceiveShuttle.Merge(m, src)
+}
+func (m *MirrorReceiveShuttle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MirrorReceiveShuttle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorReceiveShuttle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorReceiveShuttle proto.InternalMessageInfo
+
+func (m *MirrorReceiveShuttle) GetAssetName() string {
+	if m != nil {
+		return m.AssetName
+	}
+	return ""
+}
+
+func (m *MirrorReceiveShuttle) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// TerraSwapStart was published on the terraSwapStart topic, the Terraswap swaps are
+// published on the decodedSwap topic by the default decoders since
+type TerraSwapStart struct {
+	Data SwapIntent `protobuf:"bytes,1,opt,name=data,proto3" json:"data"`
+	Hash string     `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// simulation defines the predicted outcome of the tx, set when simulation is enabled
+	Simulation *Simulation `protobuf:"bytes,3,opt,name=simulation,proto3" json:"simulation,omitempty"`
+}
+
+func (m *TerraSwapStart) Reset()         { *m = TerraSwapStart{} }
+func (m *TerraSwapStart) String() string { return proto.CompactTextString(m) }
+func (*TerraSwapStart) ProtoMessage()    {}
+func (*TerraSwapStart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{7}
+}
+func (m *TerraSwapStart) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerraSwapStart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerraSwapStart.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TerraSwapStart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerraSwapStart.Merge(m, src)
+}
+func (m *TerraSwapStart) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerraSwapStart) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerraSwapStart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerraSwapStart proto.InternalMessageInfo
+
+func (m *TerraSwapStart) GetData() SwapIntent {
+	if m != nil {
+		return m.Data
+	}
+	return SwapIntent{}
+}
+
+func (m *TerraSwapStart) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TerraSwapStart) GetSimulation() *Simulation {
+	if m != nil {
+		return m.Simulation
+	}
+	return nil
+}
+
+// FactorySwap was published on the factorySwap topic, the swaps routed by the
+// factories are published on the swapHop topic since
+type FactorySwap struct {
+	Type FactoryType `protobuf:"varint,1,opt,name=type,proto3,enum=terra.feed.v1.FactoryType" json:"type,omitempty"`
+	// msg defines the base64 encoded execute msg sent to the factory
+	Msg    string                                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Hash   string                                 `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// simulation defines the predicted outcome of the tx, set when simulation is enabled
+	Simulation *Simulation `protobuf:"bytes,5,opt,name=simulation,proto3" json:"simulation,omitempty"`
+}
+
+func (m *FactorySwap) Reset()         { *m = FactorySwap{} }
+func (m *FactorySwap) String() string { return proto.CompactTextString(m) }
+func (*FactorySwap) ProtoMessage()    {}
+func (*FactorySwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{8}
+}
+func (m *FactorySwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FactorySwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FactorySwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FactorySwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactorySwap.Merge(m, src)
+}
+func (m *FactorySwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *FactorySwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactorySwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactorySwap proto.InternalMessageInfo
+
+func (m *FactorySwap) GetType() FactoryType {
+	if m != nil {
+		return m.Type
+	}
+	return FactoryTypeUnspecified
+}
+
+func (m *FactorySwap) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *FactorySwap) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *FactorySwap) GetSimulation() *Simulation {
+	if m != nil {
+		return m.Simulation
+	}
+	return nil
+}
+
+// Balance defines the balance of a wallet in an asset
+type Balance struct {
+	Asset  string                                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *Balance) Reset()         { *m = Balance{} }
+func (m *Balance) String() string { return proto.CompactTextString(m) }
+func (*Balance) ProtoMessage()    {}
+func (*Balance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{9}
+}
+func (m *Balance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Balance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Balance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Balance.Merge(m, src)
+}
+func (m *Balance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Balance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Balance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Balance proto.InternalMessageInfo
+
+func (m *Balance) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+// BlockUpdate defines the position of a block level update in its topic
+type BlockUpdate struct {
+	// height defines the height of the block the update was taken at
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// snapshot defines whether the update holds every value of the topic,
+	// otherwise it only holds the values changed since the previous update
+	Snapshot bool `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// sequence defines the position of the update in its topic, it grows by
+	// one with every update so consumers can detect a missed update
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *BlockUpdate) Reset()         { *m = BlockUpdate{} }
+func (m *BlockUpdate) String() string { return proto.CompactTextString(m) }
+func (*BlockUpdate) ProtoMessage()    {}
+func (*BlockUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{10}
+}
+func (m *BlockUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockUpdate.Merge(m, src)
+}
+func (m *BlockUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockUpdate proto.InternalMessageInfo
+
+func (m *BlockUpdate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockUpdate) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
+	}
+	return false
+}
+
+func (m *BlockUpdate) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// MirrorUpdateAccount is published on the mirrorUpdateAccount topic
+type MirrorUpdateAccount struct {
+	Balances []Balance   `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances"`
+	Sequence uint64      `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Update   BlockUpdate `protobuf:"bytes,4,opt,name=update,proto3" json:"update"`
+}
+
+func (m *MirrorUpdateAccount) Reset()         { *m = MirrorUpdateAccount{} }
+func (m *MirrorUpdateAccount) String() string { return proto.CompactTextString(m) }
+func (*MirrorUpdateAccount) ProtoMessage()    {}
+func (*MirrorUpdateAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{11}
+}
+func (m *MirrorUpdateAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MirrorUpdateAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MirrorUpdateAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MirrorUpdateAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorUpdateAccount.Merge(m, src)
+}
+func (m *MirrorUpdateAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MirrorUpdateAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorUpdateAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorUpdateAccount proto.InternalMessageInfo
+
+func (m *MirrorUpdateAccount) GetBalances() []Balance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *MirrorUpdateAccount) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MirrorUpdateAccount) GetUpdate() BlockUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return BlockUpdate{}
+}
+
+// TerraUpdateAccount is published on the terraAccount topic
+type TerraUpdateAccount struct {
+	Contract github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=contract,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"contract"`
+	Account  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=account,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"account"`
+	Sequence uint64                                 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Update   BlockUpdate                            `protobuf:"bytes,4,opt,name=update,proto3" json:"update"`
+}
+
+func (m *TerraUpdateAccount) Reset()         { *m = TerraUpdateAccount{} }
+func (m *TerraUpdateAccount) String() string { return proto.CompactTextString(m) }
+func (*TerraUpdateAccount) ProtoMessage()    {}
+func (*TerraUpdateAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{12}
+}
+func (m *TerraUpdateAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerraUpdateAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerraUpdateAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TerraUpdateAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerraUpdateAccount.Merge(m, src)
+}
+func (m *TerraUpdateAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerraUpdateAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerraUpdateAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerraUpdateAccount proto.InternalMessageInfo
+
+func (m *TerraUpdateAccount) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TerraUpdateAccount) GetUpdate() BlockUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return BlockUpdate{}
+}
+
+// TerraAccount is published on the TerraAccount topic
+type TerraAccount struct {
+	Account *types.Any `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *TerraAccount) Reset()         { *m = TerraAccount{} }
+func (m *TerraAccount) String() string { return proto.CompactTextString(m) }
+func (*TerraAccount) ProtoMessage()    {}
+func (*TerraAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{13}
+}
+func (m *TerraAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerraAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerraAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TerraAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerraAccount.Merge(m, src)
+}
+func (m *TerraAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerraAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerraAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerraAccount proto.InternalMessageInfo
+
+func (m *TerraAccount) GetAccount() *types.Any {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+// AncRate is published on the ancRate topic
+type AncRate struct {
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+}
+
+func (m *AncRate) Reset()         { *m = AncRate{} }
+func (m *AncRate) String() string { return proto.CompactTextString(m) }
+func (*AncRate) ProtoMessage()    {}
+func (*AncRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{14}
+}
+func (m *AncRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AncRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AncRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AncRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AncRate.Merge(m, src)
+}
+func (m *AncRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *AncRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_AncRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AncRate proto.InternalMessageInfo
+
+// PoolReserve defines the reserves of a pair contract
+type PoolReserve struct {
+	PairName string    `protobuf:"bytes,1,opt,name=pair_name,json=pairName,proto3" json:"pair_name,omitempty"`
+	Reserves []Balance `protobuf:"bytes,2,rep,name=reserves,proto3" json:"reserves"`
+}
+
+func (m *PoolReserve) Reset()         { *m = PoolReserve{} }
+func (m *PoolReserve) String() string { return proto.CompactTextString(m) }
+func (*PoolReserve) ProtoMessage()    {}
+func (*PoolReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{15}
+}
+func (m *PoolReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolReserve.Merge(m, src)
+}
+func (m *PoolReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolReserve proto.InternalMessageInfo
+
+func (m *PoolReserve) GetPairName() string {
+	if m != nil {
+		return m.PairName
+	}
+	return ""
+}
+
+func (m *PoolReserve) GetReserves() []Balance {
+	if m != nil {
+		return m.Reserves
+	}
+	return nil
+}
+
+// UpdateReserve is published on the mirrorUpdateReserve and terraUpdateReserve topics
+type UpdateReserve struct {
+	Pools  []PoolReserve `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+	Update BlockUpdate   `protobuf:"bytes,2,opt,name=update,proto3" json:"update"`
+}
+
+func (m *UpdateReserve) Reset()         { *m = UpdateReserve{} }
+func (m *UpdateReserve) String() string { return proto.CompactTextString(m) }
+func (*UpdateReserve) ProtoMessage()    {}
+func (*UpdateReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{16}
+}
+func (m *UpdateReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateReserve.Merge(m, src)
+}
+func (m *UpdateReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateReserve proto.InternalMessageInfo
+
+func (m *UpdateReserve) GetPools() []PoolReserve {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *UpdateReserve) GetUpdate() BlockUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return BlockUpdate{}
+}
+
+// DecodedSwap is published on the decodedSwap topic for every contract execute
+// matched by a decoder of the registry
+type DecodedSwap struct {
+	// decoder defines the name of the decoder the execute was matched by
+	Decoder  string     `protobuf:"bytes,1,opt,name=decoder,proto3" json:"decoder,omitempty"`
+	Contract string     `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Sender   string     `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Data     SwapIntent `protobuf:"bytes,4,opt,name=data,proto3" json:"data"`
+	Hash     string     `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	// simulation defines the predicted outcome of the tx, set when simulation is enabled
+	Simulation *Simulation `protobuf:"bytes,6,opt,name=simulation,proto3" json:"simulation,omitempty"`
+}
+
+func (m *DecodedSwap) Reset()         { *m = DecodedSwap{} }
+func (m *DecodedSwap) String() string { return proto.CompactTextString(m) }
+func (*DecodedSwap) ProtoMessage()    {}
+func (*DecodedSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{17}
+}
+func (m *DecodedSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodedSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecodedSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedSwap.Merge(m, src)
+}
+func (m *DecodedSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedSwap proto.InternalMessageInfo
+
+func (m *DecodedSwap) GetDecoder() string {
+	if m != nil {
+		return m.Decoder
+	}
+	return ""
+}
+
+func (m *DecodedSwap) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *DecodedSwap) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *DecodedSwap) GetData() SwapIntent {
+	if m != nil {
+		return m.Data
+	}
+	return SwapIntent{}
+}
+
+func (m *DecodedSwap) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *DecodedSwap) GetSimulation() *Simulation {
+	if m != nil {
+		return m.Simulation
+	}
+	return nil
+}
+
+// SwapHop is published on the swapHop topic for every hop of the swaps of a
+// pending tx, found in contract executes also when wrapped in authz MsgExec,
+// cw20 send hooks or router execute_swap_operations
+type SwapHop struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// msg_index defines the index of the top level msg of the tx the hop is found in
+	MsgIndex uint32 `protobuf:"varint,2,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// wrappers defines the wrappers the hop is found in, outermost first:
+	// msg type URLs, e.g. /cosmos.authz.v1beta1.MsgExec, or cw20_send
+	Wrappers []string `protobuf:"bytes,3,rep,name=wrappers,proto3" json:"wrappers,omitempty"`
+	// contract defines the pair or router executing the hop
+	Contract string `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty"`
+	Sender   string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// operation defines "swap" for a pair or the operation of a router,
+	// e.g. terra_swap, astro_swap or native_swap
+	Operation string `protobuf:"bytes,6,opt,name=operation,proto3" json:"operation,omitempty"`
+	// hop_index defines the position of the hop in the route of hops hops
+	HopIndex uint32 `protobuf:"varint,7,opt,name=hop_index,json=hopIndex,proto3" json:"hop_index,omitempty"`
+	Hops     uint32 `protobuf:"varint,8,opt,name=hops,proto3" json:"hops,omitempty"`
+	// offer_asset and ask_asset define denoms or token addresses, empty when not given by the msg
+	OfferAsset string `protobuf:"bytes,9,opt,name=offer_asset,json=offerAsset,proto3" json:"offer_asset,omitempty"`
+	AskAsset   string `protobuf:"bytes,10,opt,name=ask_asset,json=askAsset,proto3" json:"ask_asset,omitempty"`
+	// amount defines the offered amount, zero when only known once the previous hops are executed
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	BeliefPrice    string                                 `protobuf:"bytes,12,opt,name=belief_price,json=beliefPrice,proto3" json:"belief_price,omitempty"`
+	MaxSpread      string                                 `protobuf:"bytes,13,opt,name=max_spread,json=maxSpread,proto3" json:"max_spread,omitempty"`
+	MinimumReceive string                                 `protobuf:"bytes,14,opt,name=minimum_receive,json=minimumReceive,proto3" json:"minimum_receive,omitempty"`
+	// simulation defines the predicted outcome of the tx, set when simulation is enabled
+	Simulation *Simulation `protobuf:"bytes,15,opt,name=simulation,proto3" json:"simulation,omitempty"`
+}
+
+func (m *SwapHop) Reset()         { *m = SwapHop{} }
+func (m *SwapHop) String() string { return proto.CompactTextString(m) }
+func (*SwapHop) ProtoMessage()    {}
+func (*SwapHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a93ae3e8261c834, []int{18}
+}
+func (m *SwapHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapHop.Merge(m, src)
+}
+func (m *SwapHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapHop proto.InternalMessageInfo
+
+func (m *SwapHop) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SwapHop) GetMsgIndex() uint32 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *SwapHop) GetWrappers() []string {
+	if m != nil {
+		return m.Wrappers
+	}
+	return nil
+}
+
+func (m *SwapHop) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *SwapHop) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *SwapHop) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *SwapHop) GetHopIndex() uint32 {
+	if m != nil {
+		return m.HopIndex
+	}
+	return 0
+}
+
+func (m *SwapHop) GetHops() uint32 {
+	if m != nil {
+		return m.Hops
+	}
+	return 0
+}
+
+func (m *SwapHop) GetOfferAsset() string {
+	if m != nil {
+		return m.OfferAsset
+	}
+	return ""
+}
+
+func (m *SwapHop) GetAskAsset() string {
+	if m != nil {
+		return m.AskAsset
+	}
+	return ""
+}
+
+func (m *SwapHop) GetBeliefPrice() string {
+	if m != nil {
+		return m.BeliefPrice
+	}
+	return ""
+}
+
+func (m *SwapHop) GetMaxSpread() string {
+	if m != nil {
+		return m.MaxSpread
+	}
+	return ""
+}
+
+func (m *SwapHop) GetMinimumReceive() string {
+	if m != nil {
+		return m.MinimumReceive
+	}
+	return ""
+}
+
+func (m *SwapHop) GetSimulation() *Simulation {
+	if m != nil {
+		return m.Simulation
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("terra.feed.v1.MirrorSwapType", MirrorSwapType_name, MirrorSwapType_value)
+	proto.RegisterEnum("terra.feed.v1.FactoryType", FactoryType_name, FactoryType_value)
+	proto.RegisterType((*SwapIntent)(nil), "terra.feed.v1.SwapIntent")
+	proto.RegisterType((*Attribute)(nil), "terra.feed.v1.Attribute")
+	proto.RegisterType((*WasmEvent)(nil), "terra.feed.v1.WasmEvent")
+	proto.RegisterType((*Simulation)(nil), "terra.feed.v1.Simulation")
+	proto.RegisterType((*CheckTx)(nil), "terra.feed.v1.CheckTx")
+	proto.RegisterType((*MirrorSwapStart)(nil), "terra.feed.v1.MirrorSwapStart")
+	proto.RegisterType((*MirrorReceiveShuttle)(nil), "terra.feed.v1.MirrorReceiveShuttle")
+	proto.RegisterType((*TerraSwapStart)(nil), "terra.feed.v1.TerraSwapStart")
+	proto.RegisterType((*FactorySwap)(nil), "terra.feed.v1.FactorySwap")
+	proto.RegisterType((*Balance)(nil), "terra.feed.v1.Balance")
+	proto.RegisterType((*BlockUpdate)(nil), "terra.feed.v1.BlockUpdate")
+	proto.RegisterType((*MirrorUpdateAccount)(nil), "terra.feed.v1.MirrorUpdateAccount")
+	proto.RegisterType((*TerraUpdateAccount)(nil), "terra.feed.v1.TerraUpdateAccount")
+	proto.RegisterType((*TerraAccount)(nil), "terra.feed.v1.TerraAccount")
+	proto.RegisterType((*AncRate)(nil), "terra.feed.v1.AncRate")
+	proto.RegisterType((*PoolReserve)(nil), "terra.feed.v1.PoolReserve")
+	proto.RegisterType((*UpdateReserve)(nil), "terra.feed.v1.UpdateReserve")
+	proto.RegisterType((*DecodedSwap)(nil), "terra.feed.v1.DecodedSwap")
+	proto.RegisterType((*SwapHop)(nil), "terra.feed.v1.SwapHop")
+}
+
+func init() { proto.RegisterFile("terra/feed/v1/topics.proto", fileDescriptor_7a93ae3e8261c834) }
+
+var fileDescriptor_7a93ae3e8261c834 = []byte{
+	// 1405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcb, 0x6f, 0xdb, 0x46,
+	0x13, 0x37, 0x25, 0xda, 0x92, 0x46, 0x7e, 0x08, 0xfb, 0xf9, 0xf3, 0x47, 0x2b, 0x89, 0xe3, 0x4f,
+	0x87, 0x36, 0x0d, 0x1a, 0x09, 0x4e, 0xd0, 0xc2, 0x05, 0x8a, 0x04, 0x74, 0x6c, 0x23, 0x0a, 0xea,
+	0x07, 0x28, 0x19, 0x41, 0x0a, 0xb4, 0xc4, 0x9a, 0x5c, 0x4b, 0x84, 0x45, 0x2e, 0xcb, 0x5d, 0xd9,
+	0xd6, 0xad, 0x3d, 0x14, 0x28, 0x7c, 0x6a, 0x80, 0x5e, 0x7d, 0xea, 0xa9, 0xe8, 0x5f, 0x91, 0x5b,
+	0x8e, 0x39, 0x16, 0x3d, 0xa4, 0x45, 0x02, 0xf4, 0xef, 0x28, 0x76, 0x97, 0xa4, 0x28, 0x39, 0x49,
+	0x6b, 0x27, 0x27, 0x71, 0x66, 0xe7, 0xf1, 0x9b, 0xc7, 0xce, 0x8e, 0xa0, 0xca, 0x49, 0x14, 0xe1,
+	0xc6, 0x01, 0x21, 0x6e, 0xe3, 0x68, 0xa5, 0xc1, 0x69, 0xe8, 0x39, 0xac, 0x1e, 0x46, 0x94, 0x53,
+	0x34, 0x23, 0xcf, 0xea, 0xe2, 0xac, 0x7e, 0xb4, 0x52, 0x9d, 0xef, 0xd0, 0x0e, 0x95, 0x27, 0x0d,
+	0xf1, 0xa5, 0x84, 0xaa, 0x8b, 0x1d, 0x4a, 0x3b, 0x3d, 0xd2, 0x90, 0xd4, 0x7e, 0xff, 0xa0, 0x81,
+	0x83, 0x81, 0x3a, 0xaa, 0x3d, 0xd5, 0x00, 0x5a, 0xc7, 0x38, 0x6c, 0x06, 0x9c, 0x04, 0x1c, 0x5d,
+	0x81, 0x52, 0x88, 0xbd, 0xc8, 0x0e, 0xb0, 0x4f, 0x0c, 0x6d, 0x59, 0xbb, 0x51, 0xb2, 0x8a, 0x82,
+	0xb1, 0x8d, 0x7d, 0x82, 0x16, 0xa1, 0x88, 0x19, 0x23, 0xdc, 0xf6, 0x02, 0x23, 0x27, 0xcf, 0x0a,
+	0x92, 0x6e, 0x06, 0x68, 0x13, 0xa6, 0xb0, 0x4f, 0xfb, 0x01, 0x37, 0xf2, 0xe2, 0x60, 0xad, 0xfe,
+	0xec, 0xc5, 0xf5, 0x89, 0xdf, 0x5f, 0x5c, 0xff, 0xa0, 0xe3, 0xf1, 0x6e, 0x7f, 0xbf, 0xee, 0x50,
+	0xbf, 0xe1, 0x50, 0xe6, 0x53, 0x16, 0xff, 0xdc, 0x62, 0xee, 0x61, 0x83, 0x0f, 0x42, 0xc2, 0xea,
+	0xcd, 0x80, 0x5b, 0xb1, 0x36, 0xba, 0x06, 0xe0, 0xe3, 0x13, 0x9b, 0x85, 0x11, 0xc1, 0xae, 0xa1,
+	0x4b, 0x27, 0x25, 0x1f, 0x9f, 0xb4, 0x24, 0x03, 0xcd, 0xc3, 0x64, 0x18, 0x79, 0x0e, 0x31, 0x26,
+	0xe5, 0x89, 0x22, 0x6a, 0x77, 0xa0, 0x64, 0x72, 0x1e, 0x79, 0xfb, 0x7d, 0x4e, 0x50, 0x05, 0xf2,
+	0x87, 0x64, 0x10, 0x63, 0x17, 0x9f, 0x42, 0xe9, 0x08, 0xf7, 0xfa, 0x24, 0xc6, 0xac, 0x88, 0xda,
+	0xa9, 0x06, 0xa5, 0x47, 0x98, 0xf9, 0x1b, 0x47, 0x22, 0x6e, 0x04, 0xba, 0x00, 0x13, 0xab, 0xc9,
+	0x6f, 0xf4, 0x11, 0x54, 0x1c, 0x1a, 0xf0, 0x08, 0x3b, 0xdc, 0xc6, 0xae, 0x1b, 0x11, 0xc6, 0x62,
+	0x13, 0x73, 0x09, 0xdf, 0x54, 0x6c, 0x74, 0x17, 0x00, 0x27, 0x08, 0x98, 0x91, 0x5f, 0xce, 0xdf,
+	0x28, 0xdf, 0x36, 0xea, 0x23, 0xa5, 0xa9, 0xa7, 0x10, 0xd7, 0x74, 0x91, 0x1c, 0x2b, 0xa3, 0x51,
+	0x7b, 0x92, 0x03, 0x68, 0x79, 0x7e, 0xbf, 0x87, 0xb9, 0x47, 0x03, 0x81, 0x98, 0x44, 0x11, 0x8d,
+	0x62, 0x38, 0x8a, 0x10, 0xe9, 0xef, 0x60, 0x66, 0xf7, 0x19, 0x71, 0x25, 0x0e, 0xdd, 0x2a, 0x74,
+	0x30, 0xdb, 0x63, 0xc4, 0x45, 0xf7, 0xa0, 0x7c, 0x8c, 0x99, 0x6f, 0x13, 0x11, 0xcc, 0x9b, 0x00,
+	0xa4, 0xd1, 0x26, 0x00, 0x8e, 0x13, 0x06, 0x43, 0x2d, 0x98, 0x89, 0x08, 0xef, 0x47, 0x81, 0x1d,
+	0x97, 0x51, 0xbf, 0x54, 0x19, 0xa7, 0x95, 0x11, 0x53, 0x15, 0xf3, 0x73, 0x28, 0x46, 0x84, 0x91,
+	0xe8, 0x88, 0x30, 0x63, 0x52, 0x42, 0xaa, 0x8e, 0x41, 0xda, 0xa5, 0xb4, 0x67, 0x29, 0x91, 0x18,
+	0x54, 0xaa, 0x51, 0xbb, 0x05, 0x85, 0xfb, 0x5d, 0xe2, 0x1c, 0xb6, 0x4f, 0xd0, 0x2c, 0xe4, 0xf8,
+	0x89, 0x4c, 0xc6, 0xb4, 0x95, 0xe3, 0x27, 0xa2, 0x5a, 0x5d, 0xcc, 0xba, 0x71, 0x35, 0xe4, 0x77,
+	0xed, 0x85, 0x06, 0x73, 0x5b, 0x9e, 0x48, 0x94, 0x68, 0xe7, 0x16, 0xc7, 0x11, 0x47, 0x77, 0x40,
+	0x77, 0x31, 0xc7, 0x52, 0xb3, 0x7c, 0x7b, 0x71, 0xcc, 0xf9, 0xb0, 0xed, 0x63, 0xdf, 0x52, 0x58,
+	0x26, 0x3f, 0x20, 0xfe, 0x40, 0x5a, 0x2f, 0x5a, 0x8a, 0x40, 0x2b, 0x71, 0x83, 0x88, 0xf6, 0x9e,
+	0xbd, 0x7d, 0x6d, 0xcc, 0xd4, 0xd0, 0x71, 0x7b, 0x10, 0x92, 0xb8, 0x7f, 0x12, 0x94, 0xfa, 0x10,
+	0x25, 0xfa, 0x0c, 0x80, 0xa5, 0x75, 0x36, 0x26, 0x5f, 0x8f, 0x2b, 0x15, 0xb0, 0x32, 0xc2, 0xb5,
+	0x27, 0x1a, 0xcc, 0x2b, 0x3f, 0x16, 0x71, 0x88, 0x77, 0x44, 0x5a, 0xdd, 0x3e, 0xe7, 0x3d, 0x22,
+	0xee, 0x8c, 0xba, 0x96, 0x99, 0x4b, 0x5b, 0x92, 0x1c, 0x79, 0x6b, 0x87, 0x57, 0x33, 0xf7, 0x4e,
+	0x57, 0x33, 0x09, 0x27, 0x9f, 0x49, 0xfa, 0x4f, 0x1a, 0xcc, 0xb6, 0x05, 0xf8, 0x77, 0xcc, 0xf9,
+	0x6b, 0x0a, 0x3a, 0x96, 0xaa, 0xfc, 0x45, 0x52, 0xf5, 0x97, 0x06, 0xe5, 0x4d, 0xec, 0x70, 0x1a,
+	0x0d, 0x84, 0x43, 0x54, 0xcf, 0xdc, 0xee, 0xd9, 0x73, 0x4d, 0x18, 0x4b, 0x66, 0x2a, 0x57, 0x81,
+	0xbc, 0xcf, 0x3a, 0x31, 0x1a, 0xf1, 0xf9, 0xde, 0xe6, 0xdb, 0x7b, 0xee, 0x89, 0x0e, 0x14, 0xd6,
+	0x70, 0x0f, 0x07, 0x0e, 0x11, 0x6d, 0x2b, 0x6b, 0x9e, 0xcc, 0x0c, 0x49, 0xbc, 0xaf, 0xe2, 0xd7,
+	0xbe, 0x82, 0xf2, 0x5a, 0x8f, 0x3a, 0x87, 0x7b, 0xa1, 0x8b, 0x39, 0x41, 0x0b, 0x30, 0xd5, 0x25,
+	0x5e, 0xa7, 0xab, 0xbc, 0xe5, 0xad, 0x98, 0x42, 0x55, 0x28, 0xb2, 0x00, 0x87, 0xac, 0x4b, 0x79,
+	0x7c, 0x7d, 0x52, 0x5a, 0x9e, 0x91, 0x6f, 0xfa, 0x24, 0x70, 0xd4, 0x2d, 0xd2, 0xad, 0x94, 0xae,
+	0xfd, 0xaa, 0xc1, 0x7f, 0x54, 0x6f, 0x2b, 0x07, 0xa6, 0xe3, 0xc8, 0x74, 0xad, 0x42, 0x71, 0x5f,
+	0xc5, 0xc7, 0x0c, 0x4d, 0x4e, 0x90, 0x85, 0xb1, 0xc4, 0xc4, 0xe1, 0x27, 0xd3, 0x23, 0x91, 0x1e,
+	0xf1, 0x96, 0x1b, 0xf5, 0x86, 0x56, 0x61, 0xaa, 0x2f, 0xdd, 0xc8, 0x32, 0x9c, 0x9f, 0x4a, 0x99,
+	0x48, 0x63, 0xbb, 0xb1, 0xfc, 0x43, 0xbd, 0x98, 0xaf, 0xe8, 0xb5, 0x6f, 0x73, 0x80, 0x64, 0xd7,
+	0x8f, 0x82, 0x7d, 0x08, 0xc5, 0xe4, 0x5d, 0x30, 0xb4, 0x4b, 0x65, 0x3b, 0xd5, 0x47, 0x0f, 0xa0,
+	0x80, 0x95, 0xd9, 0x4b, 0x16, 0x2e, 0x51, 0x7f, 0x5b, 0xda, 0x2f, 0x9f, 0x88, 0xda, 0x5d, 0x98,
+	0x96, 0x19, 0x48, 0x62, 0xaf, 0x0f, 0xf1, 0xaa, 0x8b, 0x3f, 0x5f, 0x57, 0x3b, 0x47, 0x3d, 0xd9,
+	0x39, 0xea, 0x66, 0x30, 0x48, 0x51, 0xd5, 0xbe, 0x86, 0x82, 0x19, 0x38, 0x96, 0xe8, 0xa5, 0x16,
+	0xcc, 0x90, 0x13, 0xa7, 0x8b, 0x83, 0x0e, 0xb1, 0x23, 0x81, 0xe5, 0xe2, 0xb9, 0x5b, 0x27, 0x8e,
+	0x35, 0x9d, 0x18, 0x11, 0x46, 0x6b, 0x2e, 0x94, 0x33, 0x6f, 0xcb, 0xdb, 0xd7, 0x9a, 0xd5, 0xcc,
+	0x33, 0x95, 0xfb, 0x37, 0x4d, 0x96, 0x3e, 0x51, 0xdf, 0x69, 0x30, 0xa3, 0xd2, 0x93, 0x38, 0xfa,
+	0x14, 0x26, 0x43, 0x4a, 0x7b, 0x49, 0xb7, 0xfe, 0xf3, 0x7b, 0xa7, 0xc4, 0x33, 0x95, 0xc8, 0x5d,
+	0xb0, 0x12, 0x7f, 0x68, 0x50, 0x5e, 0x27, 0x0e, 0x75, 0x89, 0x2b, 0x67, 0x9d, 0x01, 0x05, 0x57,
+	0x92, 0xc9, 0xf6, 0x90, 0x90, 0xa2, 0x13, 0xd2, 0xfe, 0x54, 0xa3, 0x2d, 0xa5, 0xc5, 0x85, 0x66,
+	0x24, 0x10, 0x4a, 0x6a, 0xbc, 0xc7, 0x54, 0x3a, 0xcd, 0xf5, 0xcb, 0x4c, 0xf3, 0xc9, 0x37, 0x0e,
+	0xb9, 0xa9, 0x8b, 0x0c, 0xb9, 0xef, 0x75, 0x28, 0x08, 0x4f, 0x0f, 0x68, 0x98, 0x9a, 0xd6, 0x32,
+	0xa6, 0xaf, 0x40, 0xc9, 0x67, 0x1d, 0xdb, 0x0b, 0x5c, 0x72, 0x22, 0x03, 0x9b, 0xb1, 0x8a, 0x3e,
+	0xeb, 0x34, 0x05, 0x2d, 0x82, 0x3e, 0x8e, 0x70, 0x18, 0x92, 0x48, 0xad, 0x45, 0x25, 0x2b, 0xa5,
+	0x47, 0x12, 0xa2, 0xbf, 0x31, 0x21, 0x93, 0x23, 0x09, 0xb9, 0x0a, 0x25, 0x1a, 0x92, 0x68, 0x18,
+	0x46, 0xc9, 0x1a, 0x32, 0x04, 0x94, 0x2e, 0x0d, 0x63, 0x28, 0x05, 0x05, 0xa5, 0x4b, 0x43, 0x05,
+	0x45, 0x60, 0xa7, 0x21, 0x33, 0x8a, 0x92, 0x2f, 0xbf, 0xd1, 0x75, 0x28, 0xd3, 0x83, 0x03, 0x12,
+	0xd9, 0x6a, 0x76, 0x97, 0xa4, 0x41, 0x90, 0x2c, 0x53, 0x70, 0x84, 0x45, 0xcc, 0x0e, 0xe3, 0x63,
+	0x50, 0x20, 0x31, 0x3b, 0x34, 0xc7, 0xa6, 0x7b, 0xf9, 0x9d, 0x5e, 0xa5, 0xff, 0xc3, 0xf4, 0x3e,
+	0xe9, 0x79, 0xe4, 0xc0, 0x56, 0xdb, 0xf5, 0xb4, 0xf4, 0x53, 0x56, 0xbc, 0x5d, 0xc1, 0x1a, 0x5b,
+	0xcc, 0x67, 0xc6, 0x17, 0xf3, 0x0f, 0x61, 0xce, 0xf7, 0x02, 0xcf, 0xef, 0xfb, 0x76, 0xa4, 0xb6,
+	0x13, 0x63, 0x56, 0xca, 0xcc, 0xc6, 0xec, 0x78, 0x67, 0x19, 0xeb, 0x83, 0xb9, 0x0b, 0xf4, 0xc1,
+	0xcd, 0xa7, 0x1a, 0xcc, 0x8e, 0x2e, 0x5a, 0xe8, 0x1e, 0x5c, 0xdd, 0x6a, 0x5a, 0xd6, 0x8e, 0x65,
+	0xb7, 0x1e, 0x99, 0xbb, 0x76, 0xfb, 0xf1, 0xee, 0x86, 0xbd, 0xb7, 0xdd, 0xda, 0xdd, 0xb8, 0xdf,
+	0xdc, 0x6c, 0x6e, 0xac, 0x57, 0x26, 0xaa, 0xd7, 0x4e, 0xcf, 0x96, 0x17, 0x47, 0xb5, 0xf6, 0x02,
+	0x16, 0x12, 0xc7, 0x3b, 0xf0, 0x88, 0x8b, 0x3e, 0x81, 0xff, 0x9d, 0x33, 0xb0, 0xbd, 0x63, 0x6d,
+	0x99, 0x5f, 0x54, 0xb4, 0xaa, 0x71, 0x7a, 0xb6, 0x3c, 0x3f, 0xaa, 0xbb, 0x4d, 0x23, 0x1f, 0xf7,
+	0xd0, 0x0a, 0xfc, 0xf7, 0x9c, 0xda, 0x56, 0x73, 0xbb, 0x5d, 0xc9, 0x55, 0x17, 0x4e, 0xcf, 0x96,
+	0xd1, 0xa8, 0xd2, 0x96, 0x17, 0xf0, 0xaa, 0xfe, 0xc3, 0xcf, 0x4b, 0x13, 0x37, 0x7f, 0x19, 0x6e,
+	0x26, 0x32, 0x80, 0x55, 0x30, 0x36, 0xcd, 0xfb, 0xed, 0x1d, 0xeb, 0xf1, 0xeb, 0xc0, 0x57, 0x4f,
+	0xcf, 0x96, 0x17, 0x32, 0xe2, 0x59, 0xe4, 0x1f, 0x03, 0x1a, 0xd1, 0x6c, 0x6f, 0x58, 0x96, 0x59,
+	0xd1, 0xaa, 0xf3, 0xa7, 0x67, 0xcb, 0x95, 0x8c, 0x8e, 0x1c, 0xd3, 0xe7, 0xa4, 0xcd, 0x56, 0xdb,
+	0xda, 0xa9, 0xe4, 0xce, 0x49, 0x9b, 0x8c, 0x47, 0x54, 0x61, 0x5d, 0x5b, 0x7f, 0xf6, 0x72, 0x49,
+	0x7b, 0xfe, 0x72, 0x49, 0xfb, 0xf3, 0xe5, 0x92, 0xf6, 0xe3, 0xab, 0xa5, 0x89, 0xe7, 0xaf, 0x96,
+	0x26, 0x7e, 0x7b, 0xb5, 0x34, 0xf1, 0xe5, 0xcd, 0x4c, 0x7f, 0xc9, 0xd2, 0xdd, 0xf2, 0x69, 0x40,
+	0x06, 0x0d, 0x87, 0x46, 0xa4, 0x81, 0xc3, 0x50, 0xfd, 0x55, 0x95, 0x7d, 0xb6, 0x3f, 0x25, 0x1f,
+	0x80, 0x3b, 0x7f, 0x0f, 0x00, 0xfb, 0xb0, 0x87, 0x8f, 0xc5, 0x0e, 0x00, 0x00,
+}
+
+func (m *SwapIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MaxSpread) > 0 {
+		i -= len(m.MaxSpread)
+		copy(dAtA[i:], m.MaxSpread)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.MaxSpread)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AssetIn) > 0 {
+		i -= len(m.AssetIn)
+		copy(dAtA[i:], m.AssetIn)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.AssetIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PairName) > 0 {
+		i -= len(m.PairName)
+		copy(dAtA[i:], m.PairName)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.PairName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WasmEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Simulation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Simulation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Simulation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reserves) > 0 {
+		for iNdEx := len(m.Reserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.ReturnAmount.Size()
+		i -= size
+		if _, err := m.ReturnAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.WasmEvents) > 0 {
+		for iNdEx := len(m.WasmEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WasmEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MirrorSwapStart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MirrorSwapStart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MirrorSwapStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Simulation != nil {
+		{
+			size, err := m.Simulation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopics(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Enemy {
+		i--
+		if m.Enemy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MirrorReceiveShuttle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MirrorReceiveShuttle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MirrorReceiveShuttle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AssetName) > 0 {
+		i -= len(m.AssetName)
+		copy(dAtA[i:], m.AssetName)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.AssetName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerraSwapStart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerraSwapStart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerraSwapStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Simulation != nil {
+		{
+			size, err := m.Simulation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopics(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FactorySwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactorySwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactorySwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Simulation != nil {
+		{
+			size, err := m.Simulation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopics(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Balance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MirrorUpdateAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MirrorUpdateAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MirrorUpdateAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerraUpdateAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerraUpdateAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerraUpdateAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Account.Size()
+		i -= size
+		if _, err := m.Account.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Contract.Size()
+		i -= size
+		if _, err := m.Contract.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TerraAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerraAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerraAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopics(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AncRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AncRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AncRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reserves) > 0 {
+		for iNdEx := len(m.Reserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PairName) > 0 {
+		i -= len(m.PairName)
+		copy(dAtA[i:], m.PairName)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.PairName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Simulation != nil {
+		{
+			size, err := m.Simulation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopics(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Decoder) > 0 {
+		i -= len(m.Decoder)
+		copy(dAtA[i:], m.Decoder)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Decoder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Simulation != nil {
+		{
+			size, err := m.Simulation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopics(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.MinimumReceive) > 0 {
+		i -= len(m.MinimumReceive)
+		copy(dAtA[i:], m.MinimumReceive)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.MinimumReceive)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.MaxSpread) > 0 {
+		i -= len(m.MaxSpread)
+		copy(dAtA[i:], m.MaxSpread)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.MaxSpread)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.BeliefPrice) > 0 {
+		i -= len(m.BeliefPrice)
+		copy(dAtA[i:], m.BeliefPrice)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.BeliefPrice)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.AskAsset) > 0 {
+		i -= len(m.AskAsset)
+		copy(dAtA[i:], m.AskAsset)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.AskAsset)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.OfferAsset) > 0 {
+		i -= len(m.OfferAsset)
+		copy(dAtA[i:], m.OfferAsset)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.OfferAsset)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Hops != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.Hops))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HopIndex != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.HopIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Wrappers) > 0 {
+		for iNdEx := len(m.Wrappers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Wrappers[iNdEx])
+			copy(dAtA[i:], m.Wrappers[iNdEx])
+			i = encodeVarintTopics(dAtA, i, uint64(len(m.Wrappers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTopics(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTopics(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTopics(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SwapIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairName)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.AssetIn)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	l = len(m.MaxSpread)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	return n
+}
+
+func (m *Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	return n
+}
+
+func (m *WasmEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTopics(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Simulation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTopics(uint64(m.GasUsed))
+	}
+	if len(m.WasmEvents) > 0 {
+		for _, e := range m.WasmEvents {
+			l = e.Size()
+			n += 1 + l + sovTopics(uint64(l))
+		}
+	}
+	l = m.ReturnAmount.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	if len(m.Reserves) > 0 {
+		for _, e := range m.Reserves {
+			l = e.Size()
+			n += 1 + l + sovTopics(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	return n
+}
+
+func (m *MirrorSwapStart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Data.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	if m.Enemy {
+		n += 2
+	}
+	if m.Type != 0 {
+		n += 1 + sovTopics(uint64(m.Type))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	if m.Simulation != nil {
+		l = m.Simulation.Size()
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	return n
+}
+
+func (m *MirrorReceiveShuttle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetName)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	return n
+}
+
+func (m *TerraSwapStart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Data.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	if m.Simulation != nil {
+		l = m.Simulation.Size()
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	return n
+}
+
+func (m *FactorySwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTopics(uint64(m.Type))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	if m.Simulation != nil {
+		l = m.Simulation.Size()
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	return n
+}
+
+func (m *Balance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	return n
+}
+
+func (m *BlockUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTopics(uint64(m.Height))
+	}
+	if m.Snapshot {
+		n += 2
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTopics(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MirrorUpdateAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovTopics(uint64(l))
+		}
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTopics(uint64(m.Sequence))
+	}
+	l = m.Update.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	return n
+}
+
+func (m *TerraUpdateAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contract.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	l = m.Account.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovTopics(uint64(m.Sequence))
+	}
+	l = m.Update.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	return n
+}
+
+func (m *TerraAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	return n
+}
+
+func (m *AncRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	return n
+}
+
+func (m *PoolReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairName)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	if len(m.Reserves) > 0 {
+		for _, e := range m.Reserves {
+			l = e.Size()
+			n += 1 + l + sovTopics(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovTopics(uint64(l))
+		}
+	}
+	l = m.Update.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	return n
+}
+
+func (m *DecodedSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Decoder)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	if m.Simulation != nil {
+		l = m.Simulation.Size()
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovTopics(uint64(m.MsgIndex))
+	}
+	if len(m.Wrappers) > 0 {
+		for _, s := range m.Wrappers {
+			l = len(s)
+			n += 1 + l + sovTopics(uint64(l))
+		}
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	if m.HopIndex != 0 {
+		n += 1 + sovTopics(uint64(m.HopIndex))
+	}
+	if m.Hops != 0 {
+		n += 1 + sovTopics(uint64(m.Hops))
+	}
+	l = len(m.OfferAsset)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.AskAsset)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	l = len(m.BeliefPrice)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.MaxSpread)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	l = len(m.MinimumReceive)
+	if l > 0 {
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	if m.Simulation != nil {
+		l = m.Simulation.Size()
+		n += 1 + l + sovTopics(uint64(l))
+	}
+	return n
+}
+
+func sovTopics(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTopics(x uint64) (n int) {
+	return sovTopics(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SwapIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSpread = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WasmEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Simulation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Simulation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Simulation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WasmEvents = append(m.WasmEvents, WasmEvent{})
+			if err := m.WasmEvents[len(m.WasmEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReturnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserves = append(m.Reserves, PoolReserve{})
+			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MirrorSwapStart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MirrorSwapStart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MirrorSwapStart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enemy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enemy = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= MirrorSwapType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Simulation == nil {
+				m.Simulation = &Simulation{}
+			}
+			if err := m.Simulation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MirrorReceiveShuttle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MirrorReceiveShuttle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MirrorReceiveShuttle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerraSwapStart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerraSwapStart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerraSwapStart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Simulation == nil {
+				m.Simulation = &Simulation{}
+			}
+			if err := m.Simulation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FactorySwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FactorySwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FactorySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FactoryType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Simulation == nil {
+				m.Simulation = &Simulation{}
+			}
+			if err := m.Simulation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Balance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Snapshot = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MirrorUpdateAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MirrorUpdateAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MirrorUpdateAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerraUpdateAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerraUpdateAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerraUpdateAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerraAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerraAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerraAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &types.Any{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AncRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AncRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AncRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserves = append(m.Reserves, Balance{})
+			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, PoolReserve{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecodedSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecodedSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecodedSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Simulation == nil {
+				m.Simulation = &Simulation{}
+			}
+			if err := m.Simulation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wrappers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wrappers = append(m.Wrappers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HopIndex", wireType)
+			}
+			m.HopIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HopIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			m.Hops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hops |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeliefPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeliefPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSpread = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumReceive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumReceive = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Simulation == nil {
+				m.Simulation = &Simulation{}
+			}
+			if err := m.Simulation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTopics(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTopics
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTopics
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTopics
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTopics        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTopics          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTopics = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,147 @@
+package feed
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/terra-money/core/app/feed/types"
+)
+
+// webSocketWriteWait is the time allowed to write a message to a subscriber
+const webSocketWriteWait = 10 * time.Second
+
+var _ EventSink = &WebSocketSink{}
+
+// WebSocketSink publishes the feed as JSON text frames to WebSocket subscribers.
+// Subscribers set their filter with the repeated "topic", "contract" and "sender"
+// query parameters and the "min_amount" one, and replace it at any time by
+// sending a JSON encoded SubscribeRequest.
+type WebSocketSink struct {
+	*broadcaster
+
+	server   *http.Server
+	listener net.Listener
+	upgrader websocket.Upgrader
+}
+
+// NewWebSocketSink starts a WebSocket server listening on the address
+func NewWebSocketSink(address string, config SubscriberConfig) (*WebSocketSink, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	sink := &WebSocketSink{
+		broadcaster: newBroadcaster(SinkTypeWebSocket, config),
+		listener:    listener,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+	}
+
+	sink.server = &http.Server{Handler: http.HandlerFunc(sink.serveHTTP)}
+	go sink.server.Serve(listener) // nolint: errcheck
+
+	return sink, nil
+}
+
+// Addr returns the address the server listens on
+func (s *WebSocketSink) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Send implements EventSink
+func (s *WebSocketSink) Send(topic string, payload []byte) error {
+	s.broadcast(Message{Topic: topic, Payload: payload})
+	return nil
+}
+
+// Close implements EventSink
+func (s *WebSocketSink) Close() error {
+	s.broadcaster.close()
+	return s.server.Close()
+}
+
+func (s *WebSocketSink) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter, err := NewFilter(&types.SubscribeRequest{
+		Topics:    query["topic"],
+		Contracts: query["contract"],
+		Senders:   query["sender"],
+		MinAmount: query.Get("min_amount"),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	sub := s.subscribe(filter)
+	defer s.unsubscribe(sub)
+
+	// read the filters sent by the subscriber, which also detects the closed connection;
+	// the connection only supports one writer, so the loop below sends the close frame
+	closed := make(chan error, 1)
+	go func() {
+		for {
+			_, bz, err := conn.ReadMessage()
+			if err != nil {
+				closed <- nil
+				return
+			}
+
+			var req types.SubscribeRequest
+			if err := json.Unmarshal(bz, &req); err != nil {
+				closed <- err
+				return
+			}
+
+			filter, err := NewFilter(&req)
+			if err != nil {
+				closed <- err
+				return
+			}
+
+			s.setFilter(sub, filter)
+		}
+	}()
+
+	for {
+		select {
+		case err := <-closed:
+			if err != nil {
+				writeClose(conn, websocket.CloseUnsupportedData, err)
+			}
+
+			return
+		case msg, ok := <-sub.ch:
+			if !ok {
+				if sub.Err() != nil {
+					writeClose(conn, websocket.ClosePolicyViolation, sub.Err())
+				}
+
+				return
+			}
+
+			conn.SetWriteDeadline(time.Now().Add(webSocketWriteWait)) // nolint: errcheck
+			if err := conn.WriteJSON(msg); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// writeClose sends the close frame with the reason to the subscriber
+func writeClose(conn *websocket.Conn, code int, reason error) {
+	msg := websocket.FormatCloseMessage(code, reason.Error())
+	conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(webSocketWriteWait)) // nolint: errcheck
+}
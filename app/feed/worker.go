@@ -0,0 +1,109 @@
+package feed
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Job is a unit of work of the Worker, it should return once ctx is done
+type Job func(ctx context.Context)
+
+type namedJob struct {
+	name string
+	run  Job
+}
+
+// Worker runs jobs one at a time from a bounded queue in a background
+// goroutine, with a deadline per job and recovering the panics of the jobs
+type Worker struct {
+	timeout time.Duration
+	logger  log.Logger
+
+	mtx    sync.RWMutex
+	jobs   chan namedJob
+	closed bool
+	done   chan struct{}
+}
+
+// NewWorker starts a worker with a queue of queueSize jobs,
+// a timeout of zero runs the jobs without deadline
+func NewWorker(queueSize int, timeout time.Duration, logger log.Logger) *Worker {
+	w := &Worker{
+		timeout: timeout,
+		logger:  logger,
+		jobs:    make(chan namedJob, queueSize),
+		done:    make(chan struct{}),
+	}
+
+	go w.run()
+	return w
+}
+
+// Enqueue queues the job without blocking,
+// it returns false when the job was dropped as the queue is full
+func (w *Worker) Enqueue(name string, job Job) bool {
+	w.mtx.RLock()
+	defer w.mtx.RUnlock()
+
+	if w.closed {
+		return false
+	}
+
+	select {
+	case w.jobs <- namedJob{name: name, run: job}:
+		return true
+	default:
+		telemetry.IncrCounterWithLabels([]string{"feed", "jobs", "dropped"}, 1, []metrics.Label{telemetry.NewLabel("job", name)})
+		w.logger.Error("feed worker queue full, job dropped", "job", name)
+		return false
+	}
+}
+
+// Stop waits for the queued jobs to finish and stops the worker
+func (w *Worker) Stop() {
+	w.mtx.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.jobs)
+	}
+	w.mtx.Unlock()
+
+	<-w.done
+}
+
+func (w *Worker) run() {
+	defer close(w.done)
+
+	for job := range w.jobs {
+		w.runJob(job)
+	}
+}
+
+func (w *Worker) runJob(job namedJob) {
+	ctx := context.Background()
+	if w.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.timeout)
+		defer cancel()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			telemetry.IncrCounterWithLabels([]string{"feed", "jobs", "panics"}, 1, []metrics.Label{telemetry.NewLabel("job", job.name)})
+			w.logger.Error("feed job panicked", "job", job.name, "err", r)
+		}
+	}()
+
+	defer telemetry.MeasureSince(time.Now(), "feed", "jobs", job.name)
+	job.run(ctx)
+
+	if ctx.Err() == context.DeadlineExceeded {
+		telemetry.IncrCounterWithLabels([]string{"feed", "jobs", "timeouts"}, 1, []metrics.Label{telemetry.NewLabel("job", job.name)})
+		w.logger.Error("feed job timed out", "job", job.name, "timeout", w.timeout)
+	}
+}
@@ -0,0 +1,69 @@
+package feed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// blockingSink blocks every send until released
+type blockingSink struct {
+	*MemorySink
+	release chan struct{}
+}
+
+func (s blockingSink) Send(topic string, payload []byte) error {
+	<-s.release
+	return s.MemorySink.Send(topic, payload)
+}
+
+func TestAsyncSink(t *testing.T) {
+	sink := blockingSink{MemorySink: NewMemorySink(), release: make(chan struct{})}
+	async := NewAsyncSink(sink, 2, log.NewNopLogger())
+
+	// the first message is taken by the goroutine, two are queued
+	require.NoError(t, async.Send("a", nil))
+	require.Eventually(t, func() bool { return async.Len() == 0 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, async.Send("b", nil))
+	require.NoError(t, async.Send("c", nil))
+	require.Error(t, async.Send("d", nil))
+
+	close(sink.release)
+	require.NoError(t, async.Close())
+	require.Len(t, sink.Messages(), 3)
+
+	require.Error(t, async.Send("e", nil))
+}
+
+func TestWorker(t *testing.T) {
+	worker := NewWorker(1, 50*time.Millisecond, log.NewNopLogger())
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	require.True(t, worker.Enqueue("block", func(context.Context) {
+		close(started)
+		<-release
+	}))
+	<-started
+
+	// the queue holds a single job
+	require.True(t, worker.Enqueue("panic", func(context.Context) { panic("boom") }))
+	require.False(t, worker.Enqueue("dropped", func(context.Context) {}))
+	close(release)
+
+	timedOut := make(chan error, 1)
+	require.Eventually(t, func() bool {
+		return worker.Enqueue("timeout", func(ctx context.Context) {
+			<-ctx.Done()
+			timedOut <- ctx.Err()
+		})
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, context.DeadlineExceeded, <-timedOut)
+
+	worker.Stop()
+	require.False(t, worker.Enqueue("stopped", func(context.Context) {}))
+}
@@ -0,0 +1,61 @@
+package feed
+
+import (
+	"sync"
+
+	zmq "github.com/pebbe/zmq4"
+)
+
+var _ EventSink = &ZmqSink{}
+
+// ZmqSink publishes the feed through a zmq PUB socket,
+// the topic is sent as the first frame of each message.
+// Subscribers filter by topic prefix with their SUB socket; zmq drops the
+// messages of a subscriber once its high-water mark is reached.
+type ZmqSink struct {
+	// zmq sockets are not thread safe
+	mtx    sync.Mutex
+	socket *zmq.Socket
+}
+
+// NewZmqSink creates a PUB socket bound to the address,
+// buffering up to highWaterMark messages per subscriber
+func NewZmqSink(address string, highWaterMark int) (*ZmqSink, error) {
+	socket, err := zmq.NewSocket(zmq.PUB)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := socket.SetSndhwm(highWaterMark); err != nil {
+		socket.Close()
+		return nil, err
+	}
+
+	if err := socket.Bind(address); err != nil {
+		socket.Close()
+		return nil, err
+	}
+
+	return &ZmqSink{socket: socket}, nil
+}
+
+// Send implements EventSink
+func (s *ZmqSink) Send(topic string, payload []byte) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, err := s.socket.Send(topic, zmq.SNDMORE); err != nil {
+		return err
+	}
+
+	_, err := s.socket.SendBytes(payload, 0)
+	return err
+}
+
+// Close implements EventSink
+func (s *ZmqSink) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.socket.Close()
+}
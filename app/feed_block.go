@@ -0,0 +1,219 @@
+package app
+
+import (
+	"context"
+	"sync/atomic"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/terra-money/core/app/feed"
+	feedtypes "github.com/terra-money/core/app/feed/types"
+)
+
+// feedBlockState holds what the block level publishers need across blocks
+// to only publish the reserves and balances changed by a block.
+// The tracker and header are used by the consensus goroutine,
+// everything else but forceSnapshot only by the feed worker.
+type feedBlockState struct {
+	tracker *feed.ChangeTracker
+	header  tmproto.Header
+
+	// set when a block was not fully published, so the next one is a full snapshot
+	forceSnapshot int32
+
+	sequencer *feed.Sequencer
+
+	// the changes of the block being published
+	changes  feed.Changes
+	height   int64
+	snapshot bool
+
+	// the last published values, keyed by topic and name
+	reserves         map[string]map[string]feedtypes.PoolReserve
+	balances         map[string]map[string]sdk.Int
+	accountSequences map[string]uint64
+}
+
+func newFeedBlockState() *feedBlockState {
+	return &feedBlockState{
+		tracker:          feed.NewChangeTracker(),
+		sequencer:        feed.NewSequencer(),
+		reserves:         make(map[string]map[string]feedtypes.PoolReserve),
+		balances:         make(map[string]map[string]sdk.Int),
+		accountSequences: make(map[string]uint64),
+	}
+}
+
+// begin starts publishing the block, taking a full snapshot on the first block
+// published, every snapshotInterval blocks and after an incomplete block
+func (s *feedBlockState) begin(height int64, changes feed.Changes, snapshotInterval int64) {
+	forced := atomic.SwapInt32(&s.forceSnapshot, 0) == 1
+	s.snapshot = forced || s.height == 0 || snapshotInterval <= 1 || height%snapshotInterval == 0
+	s.height = height
+	s.changes = changes
+}
+
+// requestSnapshot makes the next block published a full snapshot
+func (s *feedBlockState) requestSnapshot() {
+	atomic.StoreInt32(&s.forceSnapshot, 1)
+}
+
+// expired reports whether the time or the gas to publish the block is used up,
+// the next block is then a full snapshot
+func (s *feedBlockState) expired(ctx sdk.Context) bool {
+	if ctx.Context().Err() == nil && !ctx.GasMeter().IsOutOfGas() {
+		return false
+	}
+
+	s.requestSnapshot()
+	return true
+}
+
+// update returns the position of the next update of the topic
+func (s *feedBlockState) update(topic string) feedtypes.BlockUpdate {
+	return feedtypes.BlockUpdate{
+		Height:   s.height,
+		Snapshot: s.snapshot,
+		Sequence: s.sequencer.Next(topic),
+	}
+}
+
+// setReserve records the reserve as published,
+// returning whether it differs from the last published one
+func (s *feedBlockState) setReserve(topic string, reserve feedtypes.PoolReserve) bool {
+	reserves, ok := s.reserves[topic]
+	if !ok {
+		reserves = make(map[string]feedtypes.PoolReserve)
+		s.reserves[topic] = reserves
+	}
+
+	last, ok := reserves[reserve.PairName]
+	reserves[reserve.PairName] = reserve
+
+	return !ok || !reservesEqual(last, reserve)
+}
+
+func reservesEqual(a, b feedtypes.PoolReserve) bool {
+	if len(a.Reserves) != len(b.Reserves) {
+		return false
+	}
+
+	for i := range a.Reserves {
+		if a.Reserves[i].Asset != b.Reserves[i].Asset || !a.Reserves[i].Amount.Equal(b.Reserves[i].Amount) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// setBalance records the balance as published,
+// returning whether it differs from the last published one
+func (s *feedBlockState) setBalance(topic string, balance feedtypes.Balance) bool {
+	balances, ok := s.balances[topic]
+	if !ok {
+		balances = make(map[string]sdk.Int)
+		s.balances[topic] = balances
+	}
+
+	last, ok := balances[balance.Asset]
+	balances[balance.Asset] = balance.Amount
+
+	return !ok || !last.Equal(balance.Amount)
+}
+
+// setAccountSequence records the account sequence as published,
+// returning whether it differs from the last published one
+func (s *feedBlockState) setAccountSequence(topic string, sequence uint64) bool {
+	last, ok := s.accountSequences[topic]
+	s.accountSequences[topic] = sequence
+
+	return !ok || last != sequence
+}
+
+// DeliverTx implements the ABCI interface, tracking the contracts and
+// accounts written by the tx for the block level feed updates
+func (app *TerraApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+
+	// failed txs still pay fees
+	app.feedBlock.tracker.TrackEvents(res.Events)
+
+	return res
+}
+
+// Commit implements the ABCI interface, queueing the block level feed updates
+// to be published from the committed state by the feed worker once the feed is started
+func (app *TerraApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+
+	header := app.feedBlock.header
+	changes := app.feedBlock.tracker.Flush()
+	if app.feedWorker == nil {
+		return res
+	}
+
+	if !app.feedWorker.Enqueue("block", func(ctx context.Context) {
+		app.publishFeedBlock(ctx, header, changes)
+	}) {
+		app.feedBlock.requestSnapshot()
+	}
+
+	return res
+}
+
+// publishFeedBlock publishes the block level updates from the state committed at
+// the height of the header; it stops once ctx is done or the queries ran out of
+// the block gas limit, leaving the rest of the updates to the next block, which
+// is then a full snapshot
+func (app *TerraApp) publishFeedBlock(ctx context.Context, header tmproto.Header, changes feed.Changes) {
+	state := app.feedBlock
+
+	// branch the immutable version of the height, the latest one keeps changing
+	cms, err := app.NewUncachedContext(false, header).MultiStore().CacheMultiStoreWithVersion(header.Height)
+	if err != nil {
+		app.Logger().Error("failed to load committed state for the feed", "height", header.Height, "err", err)
+		state.requestSnapshot()
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			state.requestSnapshot()
+
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				app.Logger().Error("feed block updates ran out of gas", "height", header.Height, "gas_limit", app.feedConfig.BlockGasLimit)
+				return
+			}
+
+			panic(r)
+		}
+	}()
+
+	// the publishers see the deadline of ctx and a finite gas meter,
+	// bounding the contract queries
+	sdkCtx := sdk.NewContext(cms, header, false, app.Logger()).
+		WithContext(ctx).
+		WithGasMeter(sdk.NewGasMeter(app.feedConfig.BlockGasLimit))
+	state.begin(header.Height, changes, app.feedConfig.SnapshotInterval)
+
+	for _, publish := range []func(sdk.Context){
+		app.SendMirrorBalances,
+		app.SendTerraBalances,
+		app.SendTerraAccount,
+		func(ctx sdk.Context) { app.SendPools(ctx, "mirror") },
+		func(ctx sdk.Context) { app.SendPools(ctx, "terra") },
+		app.SendAncRate,
+	} {
+		if state.expired(sdkCtx) {
+			return
+		}
+
+		publish(sdkCtx)
+	}
+
+	// a publisher may have stopped at its last query
+	state.expired(sdkCtx)
+}
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/app/feed/addressbook"
+	"github.com/terra-money/core/app/feed/decoder"
+)
+
+// setDefaultDecoders replaces the default decoders of the registry
+// by those of the current address book
+func (app *TerraApp) setDefaultDecoders() error {
+	return app.decoders.SetDefaults(defaultDecoders(app.addressBook.Snapshot()))
+}
+
+// defaultDecoders returns the decoders of the swaps on the pairs and tokens of the
+// address book and of the short positions opened on the mint contract, the config
+// file can replace them by name. The intents name the pairs and assets by their
+// names in the address book.
+func defaultDecoders(book *addressbook.Snapshot) []decoder.Spec {
+	lists := make([]string, 0, len(addressbook.Lists))
+	for list := range addressbook.Lists {
+		lists = append(lists, list)
+	}
+	sort.Strings(lists)
+
+	names := make(map[string]string)
+	for _, list := range lists {
+		for address, name := range book.List(list)[addressbook.KeyReverse] {
+			names[address] = name
+		}
+	}
+
+	pairSwap := decoder.Fields{
+		PairName:  decoder.SourceContract,
+		AssetIn:   decoder.SourceFundsDenom,
+		Amount:    decoder.SourceFundsAmount,
+		MaxSpread: "swap.max_spread",
+		Price:     "swap.belief_price",
+	}
+	tokenSwap := decoder.Fields{
+		PairName:  "send.contract",
+		AssetIn:   decoder.SourceContract,
+		Amount:    "send.amount",
+		MaxSpread: "send.msg.swap.max_spread",
+		Price:     "send.msg.swap.belief_price",
+	}
+
+	specs := []decoder.Spec{
+		{Name: "mirror-swap", Addresses: listContracts(book, "mirrorPair"), Match: "swap", Fields: pairSwap},
+		{Name: "mirror-send", Addresses: listContracts(book, "mirrorToken"), Match: "send.msg.swap", Fields: tokenSwap},
+		{Name: "terraswap-swap", Addresses: listContracts(book, "terraPair"), Match: "swap", Fields: pairSwap},
+		{Name: "terraswap-send", Addresses: listContracts(book, "terraToken"), Match: "send.msg.swap", Fields: tokenSwap},
+	}
+
+	// the minted assets of a short position are sold on its pair; whether
+	// the position of a mint is short is left to the subscribers
+	if mint := book.Wallets["mintContract"]; mint != "" {
+		specs = append(specs, decoder.Spec{
+			Name:      "mirror-mint",
+			Addresses: []string{mint},
+			Match:     "mint",
+			Fields: decoder.Fields{
+				PairName:  "mint.asset.info.token.contract_addr",
+				AssetIn:   "mint.asset.info.token.contract_addr",
+				Amount:    "mint.asset.amount",
+				MaxSpread: "'1'",
+				Price:     "'1'",
+			},
+		}, decoder.Spec{
+			Name:      "mirror-short",
+			Addresses: []string{mint},
+			Match:     "open_position.short_params",
+			Fields: decoder.Fields{
+				PairName:  "open_position.asset_info.token.contract_addr",
+				AssetIn:   "open_position.collateral.info.native_token.denom",
+				Amount:    "open_position.collateral.amount",
+				MaxSpread: "open_position.short_params.max_spread",
+				Price:     "open_position.short_params.belief_price",
+			},
+		})
+	}
+
+	// aUST collateral is sent to the mint contract with an open_position hook
+	if aust := book.List("terraToken")[addressbook.KeyNormal]["AUST"]; aust != "" {
+		specs = append(specs, decoder.Spec{
+			Name:      "mirror-short-aust",
+			Addresses: []string{aust},
+			Match:     "send.msg.open_position.short_params",
+			Fields: decoder.Fields{
+				PairName:  "send.msg.open_position.asset_info.token.contract_addr",
+				AssetIn:   decoder.SourceContract,
+				Amount:    "send.amount",
+				MaxSpread: "send.msg.open_position.short_params.max_spread",
+				Price:     "send.msg.open_position.short_params.belief_price",
+			},
+		})
+	}
+
+	defaults := make([]decoder.Spec, 0, len(specs))
+	for _, spec := range specs {
+		if len(spec.Addresses) == 0 {
+			continue
+		}
+
+		spec.Names = names
+		defaults = append(defaults, spec)
+	}
+
+	return defaults
+}
+
+// listContracts returns the contract addresses of the list in a stable order,
+// leaving out the coin denoms of the native assets
+func listContracts(book *addressbook.Snapshot, list string) []string {
+	var addresses []string
+	for _, address := range book.List(list)[addressbook.KeyNormal] {
+		if _, err := sdk.AccAddressFromBech32(address); err == nil {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
@@ -0,0 +1,110 @@
+package app
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/app/feed"
+	"github.com/terra-money/core/app/feed/addressbook"
+	feedtypes "github.com/terra-money/core/app/feed/types"
+	wasmtypes "github.com/terra-money/core/x/wasm/types"
+)
+
+func newTestAddress() string {
+	return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+}
+
+func writeAddressBookFile(t *testing.T, dir, fileName string, v interface{}) {
+	bz, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, fileName), bz, 0600))
+}
+
+func TestDefaultDecoders(t *testing.T) {
+	homePath := t.TempDir()
+	bookDir := filepath.Join(homePath, "addressbook")
+	require.NoError(t, os.MkdirAll(bookDir, 0700))
+
+	mintContract, mirPair, mirToken, aust, trader := newTestAddress(), newTestAddress(), newTestAddress(), newTestAddress(), newTestAddress()
+	writeAddressBookFile(t, bookDir, addressbook.WalletFile, addressbook.Wallets{Addresses: []addressbook.Wallet{
+		{Name: "mintContract", Address: mintContract},
+	}})
+	writeAddressBookFile(t, bookDir, addressbook.Lists["mirrorPair"], addressbook.Contracts{Contracts: []addressbook.Contract{
+		{AssetName: "MIR-UST", Address: mirPair},
+	}})
+	writeAddressBookFile(t, bookDir, addressbook.Lists["mirrorToken"], addressbook.Contracts{Contracts: []addressbook.Contract{
+		{AssetName: "MIR", Address: mirToken},
+	}})
+	writeAddressBookFile(t, bookDir, addressbook.Lists["terraToken"], addressbook.Contracts{Contracts: []addressbook.Contract{
+		{AssetName: "UST", Address: "uusd"},
+		{AssetName: "AUST", Address: aust},
+	}})
+
+	appOpts := viper.New()
+	appOpts.Set("feed.address-book-dir", "addressbook")
+	app := newFeedTestApp(t, homePath, appOpts)
+	require.NoError(t, app.ReloadFeedConfig())
+	require.Equal(t, 6, app.decoders.Len())
+
+	sink := feed.NewMemorySink()
+	app.SetFeedSink(sink)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	hook := func(msg string) string { return base64.StdEncoding.EncodeToString([]byte(msg)) }
+	for _, tc := range []struct {
+		contract string
+		msg      string
+		funds    sdk.Coins
+		decoder  string
+		expected feedtypes.SwapIntent
+	}{
+		{
+			contract: mirPair,
+			msg:      `{"swap":{"max_spread":"0.01","belief_price":"2"}}`,
+			funds:    sdk.NewCoins(sdk.NewInt64Coin("uusd", 1000)),
+			decoder:  "mirror-swap",
+			expected: feedtypes.SwapIntent{PairName: "MIR-UST", AssetIn: "UST", Amount: sdk.NewInt(1000), MaxSpread: "0.01", Price: "2"},
+		},
+		{
+			contract: mirToken,
+			msg:      `{"send":{"contract":"` + mirPair + `","amount":"42","msg":"` + hook(`{"swap":{"belief_price":"3"}}`) + `"}}`,
+			decoder:  "mirror-send",
+			expected: feedtypes.SwapIntent{PairName: "MIR-UST", AssetIn: "MIR", Amount: sdk.NewInt(42), Price: "3"},
+		},
+		{
+			contract: aust,
+			msg: `{"send":{"contract":"` + mintContract + `","amount":"500","msg":"` + hook(
+				`{"open_position":{"asset_info":{"token":{"contract_addr":"`+mirToken+`"}},"collateral_ratio":"2","short_params":{"belief_price":"4","max_spread":"0.02"}}}`,
+			) + `"}}`,
+			decoder:  "mirror-short-aust",
+			expected: feedtypes.SwapIntent{PairName: "MIR", AssetIn: "AUST", Amount: sdk.NewInt(500), MaxSpread: "0.02", Price: "4"},
+		},
+	} {
+		sink.Reset()
+		msg := &wasmtypes.MsgExecuteContract{Sender: trader, Contract: tc.contract, ExecuteMsg: []byte(tc.msg), Coins: tc.funds}
+		app.handleFeedMsg(ctx, msg, 0, nil, []byte(tc.msg), nil)
+
+		decoded := sink.MessagesByTopic(feedtypes.TopicDecodedSwap)
+		require.Len(t, decoded, 1, tc.decoder)
+		_, feedMsg, err := feedtypes.DecodeFrame(decoded[0].Payload)
+		require.NoError(t, err)
+		require.Equal(t, tc.decoder, feedMsg.(*feedtypes.DecodedSwap).Decoder)
+		require.Equal(t, tc.expected, feedMsg.(*feedtypes.DecodedSwap).Data)
+
+		// the execute is decoded once, by the registry
+		for _, topic := range []string{feedtypes.TopicMirrorSwapStart, feedtypes.TopicTerraSwapStart, feedtypes.TopicFactorySwap} {
+			require.Empty(t, sink.MessagesByTopic(topic), topic)
+		}
+	}
+}
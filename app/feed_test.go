@@ -0,0 +1,125 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/app/feed"
+	"github.com/terra-money/core/app/feed/addressbook"
+	"github.com/terra-money/core/app/feed/filewatch"
+	wasmconfig "github.com/terra-money/core/x/wasm/config"
+)
+
+func newFeedTestApp(t *testing.T, homePath string, appOpts *viper.Viper) *TerraApp {
+	return NewTerraApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, homePath, 0,
+		MakeEncodingConfig(), appOpts, wasmconfig.DefaultConfig(),
+	)
+}
+
+func TestStartStopFeed(t *testing.T) {
+	homePath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(homePath, "config"), 0700))
+	require.NoError(t, os.MkdirAll(filepath.Join(homePath, "addressbook"), 0700))
+
+	appOpts := viper.New()
+	appOpts.Set("feed.sink", feed.SinkTypeFile)
+	appOpts.Set("feed.address-book-dir", "addressbook")
+	appOpts.Set("feed.admin-address", "127.0.0.1:0")
+
+	app := newFeedTestApp(t, homePath, appOpts)
+
+	// building the app starts nothing
+	require.Equal(t, feed.NopSink{}, app.feedSink)
+	require.Nil(t, app.feedWorker)
+	require.Nil(t, app.feedAdmin)
+	require.Empty(t, app.feedWatchers)
+
+	require.NoError(t, app.StartFeed())
+	require.NotNil(t, app.feedWorker)
+	require.NotNil(t, app.feedAdmin)
+	require.Len(t, app.feedWatchers, 2)
+
+	adminAddr := app.feedAdmin.Addr().String()
+	conn, err := net.Dial("tcp", adminAddr)
+	require.NoError(t, err)
+	conn.Close()
+
+	// the address book is reloaded on change while the feed runs
+	wallet := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	bz, err := json.Marshal(addressbook.Wallets{Addresses: []addressbook.Wallet{{Name: "terraWallet", Address: wallet}}})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(homePath, "addressbook", addressbook.WalletFile), bz, 0600))
+	require.Eventually(t, func() bool {
+		return app.addressBook.Snapshot().Wallets["terraWallet"] == wallet
+	}, 10*time.Second, 10*time.Millisecond)
+
+	app.StopFeed()
+	require.Equal(t, feed.NopSink{}, app.feedSink)
+	require.Nil(t, app.feedWorker)
+	require.Nil(t, app.feedAdmin)
+	require.Empty(t, app.feedWatchers)
+
+	_, err = net.Dial("tcp", adminAddr)
+	require.Error(t, err)
+
+	// the watchers are closed, changes are only picked up on a reload
+	require.NoError(t, ioutil.WriteFile(filepath.Join(homePath, "addressbook", addressbook.WalletFile), []byte(`{"wallets":[]}`), 0600))
+	time.Sleep(5 * filewatch.Debounce)
+	require.Equal(t, wallet, app.addressBook.Snapshot().Wallets["terraWallet"])
+}
+
+func TestStartFeedError(t *testing.T) {
+	homePath := t.TempDir()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	appOpts := viper.New()
+	appOpts.Set("feed.admin-address", listener.Addr().String())
+
+	// a listener already bound fails the start without panicking
+	app := newFeedTestApp(t, homePath, appOpts)
+	require.Error(t, app.StartFeed())
+	require.Equal(t, feed.NopSink{}, app.feedSink)
+	require.Nil(t, app.feedWorker)
+	require.Empty(t, app.feedWatchers)
+}
+
+func TestPublishFeedBlockLimits(t *testing.T) {
+	s := newSimulateTestApp(t)
+
+	sink := feed.NewMemorySink()
+	s.SetFeedSink(sink)
+
+	// a passed deadline publishes nothing and makes the next block a full snapshot
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.publishFeedBlock(ctx, s.header, feed.Changes{})
+	require.Empty(t, sink.Messages())
+	require.Equal(t, int32(1), atomic.SwapInt32(&s.feedBlock.forceSnapshot, 0))
+
+	// running out of the block gas limit stops the queries without panicking
+	s.feedConfig.BlockGasLimit = 1
+	require.NotPanics(t, func() {
+		s.publishFeedBlock(context.Background(), s.header, feed.Changes{})
+	})
+	require.Empty(t, sink.Messages())
+	require.Equal(t, int32(1), atomic.SwapInt32(&s.feedBlock.forceSnapshot, 0))
+}
@@ -1,393 +1,220 @@
 package app
 
 import (
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"strconv"
-	"strings"
+	"sort"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/terra-money/core/app/feed/decoder"
+	feedtypes "github.com/terra-money/core/app/feed/types"
 	wasmexported "github.com/terra-money/core/x/wasm/exported"
 	wasmkeeper "github.com/terra-money/core/x/wasm/keeper"
 	"github.com/terra-money/core/x/wasm/types"
-	"github.com/vmihailenco/msgpack"
 )
 
 func (app *TerraApp) changeDenomName(denom string) string {
-	return app.terraToken["reverse"][denom]
+	return app.GetAddressMap("terraToken")["reverse"][denom]
 }
 
-func (app *TerraApp) HandleCheckTx(ctx sdk.Context, txBytes []byte) {
-	app.ZmqSendMessage("CheckTx", txBytes)
-	encodingConfig := MakeEncodingConfig()
-	decoder := encodingConfig.TxConfig.TxDecoder()
-	tx, err := decoder(txBytes)
-	if err != nil {
-		return
-	}
-
-	for _, msg := range tx.GetMsgs() {
-
-		switch msg := msg.(type) {
-		case *banktypes.MsgSend:
-			app.HandleSendTx(msg)
-		case *wasmexported.MsgExecuteContract:
-			if msg.Sender == app.GetWallets()["mirrorWallet"] {
-				break
-			}
-
-			if app.mirrorToken["reverse"][msg.Contract] != "" || app.mirrorPair["reverse"][msg.Contract] != "" {
-				app.HandleMirrorTx(ctx, msg, txBytes)
-			}
-
-			if msg.Contract == app.terraToken["normal"]["AUST"] {
-				data, _ := msg.ExecuteMsg.MarshalJSON()
-
-				msgExecute := make(map[string]interface{})
-				json.Unmarshal(data, &msgExecute)
-				if msgExecute["send"] == nil {
-					return
-				}
-
-				msg := msgExecute["send"].(map[string]interface{})["msg"].(string)
-				decodedData, _ := base64.StdEncoding.DecodeString(msg)
-				app.HandleMintTx(ctx, decodedData, txBytes)
-
-			} else if msg.Contract == app.GetWallets()["mintContract"] {
-				data, _ := msg.ExecuteMsg.MarshalJSON()
-				app.HandleMintTx(ctx, data, txBytes)
-			}
+// txHash returns the hex encoded hash of the tx the way tendermint displays it
+func txHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", tmhash.Sum(txBytes))
+}
 
-			if app.terraToken["reverse"][msg.Contract] != "" || app.terraPair["reverse"][msg.Contract] != "" {
-				app.HandleTerraTx(ctx, msg, txBytes)
-			}
+// jsonString returns the value when it is a JSON string, empty otherwise
+func jsonString(value interface{}) string {
+	str, _ := value.(string)
+	return str
+}
 
-			if msg.Contract == app.GetWallets()["terraFactory"] {
-				app.HandleFactorySwapTx(msg, txBytes, "terra")
+// jsonInt parses the value when it is a JSON string holding an integer, zero otherwise
+func jsonInt(value interface{}) sdk.Int {
+	amount, ok := sdk.NewIntFromString(jsonString(value))
+	if !ok {
+		return sdk.ZeroInt()
+	}
 
-			} else if msg.Contract == app.GetWallets()["astroFactory"] {
-				app.HandleFactorySwapTx(msg, txBytes, "astro")
+	return amount
+}
 
-			}
-		}
-	}
+// feedRouting returns the routing keys of the messages published for the execute
+func feedRouting(msg *types.MsgExecuteContract, amount sdk.Int) feedtypes.Routing {
+	return feedtypes.Routing{Contract: msg.Contract, Sender: msg.Sender, Amount: &amount}
 }
 
-func (app *TerraApp) HandleSendTx(msg *banktypes.MsgSend) {
-	zmqMessage := make(map[string]interface{})
-	topic := ""
-	if msg.FromAddress == app.GetWallets()["shuttle"] && msg.ToAddress == app.GetWallets()["mirrorWallet"] {
-		for _, coin := range msg.Amount {
-			zmqMessage["assetName"] = app.changeDenomName(coin.Denom)
-			zmqMessage["amount"] = coin.Amount.Sign()
+func (app *TerraApp) HandleCheckTx(ctx sdk.Context, txBytes []byte) {
+	// the handler runs in CheckTx, which does not recover panics
+	defer func() {
+		if r := recover(); r != nil {
+			telemetry.IncrCounter(1, "feed", "checktx", "panics")
+			app.Logger().Error("feed CheckTx handler panicked", "err", r)
 		}
-		topic = "mirrorReceiveShuttle"
-	}
+	}()
 
-	if topic == "" {
+	app.SendFeedMessage(feedtypes.TopicCheckTx, &feedtypes.CheckTx{Tx: txBytes, Hash: txHash(txBytes)})
+	encodingConfig := MakeEncodingConfig()
+	decoder := encodingConfig.TxConfig.TxDecoder()
+	tx, err := decoder(txBytes)
+	if err != nil {
 		return
 	}
-	b, _ := msgpack.Marshal(zmqMessage)
-	app.ZmqSendMessage(topic, b)
-}
 
-func (app *TerraApp) HandleMirrorTx(ctx sdk.Context, msg *types.MsgExecuteContract, txBytes []byte) {
-	amount := 0
+	sim := app.newTxSimulation(ctx, tx)
 
-	topic := ""
-	zmqMessage := make(map[string]interface{})
-	data, _ := msg.ExecuteMsg.MarshalJSON()
-	msgExecute := make(map[string]interface{})
-	json.Unmarshal(data, &msgExecute)
+	for i, msg := range tx.GetMsgs() {
+		app.handleFeedMsg(ctx, msg, uint32(i), nil, txBytes, sim)
+	}
+}
 
-	if msgExecute["swap"] != nil {
-		assetIn := ""
-		for _, coin := range msg.Coins {
-			assetIn = app.changeDenomName(coin.Denom)
-			amount = int(coin.Amount.Int64())
-		}
-		pairName := app.mirrorPair["reverse"][msg.Contract]
-		if pairName == "" {
+// handleFeedMsg publishes the feed messages of a msg of the tx, recursing into
+// the msgs wrapped by authz MsgExec; msgIndex is the index of the top level msg
+// and wrappers the type URLs of the msgs wrapping the msg, outermost first
+func (app *TerraApp) handleFeedMsg(ctx sdk.Context, msg sdk.Msg, msgIndex uint32, wrappers []string, txBytes []byte, sim *txSimulation) {
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		msgs, err := msg.GetMessages()
+		if err != nil {
 			return
 		}
 
-		if msg.Sender == app.GetWallets()["mirrorEnemy"] {
-			zmqMessage["enemy"] = true
+		wrappers = append(append([]string{}, wrappers...), sdk.MsgTypeURL(msg))
+		for _, wrapped := range msgs {
+			app.handleFeedMsg(ctx, wrapped, msgIndex, wrappers, txBytes, sim)
 		}
-
-		if !app.checkBalance(ctx, "UST", msg.Sender, amount) {
-			return
-		}
-		zmqMessage["data"] = make(map[string]interface{})
-		zmqMessage["data"].(map[string]interface{})["pairName"] = pairName
-		zmqMessage["data"].(map[string]interface{})["assetIn"] = assetIn
-		zmqMessage["data"].(map[string]interface{})["amount"] = amount
-		zmqMessage["data"].(map[string]interface{})["maxSpread"] = msgExecute["swap"].(map[string]interface{})["max_spread"]
-		zmqMessage["data"].(map[string]interface{})["price"] = msgExecute["swap"].(map[string]interface{})["belief_price"]
-		topic = "mirrorSwapStart"
-	} else if msgExecute["send"] != nil {
-		obj := msgExecute["send"].(map[string]interface{})
-		contract := obj["contract"].(string)
-		assetName := app.mirrorToken["reverse"][msg.Contract]
-		pairName := app.mirrorPair["reverse"][contract]
-		if pairName != "" && strings.Contains(pairName, assetName) {
-			amount, _ = strconv.Atoi(obj["amount"].(string))
-
-			if msg.Sender == app.GetWallets()["mirrorEnemy"] {
-				zmqMessage["enemy"] = true
-			} else {
-				if !app.checkBalance(ctx, assetName, msg.Sender, amount) {
-					return
-				}
-			}
-
-			price, spread := extractPriceAndSpread(obj["msg"].(string))
-			zmqMessage["data"] = make(map[string]interface{})
-			zmqMessage["data"].(map[string]interface{})["pairName"] = pairName
-			zmqMessage["data"].(map[string]interface{})["assetIn"] = pairName
-			zmqMessage["data"].(map[string]interface{})["amount"] = amount
-			zmqMessage["data"].(map[string]interface{})["maxSpread"] = spread
-			zmqMessage["data"].(map[string]interface{})["price"] = price
-			topic = "mirrorSwapStart"
-		} else {
-			return
+	case *banktypes.MsgSend:
+		app.HandleSendTx(msg)
+	case *wasmexported.MsgExecuteContract:
+		if msg.Sender == app.GetWallets()["mirrorWallet"] {
+			break
 		}
-	} else if msgExecute["transfer"] != nil && msg.Sender == app.GetWallets()["shuttle"] {
-		obj := msgExecute["transfer"].(map[string]interface{})
-		amountStr := obj["amount"].(string)
-		amount, _ := strconv.Atoi(amountStr)
-		recipient := obj["recipient"].(string)
-		if recipient != app.GetWallets()["mirrorWallet"] {
-			return
-		}
-		assetName := app.mirrorToken["reverse"][msg.Contract]
-		zmqMessage["assetName"] = assetName
-		zmqMessage["amount"] = amount
 
-		topic = "mirrorReceiveShuttle"
+		app.HandleDecodedTx(ctx, msg, txBytes, sim)
+		app.HandleSwapHops(msg, msgIndex, wrappers, txBytes, sim)
+		app.HandleTransferTx(msg, txBytes)
 	}
+}
 
-	if topic == "" {
-		return
+// HandleSwapHops publishes a swap hop for every swap of the execute, unwrapping
+// cw20 send hooks and router execute_swap_operations into their hops
+func (app *TerraApp) HandleSwapHops(msg *types.MsgExecuteContract, msgIndex uint32, wrappers []string, txBytes []byte, sim *txSimulation) {
+	hops := decoder.DecodeHops(decoder.Execute{
+		Contract: msg.Contract,
+		Sender:   msg.Sender,
+		Msg:      msg.ExecuteMsg,
+		Funds:    msg.Coins,
+	})
+
+	for _, hop := range hops {
+		amount := hop.Amount
+		app.SendRoutedFeedMessage(feedtypes.TopicSwapHop, feedtypes.Routing{Contract: hop.Contract, Sender: msg.Sender, Amount: &amount}, &feedtypes.SwapHop{
+			Hash:           txHash(txBytes),
+			MsgIndex:       msgIndex,
+			Wrappers:       append(append([]string{}, wrappers...), hop.Wrappers...),
+			Contract:       hop.Contract,
+			Sender:         msg.Sender,
+			Operation:      hop.Operation,
+			HopIndex:       uint32(hop.Index),
+			Hops:           uint32(hop.Hops),
+			OfferAsset:     hop.OfferAsset,
+			AskAsset:       hop.AskAsset,
+			Amount:         hop.Amount,
+			BeliefPrice:    hop.BeliefPrice,
+			MaxSpread:      hop.MaxSpread,
+			MinimumReceive: hop.MinimumReceive,
+			Simulation:     sim.Result(),
+		})
 	}
-	zmqMessage["type"] = "normal"
-	zmqMessage["hash"] = fmt.Sprintf("%X", tmhash.Sum(txBytes))
-	b, _ := msgpack.Marshal(zmqMessage)
-	app.ZmqSendMessage(topic, b)
 }
 
-func extractPriceAndSpread(msg string) (string, string) {
-	sDec, _ := base64.StdEncoding.DecodeString(msg)
-	temp := make(map[string]map[string]string)
-	json.Unmarshal(sDec, &temp)
-	swapMsg := temp["swap"]
-	if swapMsg == nil {
-		return "1", "1"
+// HandleDecodedTx publishes the swap intents the configured decoders extract from the execute
+func (app *TerraApp) HandleDecodedTx(ctx sdk.Context, msg *types.MsgExecuteContract, txBytes []byte, sim *txSimulation) {
+	if app.decoders.Len() == 0 {
+		return
 	}
-	return swapMsg["belief_price"], swapMsg["max_spread"]
-}
 
-func (app *TerraApp) checkBalance(ctx sdk.Context, assetIn, sender string, amount int) bool {
-	walletAddr, _ := sdk.AccAddressFromBech32(sender)
-	senderBalance := 0
-	if assetIn == "UST" {
-		senderBalance = int(app.BankKeeper.GetBalance(ctx, walletAddr, "uusd").Amount.Int64())
-	} else if assetIn == "LUNA" {
-		senderBalance = int(app.BankKeeper.GetBalance(ctx, walletAddr, "uluna").Amount.Int64())
-	} else {
-		q := wasmkeeper.NewWasmQuerier(app.WasmKeeper)
-		query := make(map[string]interface{})
-		query["balance"] = make(map[string]interface{})
-		query["balance"].(map[string]interface{})["address"] = sender
-		queryJson, _ := json.Marshal(query)
-		contractAddress := app.mirrorToken["normal"][assetIn]
-		result, err := q.CustomQuery(ctx, contractAddress, queryJson)
+	execute := decoder.Execute{
+		Contract: msg.Contract,
+		Sender:   msg.Sender,
+		Msg:      msg.ExecuteMsg,
+		Funds:    msg.Coins,
+	}
+
+	if app.decoders.HasCodeIDs() {
+		contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
 		if err != nil {
-			fmt.Println(err)
-			return false
+			return
 		}
 
-		jsonData := make(map[string]string)
-		json.Unmarshal(result, &jsonData)
-		senderBalance, _ = strconv.Atoi(jsonData["balance"])
+		if contractInfo, err := app.WasmKeeper.GetContractInfo(ctx, contractAddr); err == nil {
+			execute.CodeID = contractInfo.CodeID
+		}
 	}
 
-	if senderBalance < amount {
-		return false
+	for _, result := range app.decoders.Decode(execute) {
+		app.SendRoutedFeedMessage(feedtypes.TopicDecodedSwap, feedRouting(msg, result.Intent.Amount), &feedtypes.DecodedSwap{
+			Decoder:    result.Decoder,
+			Contract:   msg.Contract,
+			Sender:     msg.Sender,
+			Data:       result.Intent,
+			Hash:       txHash(txBytes),
+			Simulation: sim.Result(),
+		})
 	}
-
-	return true
 }
 
-func (app *TerraApp) HandleTerraTx(ctx sdk.Context, msg *types.MsgExecuteContract, txBytes []byte) {
-	topic := ""
-	zmqMessage := make(map[string]interface{})
-	data, _ := msg.ExecuteMsg.MarshalJSON()
-
-	assetIn := ""
-	amount := 0
-	assetName := app.terraToken["reverse"][msg.Contract]
+func (app *TerraApp) HandleSendTx(msg *banktypes.MsgSend) {
+	if msg.FromAddress != app.GetWallets()["shuttle"] || msg.ToAddress != app.GetWallets()["mirrorWallet"] {
+		return
+	}
 
-	pairName := ""
-	if assetName == "" {
-		pairName = app.terraPair["reverse"][msg.Contract]
-		pieces := strings.Split(pairName, "-")
-		if pieces[0] == "UST" || pieces[0] == "LUNA" {
-			assetName = pieces[0]
-		} else {
-			return
-		}
+	feedMsg := &feedtypes.MirrorReceiveShuttle{Amount: sdk.ZeroInt()}
+	for _, coin := range msg.Amount {
+		feedMsg.AssetName = app.changeDenomName(coin.Denom)
+		feedMsg.Amount = coin.Amount
 	}
 
-	msgExecute := make(map[string]interface{})
-	json.Unmarshal(data, &msgExecute)
+	app.SendRoutedFeedMessage(feedtypes.TopicMirrorReceiveShuttle, feedtypes.Routing{Sender: msg.FromAddress, Amount: &feedMsg.Amount}, feedMsg)
+}
 
-	if msgExecute["swap"] != nil {
-		for _, coin := range msg.Coins {
-			assetIn = app.changeDenomName(coin.Denom)
-			amount = int(coin.Amount.Int64())
-		}
-		zmqMessage["data"] = make(map[string]interface{})
-		zmqMessage["data"].(map[string]interface{})["pairName"] = pairName
-		zmqMessage["data"].(map[string]interface{})["assetIn"] = assetIn
-		zmqMessage["data"].(map[string]interface{})["amount"] = amount
-
-		topic = "terraSwapStart"
-	} else if msgExecute["send"] != nil {
-		obj := msgExecute["send"].(map[string]interface{})
-		contract := obj["contract"].(string)
-		pairName = app.terraPair["reverse"][contract]
-		amount, _ = strconv.Atoi(obj["amount"].(string))
-		if pairName != "" && strings.Contains(pairName, assetName) {
-			assetIn = assetName
-			zmqMessage["data"] = make(map[string]interface{})
-			zmqMessage["data"].(map[string]interface{})["pairName"] = pairName
-			zmqMessage["data"].(map[string]interface{})["assetIn"] = assetIn
-			zmqMessage["data"].(map[string]interface{})["amount"] = amount
-			topic = "terraSwapStart"
-		} else if contract == app.GetWallets()["terraFactory"] {
-			zmqMessage["type"] = "terra"
-			zmqMessage["msg"] = obj["msg"].(string)
-			zmqMessage["amount"] = amount
-			topic = "factorySwap"
-		} else if contract == app.GetWallets()["astroFactory"] {
-			zmqMessage["type"] = "astro"
-			zmqMessage["msg"] = obj["msg"].(string)
-			zmqMessage["amount"] = amount
-			topic = "factorySwap"
-		} else {
-			return
-		}
-	} else {
+// HandleTransferTx publishes the cw20 transfers of the shuttle to the mirror wallet
+func (app *TerraApp) HandleTransferTx(msg *types.MsgExecuteContract, txBytes []byte) {
+	if msg.Sender != app.GetWallets()["shuttle"] {
 		return
 	}
-	zmqMessage["hash"] = fmt.Sprintf("%X", tmhash.Sum(txBytes))
-	b, _ := msgpack.Marshal(zmqMessage)
-	app.ZmqSendMessage(topic, b)
-}
-
-func (app *TerraApp) HandleFactorySwapTx(msg *types.MsgExecuteContract, txBytes []byte, types string) {
 
-	zmqMessage := make(map[string]interface{})
-	data, _ := msg.ExecuteMsg.MarshalJSON()
-	msgExecute := make(map[string]interface{})
-	json.Unmarshal(data, &msgExecute)
-
-	amount := 0
-	for _, coin := range msg.Coins {
-		amount = int(coin.Amount.Int64())
+	assetName := app.GetAddressMap("mirrorToken")["reverse"][msg.Contract]
+	if assetName == "" {
+		return
 	}
-	message := base64.StdEncoding.EncodeToString(data)
-	zmqMessage["type"] = types
-	zmqMessage["msg"] = message
-	zmqMessage["amount"] = amount
-	zmqMessage["hash"] = fmt.Sprintf("%X", tmhash.Sum(txBytes))
-	topic := "factorySwap"
-	b, _ := msgpack.Marshal(zmqMessage)
-	app.ZmqSendMessage(topic, b)
-}
-
-func (app *TerraApp) HandleMintTx(ctx sdk.Context, data, txBytes []byte) {
-	assetAmount := 0
-	pairName := ""
-	price := ""
-	maxSpread := ""
-	msgExecute := make(map[string]interface{})
-	json.Unmarshal(data, &msgExecute)
-	if msgExecute["mint"] != nil {
-		idx := msgExecute["mint"].(map[string]interface{})["position_idx"].(string)
-		if !app.isShortPosition(ctx, idx) {
-			return
-		}
-
-		asset := msgExecute["mint"].(map[string]interface{})["asset"].(map[string]interface{})
-		tokenContract := asset["info"].(map[string]interface{})["token"].(map[string]interface{})["contract_addr"].(string)
-		pairName = app.mirrorToken["reverse"][tokenContract]
-		if pairName == "" {
-			return
-		}
-		assetAmount, _ = strconv.Atoi(asset["amount"].(string))
-		price = "1"
-		maxSpread = "1"
-	} else if msgExecute["open_position"] != nil {
-
-		openPosition := msgExecute["open_position"].(map[string]interface{})
-		collateralRatio, _ := strconv.ParseFloat(openPosition["collateral_ratio"].(string), 64)
-		assetAddr := openPosition["asset_info"].(map[string]interface{})["token"].(map[string]interface{})["contract_addr"].(string)
-		pairName = app.mirrorToken["reverse"][assetAddr]
-		if pairName == "" {
-			return
-		}
 
-		collateralAmount, _ := strconv.ParseFloat(openPosition["collateral"].(map[string]interface{})["amount"].(string), 64)
-		collateralAmount /= collateralRatio
-		collateralInfo := openPosition["collateral"].(map[string]interface{})["info"].(map[string]interface{})
-		if collateralInfo["token"] != nil {
-			tokenAddr := collateralInfo["token"].(map[string]interface{})["contract_addr"].(string)
-			collateralAsset := app.terraToken["reverse"][tokenAddr]
-			if collateralAsset != "AUST" {
-				collateralAsset = app.mirrorToken["reverse"][tokenAddr]
-				if collateralAsset == "" {
-					return
-				}
-
-				oraclePrice := app.getMirrorOraclePrice(ctx, tokenAddr)
-				collateralAmount *= oraclePrice
-			} else {
-				ancRate := app.getAncRate(ctx)
-				collateralAmount *= ancRate
-			}
-		}
-		price = openPosition["short_params"].(map[string]interface{})["belief_price"].(string)
-		maxSpread = openPosition["short_params"].(map[string]interface{})["max_spread"].(string)
-
-		oraclePrice := app.getMirrorOraclePrice(ctx, assetAddr)
-		amount := collateralAmount / oraclePrice
-		assetAmount = int(amount)
+	var executeMsg struct {
+		Transfer *struct {
+			Recipient string  `json:"recipient"`
+			Amount    sdk.Int `json:"amount"`
+		} `json:"transfer"`
+	}
+	if err := json.Unmarshal(msg.ExecuteMsg, &executeMsg); err != nil || executeMsg.Transfer == nil {
+		return
+	}
 
-	} else {
+	transfer := executeMsg.Transfer
+	if transfer.Recipient != app.GetWallets()["mirrorWallet"] || transfer.Amount.IsNil() {
 		return
 	}
 
-	zmqMessage := make(map[string]interface{})
-	zmqMessage["data"] = make(map[string]interface{})
-	zmqMessage["data"].(map[string]interface{})["pairName"] = pairName
-	zmqMessage["data"].(map[string]interface{})["assetIn"] = pairName
-	zmqMessage["data"].(map[string]interface{})["amount"] = assetAmount
-	zmqMessage["data"].(map[string]interface{})["maxSpread"] = maxSpread
-	zmqMessage["data"].(map[string]interface{})["price"] = price
-	zmqMessage["hash"] = fmt.Sprintf("%X", tmhash.Sum(txBytes))
-	zmqMessage["type"] = "mint"
-	topic := "mirrorSwapStart"
-
-	b, _ := msgpack.Marshal(zmqMessage)
-	app.ZmqSendMessage(topic, b)
+	app.SendRoutedFeedMessage(feedtypes.TopicMirrorReceiveShuttle, feedRouting(msg, transfer.Amount), &feedtypes.MirrorReceiveShuttle{
+		AssetName: assetName,
+		Amount:    transfer.Amount,
+		Hash:      txHash(txBytes),
+	})
 }
 
-func (app *TerraApp) getAncRate(ctx sdk.Context) float64 {
+func (app *TerraApp) getAncRate(ctx sdk.Context) sdk.Dec {
 	query := make(map[string]interface{})
 	query["epoch_state"] = make(map[string]interface{})
 
@@ -397,104 +224,97 @@ func (app *TerraApp) getAncRate(ctx sdk.Context) float64 {
 	result, _ := q.CustomQuery(ctx, app.GetWallets()["ancContract"], queryJson)
 	jsonData := make(map[string]interface{})
 	json.Unmarshal(result, &jsonData)
-	ancRate, _ := strconv.ParseFloat(jsonData["exchange_rate"].(string), 64)
-	return ancRate
-}
-
-func (app *TerraApp) getMirrorOraclePrice(ctx sdk.Context, address string) float64 {
-	query := make(map[string]interface{})
-	query["price"] = make(map[string]interface{})
-	query["price"].(map[string]interface{})["base_asset"] = address
-	query["price"].(map[string]interface{})["quote_asset"] = "uusd"
-	queryJson, _ := json.Marshal(query)
-
-	q := wasmkeeper.NewWasmQuerier(app.WasmKeeper)
-	result, _ := q.CustomQuery(ctx, app.GetWallets()["mirrorOracle"], queryJson)
-	jsonData := make(map[string]interface{})
-	json.Unmarshal(result, &jsonData)
-	oraclePrice, _ := strconv.ParseFloat(jsonData["rate"].(string), 64)
-	return oraclePrice
-}
-
-func (app *TerraApp) isShortPosition(ctx sdk.Context, idx string) bool {
-	query := make(map[string]interface{})
-	query["position"] = make(map[string]interface{})
-	query["position"].(map[string]interface{})["position_idx"] = idx
-	queryJson, _ := json.Marshal(query)
+	ancRate, err := sdk.NewDecFromStr(jsonData["exchange_rate"].(string))
+	if err != nil {
+		return sdk.ZeroDec()
+	}
 
-	q := wasmkeeper.NewWasmQuerier(app.WasmKeeper)
-	result, _ := q.CustomQuery(ctx, app.GetWallets()["mintContract"], queryJson)
-	jsonData := make(map[string]interface{})
-	json.Unmarshal(result, &jsonData)
-	isShort := jsonData["is_short"].(bool)
-	return isShort
+	return ancRate
 }
 
 func (app *TerraApp) SendMirrorBalances(ctx sdk.Context) {
 	q := wasmkeeper.NewWasmQuerier(app.WasmKeeper)
+	state := app.feedBlock
+	topic := feedtypes.TopicMirrorUpdateAccount
 
 	wallet := app.GetWallets()
-	zmqMessage := make(map[string]interface{})
 	query := make(map[string]interface{})
 
 	walletAddr, _ := sdk.AccAddressFromBech32(wallet["mirrorWallet"])
 	sequence, _ := app.AccountKeeper.GetSequence(ctx, walletAddr)
-	ust := app.BankKeeper.GetBalance(ctx, walletAddr, "uusd")
 
-	zmqMessage["balance"] = make(map[string]interface{})
-	zmqMessage["sequence"] = sequence
+	feedMsg := &feedtypes.MirrorUpdateAccount{Sequence: sequence}
+	addBalance := func(balance feedtypes.Balance) {
+		if state.setBalance(topic, balance) || state.snapshot {
+			feedMsg.Balances = append(feedMsg.Balances, balance)
+		}
+	}
 
-	zmqMessage["balance"].(map[string]interface{})["UST"] = ust.Amount.String()
-	luna := app.BankKeeper.GetBalance(ctx, walletAddr, "uluna")
-	zmqMessage["balance"].(map[string]interface{})["LUNA"] = luna.Amount.String()
+	if state.snapshot || state.changes.Account(wallet["mirrorWallet"]) {
+		ust := app.BankKeeper.GetBalance(ctx, walletAddr, "uusd")
+		luna := app.BankKeeper.GetBalance(ctx, walletAddr, "uluna")
+		addBalance(feedtypes.Balance{Asset: "UST", Amount: ust.Amount})
+		addBalance(feedtypes.Balance{Asset: "LUNA", Amount: luna.Amount})
+	}
 
 	query["balance"] = make(map[string]interface{})
 	query["balance"].(map[string]interface{})["address"] = wallet["mirrorWallet"]
 
 	queryJson, _ := json.Marshal(query)
-	for assetName, contractAddress := range app.mirrorToken["normal"] {
-		result, err := q.CustomQuery(ctx, contractAddress, queryJson)
+
+	// iterate the tokens in a stable order to keep the published balances deterministic
+	tokens := make(map[string]string)
+	for assetName, contractAddress := range app.GetAddressMap("mirrorToken")["normal"] {
+		tokens[assetName] = contractAddress
+	}
+	if aust := app.GetAddressMap("terraToken")["normal"]["AUST"]; aust != "" {
+		tokens["AUST"] = aust
+	}
+
+	assetNames := make([]string, 0, len(tokens))
+	for assetName := range tokens {
+		assetNames = append(assetNames, assetName)
+	}
+	sort.Strings(assetNames)
+
+	for _, assetName := range assetNames {
+		// cw20 balances only change when the token contract is executed
+		if !state.snapshot && !state.changes.Contract(tokens[assetName]) {
+			continue
+		}
+
+		if state.expired(ctx) {
+			return
+		}
+
+		result, err := q.CustomQuery(ctx, tokens[assetName], queryJson)
 		if err != nil {
-			fmt.Println(err)
+			app.Logger().Error("failed to query the mirror wallet balance for the feed", "contract", tokens[assetName], "height", state.height, "err", err)
 			continue
 		}
 
 		jsonData := make(map[string]interface{})
 		json.Unmarshal(result, &jsonData)
-		balance := jsonData["balance"]
 
-		zmqMessage["balance"].(map[string]interface{})[assetName] = balance
+		addBalance(feedtypes.Balance{Asset: assetName, Amount: jsonInt(jsonData["balance"])})
 	}
 
-	result, err := q.CustomQuery(ctx, app.terraToken["normal"]["AUST"], queryJson)
-	if err != nil {
-		fmt.Println(err)
+	if !state.setAccountSequence(topic, sequence) && len(feedMsg.Balances) == 0 && !state.snapshot {
 		return
 	}
 
-	jsonData := make(map[string]interface{})
-	json.Unmarshal(result, &jsonData)
-	balance := jsonData["balance"]
-
-	zmqMessage["AUST"] = balance
-
-	b, _ := msgpack.Marshal(zmqMessage)
-	app.ZmqSendMessage("mirrorUpdateAccount", b)
+	feedMsg.Update = state.update(topic)
+	app.SendFeedMessage(topic, feedMsg)
 }
 
 func (app *TerraApp) SendAncRate(ctx sdk.Context) {
-	ancRate := app.getAncRate(ctx)
-	zmqMessage := make(map[string]interface{})
-	zmqMessage["ancRate"] = ancRate
-
-	b, _ := msgpack.Marshal(zmqMessage)
-	app.ZmqSendMessage("ancRate", b)
+	app.SendFeedMessage(feedtypes.TopicAncRate, &feedtypes.AncRate{ExchangeRate: app.getAncRate(ctx)})
 }
 
 func (app *TerraApp) SendTerraBalances(ctx sdk.Context) {
-
+	state := app.feedBlock
+	topic := feedtypes.TopicTerraUpdateAccount
 	wallet := app.GetWallets()
-	zmqMessage := make(map[string]interface{})
 
 	walletAddr, _ := sdk.AccAddressFromBech32(wallet["terraWallet"])
 	sequence, _ := app.AccountKeeper.GetSequence(ctx, walletAddr)
@@ -502,22 +322,29 @@ func (app *TerraApp) SendTerraBalances(ctx sdk.Context) {
 	contractUst := app.BankKeeper.GetBalance(ctx, contractAddr, "uusd")
 	accountUst := app.BankKeeper.GetBalance(ctx, walletAddr, "uusd")
 
-	zmqMessage["Contract"] = contractUst.Amount.String()
-	zmqMessage["Account"] = accountUst.Amount.String()
-	zmqMessage["sequence"] = sequence
+	// both balances are always published, they are two cheap store reads
+	changed := state.setBalance(topic, feedtypes.Balance{Asset: "Contract", Amount: contractUst.Amount})
+	changed = state.setBalance(topic, feedtypes.Balance{Asset: "Account", Amount: accountUst.Amount}) || changed
+	changed = state.setAccountSequence(topic, sequence) || changed
+	if !changed && !state.snapshot {
+		return
+	}
 
-	b, _ := msgpack.Marshal(zmqMessage)
-	app.ZmqSendMessage("terraAccount", b)
+	app.SendFeedMessage(topic, &feedtypes.TerraUpdateAccount{
+		Contract: contractUst.Amount,
+		Account:  accountUst.Amount,
+		Sequence: sequence,
+		Update:   state.update(topic),
+	})
 }
 
 func (app *TerraApp) SendPools(ctx sdk.Context, types string) {
-	fmt.Println(types, "Sendpools")
+	state := app.feedBlock
+	topic := types + "UpdateReserve"
 
 	pair := app.GetAddressMap(types + "Pair")
 	token := app.GetAddressMap(types + "Token")
 
-	zmqMessage := make(map[string]interface{})
-
 	query := make(map[string]interface{})
 	query["pool"] = make(map[string]interface{})
 
@@ -525,23 +352,41 @@ func (app *TerraApp) SendPools(ctx sdk.Context, types string) {
 
 	q := wasmkeeper.NewWasmQuerier(app.WasmKeeper)
 
-	for name, contractAddress := range pair["normal"] {
+	// iterate the pairs in a stable order to keep the published reserves deterministic
+	names := make([]string, 0, len(pair["normal"]))
+	for name := range pair["normal"] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
+	feedMsg := &feedtypes.UpdateReserve{}
+	for _, name := range names {
 		if name == "BLUNA-NLUNA" || name == "BETH-NETH" {
 			continue
 		}
 
-		result, err := q.CustomQuery(ctx, contractAddress, queryJson)
-		if err != nil {
-			fmt.Println(err)
+		// the reserves only change when the pair contract is executed
+		if !state.snapshot && !state.changes.Contract(pair["normal"][name]) {
 			continue
 		}
 
-		zmqMessage[name] = make(map[string]interface{})
+		if state.expired(ctx) {
+			return
+		}
+
+		result, err := q.CustomQuery(ctx, pair["normal"][name], queryJson)
+		if err != nil {
+			app.Logger().Error("failed to query the pool reserves for the feed", "contract", pair["normal"][name], "height", state.height, "err", err)
+			continue
+		}
 
 		jsonData := make(map[string]([]map[string]interface{}))
 		json.Unmarshal(result, &jsonData)
 		assets := jsonData["assets"]
+		if len(assets) < 2 {
+			continue
+		}
+
 		assetName0 := extractAssetName(assets[0]["info"].(map[string]interface{}), token["reverse"])
 		assetName1 := extractAssetName(assets[1]["info"].(map[string]interface{}), token["reverse"])
 
@@ -549,14 +394,25 @@ func (app *TerraApp) SendPools(ctx sdk.Context, types string) {
 			continue
 		}
 
-		amount0 := assets[0]["amount"]
-		amount1 := assets[1]["amount"]
-		zmqMessage[name].(map[string]interface{})[assetName0] = amount0
-		zmqMessage[name].(map[string]interface{})[assetName1] = amount1
+		reserve := feedtypes.PoolReserve{
+			PairName: name,
+			Reserves: []feedtypes.Balance{
+				{Asset: assetName0, Amount: jsonInt(assets[0]["amount"])},
+				{Asset: assetName1, Amount: jsonInt(assets[1]["amount"])},
+			},
+		}
+
+		if state.setReserve(topic, reserve) || state.snapshot {
+			feedMsg.Pools = append(feedMsg.Pools, reserve)
+		}
+	}
+
+	if len(feedMsg.Pools) == 0 && !state.snapshot {
+		return
 	}
 
-	b, _ := msgpack.Marshal(zmqMessage)
-	app.ZmqSendMessage(types+"UpdateReserve", b)
+	feedMsg.Update = state.update(topic)
+	app.SendFeedMessage(topic, feedMsg)
 }
 
 func extractAssetName(info map[string]interface{}, tokenReverse map[string]string) (assetName string) {
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// ReplayBlock runs the txs of a committed block through the HandleCheckTx
+// pipeline on a branch of the state committed before the block, so the
+// feed is emitted as the mempool would have seen the txs. Like CheckTx,
+// the ante handler of every tx is applied to the branch before the tx is
+// handled and a tx failing it is skipped; the msgs are only simulated.
+func (app *TerraApp) ReplayBlock(header tmproto.Header, txs [][]byte) error {
+	// the iavl stores branch an empty tree for a version they do not have
+	if lastHeight := app.LastBlockHeight(); header.Height < 1 || header.Height-1 > lastHeight {
+		return fmt.Errorf("the state before height %d is not committed, the latest height is %d", header.Height, lastHeight)
+	}
+
+	cms, err := app.NewUncachedContext(true, header).MultiStore().CacheMultiStoreWithVersion(header.Height - 1)
+	if err != nil {
+		return err
+	}
+
+	txDecoder := MakeEncodingConfig().TxConfig.TxDecoder()
+
+	ctx := sdk.NewContext(cms, header, true, app.Logger())
+	for _, txBytes := range txs {
+		tx, err := txDecoder(txBytes)
+		if err != nil {
+			continue
+		}
+
+		txCtx := ctx.WithTxBytes(txBytes)
+		anteCtx, write := txCtx.CacheContext()
+		if _, err := app.anteHandler(anteCtx, tx, false); err != nil {
+			app.Logger().Debug("replayed tx failed the ante handler", "hash", txHash(txBytes), "err", err)
+			continue
+		}
+		write()
+
+		app.HandleCheckTx(txCtx, txBytes)
+	}
+
+	return nil
+}
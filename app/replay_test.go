@@ -0,0 +1,72 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/app/feed"
+	feedtypes "github.com/terra-money/core/app/feed/types"
+	core "github.com/terra-money/core/types"
+	markettypes "github.com/terra-money/core/x/market/types"
+)
+
+func TestReplayBlock(t *testing.T) {
+	s := newSimulateTestApp(t)
+
+	offerCoin := sdk.NewInt64Coin(core.MicroLunaDenom, 1000000)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 10000))
+	s.fund(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, offerCoin.Amount.Add(fees.AmountOf(core.MicroLunaDenom)).MulRaw(3))))
+
+	swap := []sdk.Msg{markettypes.NewMsgSwap(s.trader, offerCoin, core.MicroKRWDenom)}
+
+	// commit a block of two txs of the trader and a block of one
+	var headers []tmproto.Header
+	var blocks [][][]byte
+	for _, numTxs := range []int{2, 1} {
+		var txs [][]byte
+		for i := 0; i < numTxs; i++ {
+			_, txBytes := s.signTx(swap, fees)
+			txs = append(txs, txBytes)
+		}
+
+		for _, res := range s.deliverBlock(txs...) {
+			require.Equal(t, uint32(0), res.Code, res.Log)
+		}
+
+		headers = append(headers, s.header)
+		blocks = append(blocks, txs)
+	}
+
+	// a tx the ante handler rejects, signed with a sequence used by the first block
+	s.nextSeq = 0
+	_, replayedTx := s.signTx(swap, fees)
+
+	sink := feed.NewMemorySink()
+	s.SetFeedSink(sink)
+
+	// the committed blocks are replayed from the db the app committed them to
+	var hashes []string
+	for i, header := range headers {
+		require.NoError(t, s.ReplayBlock(header, blocks[i]))
+
+		for _, txBytes := range blocks[i] {
+			hashes = append(hashes, txHash(txBytes))
+		}
+	}
+	require.NoError(t, s.ReplayBlock(headers[1], [][]byte{replayedTx}))
+
+	var replayed []string
+	for _, msg := range sink.MessagesByTopic(feedtypes.TopicCheckTx) {
+		_, checkTx, err := feedtypes.DecodeFrame(msg.Payload)
+		require.NoError(t, err)
+		replayed = append(replayed, checkTx.(*feedtypes.CheckTx).Hash)
+	}
+	require.Equal(t, hashes, replayed)
+
+	// the state before the block must be committed
+	require.Error(t, s.ReplayBlock(tmproto.Header{ChainID: simulateTestChainID, Height: s.LastBlockHeight() + 2}, nil))
+}
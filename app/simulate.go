@@ -0,0 +1,240 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	feedtypes "github.com/terra-money/core/app/feed/types"
+	markettypes "github.com/terra-money/core/x/market/types"
+	wasmkeeper "github.com/terra-money/core/x/wasm/keeper"
+	wasmtypes "github.com/terra-money/core/x/wasm/types"
+)
+
+// txSimulation lazily simulates a pending tx on a branch of the CheckTx state,
+// so a tx is simulated at most once however many intents it publishes
+type txSimulation struct {
+	app *TerraApp
+	ctx sdk.Context
+	tx  sdk.Tx
+
+	done   bool
+	result *feedtypes.Simulation
+}
+
+// newTxSimulation returns the simulation of the tx, nil when simulation is disabled
+func (app *TerraApp) newTxSimulation(ctx sdk.Context, tx sdk.Tx) *txSimulation {
+	if app.feedConfig == nil || !app.feedConfig.Simulate {
+		return nil
+	}
+
+	return &txSimulation{app: app, ctx: ctx, tx: tx}
+}
+
+// Result runs the simulation on first use and returns its outcome,
+// nil when simulation is disabled
+func (s *txSimulation) Result() *feedtypes.Simulation {
+	if s == nil {
+		return nil
+	}
+
+	if !s.done {
+		s.result = s.app.SimulateTx(s.ctx, s.tx)
+		s.done = true
+	}
+
+	return s.result
+}
+
+// SimulateTx executes the msgs of the tx on a branch of the state the way DeliverTx
+// does and returns the emitted wasm events, the amount returned by the last swap and
+// the reserves of the pairs swapped on; the branch is always discarded.
+// The ante handler is not run again: ctx must hold the state the tx passed it on, with
+// the fees and tax deducted and the sequences incremented, as the CheckTx state does
+// once the tx passed CheckTx. The msgs run with the gas limit of the tx, capped by the
+// simulate gas limit; the gas consumed by the ante handler is not counted.
+func (app *TerraApp) SimulateTx(ctx sdk.Context, tx sdk.Tx) (simulation *feedtypes.Simulation) {
+	simulation = &feedtypes.Simulation{ReturnAmount: sdk.ZeroInt()}
+
+	gasLimit := app.feedConfig.SimulateGasLimit
+	if feeTx, ok := tx.(sdk.FeeTx); ok && feeTx.GetGas() < gasLimit {
+		gasLimit = feeTx.GetGas()
+	}
+
+	cacheCtx, _ := ctx.WithIsCheckTx(false).WithGasMeter(sdk.NewGasMeter(gasLimit)).CacheContext()
+	defer func() {
+		// running out of gas panics
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(sdk.ErrorOutOfGas); ok {
+				simulation.Error = fmt.Sprintf("out of gas in location: %v; gasWanted: %d", outOfGas.Descriptor, gasLimit)
+			} else {
+				simulation.Error = fmt.Sprintf("simulation panicked: %v", r)
+			}
+		}
+
+		simulation.GasUsed = cacheCtx.GasMeter().GasConsumed()
+	}()
+
+	var events []abci.Event
+	for _, msg := range tx.GetMsgs() {
+		handler := app.MsgServiceRouter().Handler(msg)
+		if handler == nil {
+			simulation.Error = fmt.Sprintf("no handler for msg %s", sdk.MsgTypeURL(msg))
+			return simulation
+		}
+
+		// every msg runs with its own event manager, as in DeliverTx
+		res, err := handler(cacheCtx.WithEventManager(sdk.NewEventManager()), msg)
+		if err != nil {
+			simulation.Error = err.Error()
+			return simulation
+		}
+
+		events = append(events, res.Events...)
+	}
+
+	var swappedPairs []string
+	addWasmEvent := func(wasmEvent *feedtypes.WasmEvent) {
+		if wasmEvent == nil {
+			return
+		}
+
+		simulation.WasmEvents = append(simulation.WasmEvents, *wasmEvent)
+
+		attributes := make(map[string]string)
+		for _, attr := range wasmEvent.Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+
+		if attributes["action"] != "swap" {
+			return
+		}
+
+		if returnAmount, ok := sdk.NewIntFromString(attributes["return_amount"]); ok {
+			simulation.ReturnAmount = returnAmount
+		}
+
+		swappedPairs = appendUnique(swappedPairs, wasmEvent.ContractAddress)
+	}
+
+	for _, event := range events {
+		// market swaps, made by the tx or by a contract, return the swap coin
+		if event.Type == markettypes.EventSwap {
+			for _, attr := range event.Attributes {
+				if string(attr.Key) != markettypes.AttributeKeySwapCoin {
+					continue
+				}
+
+				if swapCoin, err := sdk.ParseCoinNormalized(string(attr.Value)); err == nil {
+					simulation.ReturnAmount = swapCoin.Amount
+				}
+			}
+
+			continue
+		}
+
+		if event.Type != wasmtypes.EventTypeWasmPrefix && !strings.HasPrefix(event.Type, wasmtypes.EventTypeWasmPrefix+"-") {
+			continue
+		}
+
+		// a wasm event holds the attributes of every contract call,
+		// each introduced by the address of the contract
+		var wasmEvent *feedtypes.WasmEvent
+		for _, attr := range event.Attributes {
+			key, value := string(attr.Key), string(attr.Value)
+			if key == wasmtypes.AttributeKeyContractAddress {
+				addWasmEvent(wasmEvent)
+				wasmEvent = &feedtypes.WasmEvent{Type: event.Type, ContractAddress: value}
+				continue
+			}
+
+			if wasmEvent == nil {
+				continue
+			}
+
+			wasmEvent.Attributes = append(wasmEvent.Attributes, feedtypes.Attribute{Key: key, Value: value})
+		}
+
+		addWasmEvent(wasmEvent)
+	}
+
+	for _, pair := range swappedPairs {
+		if reserve, ok := app.queryPoolReserve(cacheCtx, pair); ok {
+			simulation.Reserves = append(simulation.Reserves, reserve)
+		}
+	}
+
+	return simulation
+}
+
+// queryPoolReserve queries the reserves of the pair contract, naming the pair
+// and its assets after the address book where possible
+func (app *TerraApp) queryPoolReserve(ctx sdk.Context, contract string) (feedtypes.PoolReserve, bool) {
+	queryJson, _ := json.Marshal(map[string]interface{}{"pool": map[string]interface{}{}})
+
+	q := wasmkeeper.NewWasmQuerier(app.WasmKeeper)
+	result, err := q.CustomQuery(ctx, contract, queryJson)
+	if err != nil {
+		return feedtypes.PoolReserve{}, false
+	}
+
+	var pool struct {
+		Assets []struct {
+			Info   map[string]map[string]string `json:"info"`
+			Amount string                       `json:"amount"`
+		} `json:"assets"`
+	}
+	if err := json.Unmarshal(result, &pool); err != nil {
+		return feedtypes.PoolReserve{}, false
+	}
+
+	tokens := []map[string]string{
+		app.GetAddressMap("terraToken")["reverse"],
+		app.GetAddressMap("mirrorToken")["reverse"],
+	}
+
+	reserve := feedtypes.PoolReserve{PairName: contract}
+	for _, pairs := range []map[string]string{app.GetAddressMap("terraPair")["reverse"], app.GetAddressMap("mirrorPair")["reverse"]} {
+		if name := pairs[contract]; name != "" {
+			reserve.PairName = name
+			break
+		}
+	}
+
+	for _, asset := range pool.Assets {
+		id := asset.Info["native_token"]["denom"]
+		if id == "" {
+			id = asset.Info["token"]["contract_addr"]
+		}
+
+		name := id
+		for _, token := range tokens {
+			if tokenName := token[id]; tokenName != "" {
+				name = tokenName
+				break
+			}
+		}
+
+		amount, ok := sdk.NewIntFromString(asset.Amount)
+		if !ok {
+			return feedtypes.PoolReserve{}, false
+		}
+
+		reserve.Reserves = append(reserve.Reserves, feedtypes.Balance{Asset: name, Amount: amount})
+	}
+
+	return reserve, true
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+
+	return append(values, value)
+}
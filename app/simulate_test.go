@@ -0,0 +1,214 @@
+package app
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	feedtypes "github.com/terra-money/core/app/feed/types"
+	core "github.com/terra-money/core/types"
+	markettypes "github.com/terra-money/core/x/market/types"
+	wasmtypes "github.com/terra-money/core/x/wasm/types"
+)
+
+const simulateTestChainID = "simulate-test"
+
+// simulateTestApp is a chain of a single account, which the test txs are signed by
+type simulateTestApp struct {
+	*TerraApp
+
+	t      *testing.T
+	header tmproto.Header
+
+	priv    *secp256k1.PrivKey
+	trader  sdk.AccAddress
+	accNum  uint64
+	nextSeq uint64
+}
+
+func newSimulateTestApp(t *testing.T) *simulateTestApp {
+	app := newFeedTestApp(t, t.TempDir(), viper.New())
+
+	genesis, err := json.Marshal(ModuleBasics.DefaultGenesis(app.AppCodec()))
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         simulateTestChainID,
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   genesis,
+	})
+
+	priv := secp256k1.GenPrivKey()
+	s := &simulateTestApp{
+		TerraApp: app,
+		t:        t,
+		priv:     priv,
+		trader:   sdk.AccAddress(priv.PubKey().Address()),
+	}
+
+	ctx := s.beginBlock()
+	account := app.AccountKeeper.NewAccountWithAddress(ctx, s.trader)
+	app.AccountKeeper.SetAccount(ctx, account)
+	s.accNum = account.GetAccountNumber()
+
+	app.OracleKeeper.SetLunaExchangeRate(ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+	app.OracleKeeper.SetLunaExchangeRate(ctx, core.MicroKRWDenom, sdk.NewDec(1300))
+	s.endBlock()
+
+	return s
+}
+
+// beginBlock begins the next block, returning the context of its deliver state
+func (s *simulateTestApp) beginBlock() sdk.Context {
+	s.header = tmproto.Header{
+		ChainID: simulateTestChainID,
+		Height:  s.LastBlockHeight() + 1,
+		Time:    time.Now().UTC(),
+	}
+	s.BeginBlock(abci.RequestBeginBlock{Header: s.header})
+
+	return s.BaseApp.NewContext(false, s.header)
+}
+
+func (s *simulateTestApp) endBlock() {
+	s.EndBlock(abci.RequestEndBlock{Height: s.header.Height})
+	s.Commit()
+}
+
+// fund mints the coins to the trader in a block of its own
+func (s *simulateTestApp) fund(coins sdk.Coins) {
+	ctx := s.beginBlock()
+	require.NoError(s.t, s.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(s.t, s.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, s.trader, coins))
+	s.endBlock()
+}
+
+// signTx signs the msgs with the next sequence of the trader
+func (s *simulateTestApp) signTx(msgs []sdk.Msg, fees sdk.Coins) (sdk.Tx, []byte) {
+	txConfig := MakeEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(txConfig, msgs, fees, 1000000, simulateTestChainID, []uint64{s.accNum}, []uint64{s.nextSeq}, s.priv)
+	require.NoError(s.t, err)
+	s.nextSeq++
+
+	txBytes, err := txConfig.TxEncoder()(tx)
+	require.NoError(s.t, err)
+
+	return tx, txBytes
+}
+
+// deliverBlock delivers the txs in the next block
+func (s *simulateTestApp) deliverBlock(txs ...[]byte) (res []abci.ResponseDeliverTx) {
+	s.beginBlock()
+	for _, txBytes := range txs {
+		res = append(res, s.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}))
+	}
+	s.endBlock()
+
+	return res
+}
+
+// checkAndDeliver passes the tx to CheckTx and simulates it on the CheckTx state,
+// as the feed does, before delivering it in the next block
+func (s *simulateTestApp) checkAndDeliver(msgs []sdk.Msg, fees sdk.Coins) (*feedtypes.Simulation, abci.ResponseDeliverTx) {
+	tx, txBytes := s.signTx(msgs, fees)
+
+	checkRes := s.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+	require.Equal(s.t, uint32(0), checkRes.Code, checkRes.Log)
+
+	simulation := s.SimulateTx(s.BaseApp.NewContext(true, s.header), tx)
+
+	return simulation, s.deliverBlock(txBytes)[0]
+}
+
+// lastSwapCoin returns the swap coin of the last market swap of the delivered tx
+func lastSwapCoin(t *testing.T, res abci.ResponseDeliverTx) (swapCoin sdk.Coin) {
+	for _, event := range res.Events {
+		if event.Type != markettypes.EventSwap {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == markettypes.AttributeKeySwapCoin {
+				coin, err := sdk.ParseCoinNormalized(string(attr.Value))
+				require.NoError(t, err)
+				swapCoin = coin
+			}
+		}
+	}
+
+	require.True(t, swapCoin.IsPositive(), "no swap in the delivered tx")
+	return swapCoin
+}
+
+func TestSimulateTxMarketSwap(t *testing.T) {
+	s := newSimulateTestApp(t)
+
+	offerCoin := sdk.NewInt64Coin(core.MicroLunaDenom, 1000000)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 10000))
+	s.fund(fees.Add(offerCoin).Add(fees...))
+
+	msgs := []sdk.Msg{markettypes.NewMsgSwap(s.trader, offerCoin, core.MicroKRWDenom)}
+
+	// the simulation returns what the chain executes
+	simulation, res := s.checkAndDeliver(msgs, fees)
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.Empty(t, simulation.Error)
+	require.Equal(t, lastSwapCoin(t, res).Amount, simulation.ReturnAmount)
+	require.NotZero(t, simulation.GasUsed)
+
+	// the fees leave too little to swap the offer, the simulation fails like the tx
+	simulation, res = s.checkAndDeliver(msgs, fees)
+	require.NotEqual(t, uint32(0), res.Code)
+	require.NotEmpty(t, simulation.Error)
+}
+
+func TestSimulateTxWasmSwap(t *testing.T) {
+	s := newSimulateTestApp(t)
+
+	// the maker contract swaps the funds it is sent through the market
+	makerCode, err := ioutil.ReadFile("../x/wasm/keeper/testdata/maker.wasm")
+	require.NoError(t, err)
+
+	ctx := s.beginBlock()
+	codeID, err := s.WasmKeeper.StoreCode(ctx, s.trader, makerCode)
+	require.NoError(t, err)
+
+	initMsg, err := json.Marshal(map[string]string{"offer": core.MicroSDRDenom, "ask": core.MicroLunaDenom})
+	require.NoError(t, err)
+	maker, _, err := s.WasmKeeper.InstantiateContract(ctx, codeID, s.trader, sdk.AccAddress{}, initMsg, nil)
+	require.NoError(t, err)
+
+	// the funds of the execute are taxed
+	offerCoin := sdk.NewInt64Coin(core.MicroSDRDenom, 1000000)
+	taxAmount := s.TreasuryKeeper.GetTaxRate(ctx).MulInt(offerCoin.Amount).TruncateInt()
+	if taxCap := s.TreasuryKeeper.GetTaxCap(ctx, offerCoin.Denom); taxAmount.GT(taxCap) {
+		taxAmount = taxCap
+	}
+	require.True(t, taxAmount.IsPositive())
+	s.endBlock()
+
+	fees := sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, taxAmount))
+	s.fund(fees.Add(offerCoin))
+
+	buyMsg, err := json.Marshal(map[string]interface{}{"buy": map[string]interface{}{}})
+	require.NoError(t, err)
+	msgs := []sdk.Msg{wasmtypes.NewMsgExecuteContract(s.trader, maker, buyMsg, sdk.NewCoins(offerCoin))}
+
+	simulation, res := s.checkAndDeliver(msgs, fees)
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.Empty(t, simulation.Error)
+	require.Equal(t, lastSwapCoin(t, res).Amount, simulation.ReturnAmount)
+	require.NotZero(t, simulation.GasUsed)
+}
@@ -1,12 +1,25 @@
 package app
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feedtypes "github.com/terra-money/core/app/feed/types"
+)
 
 func (app *TerraApp) SendTerraAccount(ctx sdk.Context) {
 	wallet := app.GetWallets()
 	walletAddr, _ := sdk.AccAddressFromBech32(wallet["terraWallet"])
 	account := app.AccountKeeper.GetAccount(ctx, walletAddr)
-	encodingConfig := MakeEncodingConfig()
-	value, _ := encodingConfig.Marshaler.MarshalInterface(account)
-	app.ZmqSendMessage("TerraAccount", value)
+	if account == nil {
+		return
+	}
+
+	accountAny, err := codectypes.NewAnyWithValue(account)
+	if err != nil {
+		app.Logger().Error("failed to pack terra account", "err", err)
+		return
+	}
+
+	app.SendFeedMessage(feedtypes.TopicTerraAccount, &feedtypes.TerraAccount{Account: accountAny})
 }
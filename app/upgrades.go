@@ -0,0 +1,30 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	markettypes "github.com/terra-money/core/x/market/types"
+	oracletypes "github.com/terra-money/core/x/oracle/types"
+)
+
+// UpgradeName is the name of the software upgrade plan which migrates
+// the oracle and market modules to their consensus version 2
+const UpgradeName = "v0.6.0"
+
+// registerUpgradeHandlers registers the handler of each named upgrade plan
+func (app *TerraApp) registerUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// chains started before the version map was stored in InitChainer have none;
+		// RunMigrations would then run InitGenesis of every module, so start from the
+		// versions of the previous release instead
+		if len(fromVM) == 0 {
+			fromVM = app.mm.GetVersionMap()
+			fromVM[oracletypes.ModuleName] = 1
+			fromVM[markettypes.ModuleName] = 1
+		}
+
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+}
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	markettypes "github.com/terra-money/core/x/market/types"
+	oracletypes "github.com/terra-money/core/x/oracle/types"
+)
+
+// downgradeStore leaves the store as the previous release wrote it: without
+// the module version map and the params added since
+func downgradeStore(ctx sdk.Context, app *TerraApp, params map[string][][]byte) {
+	versionMap := prefix.NewStore(ctx.KVStore(app.GetKey(upgradetypes.StoreKey)), []byte{upgradetypes.VersionMapByte})
+	iter := versionMap.Iterator(nil, nil)
+	var modules [][]byte
+	for ; iter.Valid(); iter.Next() {
+		modules = append(modules, iter.Key())
+	}
+	iter.Close()
+	for _, module := range modules {
+		versionMap.Delete(module)
+	}
+
+	for subspace, keys := range params {
+		store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(subspace+"/"))
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+func TestUpgradeFromV1Params(t *testing.T) {
+	s := newSimulateTestApp(t)
+
+	ctx := s.beginBlock()
+	oracleParams := s.OracleKeeper.GetParams(ctx)
+	oracleParams.MinValidPerWindow = sdk.NewDecWithPrec(20, 2)
+	oracleParams.WarningValidPerWindow = sdk.NewDecWithPrec(20, 2)
+	s.OracleKeeper.SetParams(ctx, oracleParams)
+
+	downgradeStore(ctx, s.TerraApp, map[string][][]byte{
+		oracletypes.ModuleName: {
+			oracletypes.KeyWarningValidPerWindow, oracletypes.KeyMaxSlashFraction, oracletypes.KeyJailAfterWindows,
+			oracletypes.KeyPriceFeeds, oracletypes.KeyMaxRateChange,
+		},
+		markettypes.ModuleName: {
+			markettypes.KeyMaxRateAge, markettypes.KeyDenomPools, markettypes.KeySwapFeePairs,
+		},
+	})
+	require.Panics(t, func() { s.OracleKeeper.GetParams(ctx) })
+
+	s.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: UpgradeName, Height: ctx.BlockHeight()})
+	s.endBlock()
+
+	ctx = s.beginBlock()
+	s.endBlock()
+
+	// the migrated modules are at their current version, the others kept their state
+	require.Equal(t, s.mm.GetVersionMap(), s.UpgradeKeeper.GetModuleVersionMap(ctx))
+	require.NotNil(t, s.AccountKeeper.GetAccount(ctx, s.trader))
+
+	migrated := s.OracleKeeper.GetParams(ctx)
+	require.NoError(t, migrated.Validate())
+	require.Equal(t, oracleParams.MinValidPerWindow, migrated.WarningValidPerWindow)
+	require.Equal(t, oracletypes.DefaultMaxSlashFraction, migrated.MaxSlashFraction)
+	require.Equal(t, oracletypes.DefaultJailAfterWindows, migrated.JailAfterWindows)
+	require.Empty(t, migrated.PriceFeeds)
+	require.Equal(t, oracletypes.DefaultMaxRateChange, migrated.MaxRateChange)
+
+	require.Equal(t, markettypes.DefaultMaxRateAge, s.MarketKeeper.MaxRateAge(ctx))
+	require.Empty(t, s.MarketKeeper.DenomPools(ctx))
+	require.Empty(t, s.MarketKeeper.SwapFeePairs(ctx))
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/terra-money/core/app/feed"
 	wasmconfig "github.com/terra-money/core/x/wasm/config"
 
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -11,6 +12,7 @@ type TerraAppConfig struct {
 	serverconfig.Config
 
 	WASMConfig wasmconfig.Config `mapstructure:"wasm"`
+	FeedConfig feed.Config       `mapstructure:"feed"`
 }
 
 // initAppConfig helps to override default appConfig template and configs.
@@ -37,9 +39,10 @@ func initAppConfig() (string, interface{}) {
 	terraAppConfig := TerraAppConfig{
 		Config:     *srvCfg,
 		WASMConfig: *wasmconfig.DefaultConfig(),
+		FeedConfig: *feed.DefaultConfig(),
 	}
 
-	terraAppTemplate := serverconfig.DefaultConfigTemplate + wasmconfig.DefaultConfigTemplate
+	terraAppTemplate := serverconfig.DefaultConfigTemplate + wasmconfig.DefaultConfigTemplate + feed.DefaultConfigTemplate
 
 	return terraAppTemplate, terraAppConfig
 }
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	tmstore "github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	terraapp "github.com/terra-money/core/app"
+	"github.com/terra-money/core/app/feed"
+)
+
+const (
+	flagOutput       = "output"
+	flagAppDBBackend = "app-db-backend"
+)
+
+// feedCommand returns the mempool/state feed commands
+func feedCommand(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "feed",
+		Short:                      "Mempool/state feed subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(replayCommand(appCreator, defaultNodeHome))
+
+	return cmd
+}
+
+// startCommand returns the start command of the server, the node it runs also serves
+// the mempool/state feed, which is stopped once the node is shut down
+func startCommand(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	var app *terraapp.TerraApp
+
+	cmd := server.StartCmd(func(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+		application := appCreator(logger, db, traceStore, appOpts)
+
+		terraApp, ok := application.(*terraapp.TerraApp)
+		if !ok {
+			return application
+		}
+
+		// the feed is not part of consensus, the node keeps running without it
+		if err := terraApp.StartFeed(); err != nil {
+			logger.Error("failed to start the feed, the node runs without it", "err", err)
+			return application
+		}
+
+		app = terraApp
+		return application
+	}, defaultNodeHome)
+
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		defer func() {
+			if app != nil {
+				app.StopFeed()
+			}
+		}()
+
+		return runE(cmd, args)
+	}
+
+	return cmd
+}
+
+// replayCommand returns the command replaying committed blocks through the feed
+func replayCommand(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [from-height] [to-height]",
+		Short: "Replay committed blocks through the mempool feed",
+		Long: `Read the blocks of the range from the block store and run every tx through the
+HandleCheckTx pipeline on the state committed before its block, emitting the feed
+to the NDJSON file given with --output or else to the sink configured in app.toml.
+Messages are sent synchronously and never dropped.
+
+The node must be stopped, and the application state of the heights before the
+range must not be pruned.
+
+Example:
+$ terrad feed replay 5000000 5000100 --output feed.ndjson
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from-height: %w", err)
+			}
+
+			to, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to-height: %w", err)
+			}
+
+			if from < 1 || to < from {
+				return fmt.Errorf("invalid height range: %d-%d", from, to)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			blockStoreDB, err := dbm.NewDB("blockstore", dbm.BackendType(config.DBBackend), config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+
+			blockStore := tmstore.NewBlockStore(blockStoreDB)
+			if from < blockStore.Base() || to > blockStore.Height() {
+				return fmt.Errorf("height range %d-%d not in the block store, which holds %d-%d", from, to, blockStore.Base(), blockStore.Height())
+			}
+
+			// the feed is not started, the messages are sent synchronously through the sink below
+			feedConfig := feed.GetConfig(serverCtx.Viper)
+
+			var sink feed.EventSink
+			if output, _ := cmd.Flags().GetString(flagOutput); output != "" {
+				sink, err = feed.NewFileSink(output)
+			} else {
+				sink, err = feed.NewSink(feedConfig, config.RootDir)
+			}
+			if err != nil {
+				return err
+			}
+
+			appDBBackend, _ := cmd.Flags().GetString(flagAppDBBackend)
+			db, err := openAppDB(config.RootDir, dbm.BackendType(appDBBackend))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app, ok := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper).(*terraapp.TerraApp)
+			if !ok {
+				return fmt.Errorf("unexpected application type")
+			}
+
+			if err := app.ReloadFeedConfig(); err != nil {
+				sink.Close()
+				return err
+			}
+
+			app.SetFeedSink(sink)
+			// closes the replay sink, flushing the output
+			defer app.SetFeedSink(feed.NopSink{})
+
+			for height := from; height <= to; height++ {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return fmt.Errorf("block %d not found", height)
+				}
+
+				txs := make([][]byte, len(block.Txs))
+				for i, tx := range block.Txs {
+					txs[i] = tx
+				}
+
+				if err := app.ReplayBlock(*block.Header.ToProto(), txs); err != nil {
+					return fmt.Errorf("failed to replay block %d: %w", height, err)
+				}
+			}
+
+			cmd.Printf("replayed blocks %d-%d\n", from, to)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagOutput, "", "The NDJSON file the feed is written to, instead of the sink configured in app.toml")
+	cmd.Flags().String(flagAppDBBackend, "", "The backend of the application db, by default the one the node opens it with")
+
+	return cmd
+}
+
+// openAppDB opens the application db of the node home with the backend, an empty
+// backend selects the one the start command opens it with: the backend the binary
+// is built for (COSMOS_BUILD_OPTIONS), goleveldb by default
+func openAppDB(rootDir string, backend dbm.BackendType) (dbm.DB, error) {
+	if backend == "" {
+		backend = dbm.GoLevelDBBackend
+		if sdk.DBBackend != "" {
+			backend = dbm.BackendType(sdk.DBBackend)
+		}
+	}
+
+	return dbm.NewDB("application", backend, filepath.Join(rootDir, "data"))
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	oraclecli "github.com/terra-money/core/x/oracle/client/cli"
+)
+
+// oracleCommand returns the oracle client subcommands
+func oracleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "oracle",
+		Short:                      "Oracle client subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(oraclecli.GetCmdFeeder())
+
+	return cmd
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/terra-money/core/app/params"
 	authcustomcli "github.com/terra-money/core/custom/auth/client/cli"
 	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/types/rules"
 	wasmconfig "github.com/terra-money/core/x/wasm/config"
 )
 
@@ -114,6 +115,20 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	a := appCreator{encodingConfig}
 	server.AddCommands(rootCmd, terraapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
 
+	// replace the start command with one whose node serves the mempool/state feed
+	if startCmd, _, err := rootCmd.Find([]string{"start"}); err == nil && startCmd.Name() == "start" {
+		rootCmd.RemoveCommand(startCmd)
+	}
+	startCmd := startCommand(a.newApp, terraapp.DefaultNodeHome)
+	addModuleInitFlags(startCmd)
+	rootCmd.AddCommand(startCmd)
+
+	// add mempool/state feed commands
+	rootCmd.AddCommand(feedCommand(a.newApp, terraapp.DefaultNodeHome))
+
+	// add oracle feeder commands
+	rootCmd.AddCommand(oracleCommand())
+
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
@@ -126,8 +141,12 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	rootCmd.AddCommand(server.RosettaCommand(encodingConfig.InterfaceRegistry, encodingConfig.Marshaler))
 }
 
+// flagUnsafeRuleActivations overrides the activation heights of the consensus rules
+const flagUnsafeRuleActivations = "unsafe-rule-activations"
+
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	startCmd.Flags().StringSlice(flagUnsafeRuleActivations, []string{}, "Activation heights of the consensus rules as <chain-id>:<rule>=<height>, for forks, local testnets and replays")
 }
 
 func queryCommand() *cobra.Command {
@@ -201,6 +220,15 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		skipUpgradeHeights[int64(h)] = true
 	}
 
+	for _, activation := range cast.ToStringSlice(appOpts.Get(flagUnsafeRuleActivations)) {
+		chainID, rule, height, err := rules.ParseActivation(activation)
+		if err != nil {
+			panic(err)
+		}
+
+		rules.SetActivationHeight(chainID, rule, height)
+	}
+
 	pruningOpts, err := server.GetPruningOptionsFromFlags(appOpts)
 	if err != nil {
 		panic(err)
@@ -2,11 +2,13 @@
 // autogenerated code using github.com/rigelrozanski/multitool
 // aliases generated for the following subdirectories:
 // ALIASGEN: github.com/terra-money/core/types/assets/
+// ALIASGEN: github.com/terra-money/core/types/rules/
 // ALIASGEN: github.com/terra-money/core/types/util/
 package types
 
 import (
 	"github.com/terra-money/core/types/assets"
+	"github.com/terra-money/core/types/rules"
 	"github.com/terra-money/core/types/util"
 )
 
@@ -35,8 +37,8 @@ const (
 	Bech32PrefixValPub   = util.Bech32PrefixValPub
 	Bech32PrefixConsAddr = util.Bech32PrefixConsAddr
 	Bech32PrefixConsPub  = util.Bech32PrefixConsPub
-	ColumbusChainID      = "columbus-5"
-	BombayChainID        = "bombay-12"
+	ColumbusChainID      = rules.ColumbusChainID
+	BombayChainID        = rules.BombayChainID
 )
 
 // functions aliases
@@ -0,0 +1,137 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Chain IDs of the networks with rules activated after genesis
+const (
+	ColumbusChainID = "columbus-5"
+	BombayChainID   = "bombay-12"
+)
+
+// Rule is a consensus behavior enabled from an activation height
+type Rule string
+
+// nolint
+const (
+	// OracleRewardAllDenoms distributes the reward pools of luna and every vote target
+	// to the ballot winners, instead of the luna reward pool only
+	OracleRewardAllDenoms Rule = "oracle_reward_all_denoms"
+
+	// OracleSortedBallot tallies the ballots with the configured aggregator asserting
+	// they are sorted and sorts the cross rate ballots, instead of the plain weighted median
+	OracleSortedBallot Rule = "oracle_sorted_ballot"
+
+	// WasmGasStoreFix charges the gas of wasm store iterators
+	// with the fix of https://github.com/cosmos/cosmos-sdk/issues/10243
+	WasmGasStoreFix Rule = "wasm_gas_store_fix"
+)
+
+// Activations are the activation heights of the rules of a chain
+type Activations map[Rule]int64
+
+var (
+	mtx sync.RWMutex
+
+	// registry holds the activations of the chains; a rule without
+	// an activation height is active from genesis
+	registry = map[string]Activations{
+		ColumbusChainID: {
+			OracleRewardAllDenoms: 5_100_000,
+			OracleSortedBallot:    5_701_000,
+			WasmGasStoreFix:       6_470_000,
+		},
+		BombayChainID: {
+			OracleRewardAllDenoms: 6_200_000,
+			OracleSortedBallot:    7_000_000,
+			WasmGasStoreFix:       7_800_000,
+		},
+	}
+)
+
+// IsActive returns true if the rule is active at the height of the chain of the context
+func IsActive(ctx sdk.Context, rule Rule) bool {
+	return IsActiveAt(ctx.ChainID(), ctx.BlockHeight(), rule)
+}
+
+// IsActiveAt returns true if the rule is active at the height of the chain
+func IsActiveAt(chainID string, height int64, rule Rule) bool {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	activationHeight, ok := registry[chainID][rule]
+	return !ok || height >= activationHeight
+}
+
+// GetActivations returns a copy of the activation heights of the chain
+func GetActivations(chainID string) Activations {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	activations := make(Activations, len(registry[chainID]))
+	for rule, height := range registry[chainID] {
+		activations[rule] = height
+	}
+
+	return activations
+}
+
+// SetActivationHeight sets the activation height of the rule on the chain,
+// for forks, local testnets and replays of existing chains.
+// NOTE: must be the same on every node of the chain
+func SetActivationHeight(chainID string, rule Rule, height int64) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	if registry[chainID] == nil {
+		registry[chainID] = make(Activations)
+	}
+
+	registry[chainID][rule] = height
+}
+
+// ParseActivation parses an activation height given as <chain-id>:<rule>=<height>
+func ParseActivation(activation string) (chainID string, rule Rule, height int64, err error) {
+	chainRule, heightStr := splitLast(activation, "=")
+	chainID, ruleStr := splitLast(chainRule, ":")
+	if chainID == "" || ruleStr == "" || heightStr == "" {
+		return "", "", 0, fmt.Errorf("invalid rule activation %s: expected <chain-id>:<rule>=<height>", activation)
+	}
+
+	rule = Rule(ruleStr)
+	if !rule.IsKnown() {
+		return "", "", 0, fmt.Errorf("invalid rule activation %s: unknown rule %s", activation, ruleStr)
+	}
+
+	height, err = strconv.ParseInt(heightStr, 10, 64)
+	if err != nil || height < 0 {
+		return "", "", 0, fmt.Errorf("invalid rule activation %s: invalid height %s", activation, heightStr)
+	}
+
+	return chainID, rule, height, nil
+}
+
+// IsKnown returns true if the rule is defined
+func (r Rule) IsKnown() bool {
+	switch r {
+	case OracleRewardAllDenoms, OracleSortedBallot, WasmGasStoreFix:
+		return true
+	default:
+		return false
+	}
+}
+
+func splitLast(s string, sep string) (string, string) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, ""
+	}
+
+	return s[:i], s[i+len(sep):]
+}
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsActiveAt(t *testing.T) {
+	require.False(t, IsActiveAt(ColumbusChainID, 5_700_999, OracleSortedBallot))
+	require.True(t, IsActiveAt(ColumbusChainID, 5_701_000, OracleSortedBallot))
+	require.False(t, IsActiveAt(BombayChainID, 6_199_999, OracleRewardAllDenoms))
+	require.True(t, IsActiveAt(BombayChainID, 7_800_000, WasmGasStoreFix))
+
+	// rules are active from genesis on other chains
+	require.True(t, IsActiveAt("localterra", 0, OracleSortedBallot))
+}
+
+func TestSetActivationHeight(t *testing.T) {
+	SetActivationHeight("testnet-1", OracleRewardAllDenoms, 100)
+	require.False(t, IsActiveAt("testnet-1", 99, OracleRewardAllDenoms))
+	require.True(t, IsActiveAt("testnet-1", 100, OracleRewardAllDenoms))
+	require.True(t, IsActiveAt("testnet-1", 0, OracleSortedBallot))
+	require.Equal(t, Activations{OracleRewardAllDenoms: 100}, GetActivations("testnet-1"))
+
+	// the copy does not change the registry
+	GetActivations(ColumbusChainID)[OracleSortedBallot] = 0
+	require.False(t, IsActiveAt(ColumbusChainID, 5_700_999, OracleSortedBallot))
+}
+
+func TestParseActivation(t *testing.T) {
+	chainID, rule, height, err := ParseActivation("testnet-1:oracle_sorted_ballot=123")
+	require.NoError(t, err)
+	require.Equal(t, "testnet-1", chainID)
+	require.Equal(t, OracleSortedBallot, rule)
+	require.Equal(t, int64(123), height)
+
+	for _, activation := range []string{
+		"",
+		"oracle_sorted_ballot=123",
+		"testnet-1:oracle_sorted_ballot",
+		"testnet-1:unknown=123",
+		"testnet-1:oracle_sorted_ballot=-1",
+		"testnet-1:oracle_sorted_ballot=abc",
+	} {
+		_, _, _, err := ParseActivation(activation)
+		require.Error(t, err, activation)
+	}
+}
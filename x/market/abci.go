@@ -3,7 +3,9 @@ package market
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/market/keeper"
+	"github.com/terra-money/core/x/market/types"
 )
 
 // EndBlocker is called at the end of every block
@@ -12,4 +14,14 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Replenishes each pools towards equilibrium
 	k.ReplenishPools(ctx)
 
+	// Rolls the swap volumes of the swap fee pairs over to the next block
+	k.UpdateSwapVolumes(ctx)
+
+	// Keeps the swap indicators of the epochs within the retention, the next one included
+	if core.IsPeriodLastBlock(ctx, core.BlocksPerWeek) {
+		if next := k.GetEpoch(ctx) + 1; next >= types.EpochSwapIndicatorsRetention {
+			k.PruneEpochSwapIndicators(ctx, next+1-types.EpochSwapIndicatorsRetention)
+		}
+	}
+
 }
@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/market/keeper"
+	"github.com/terra-money/core/x/market/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,3 +30,28 @@ func TestReplenishPools(t *testing.T) {
 		require.Equal(t, terraDelta.Sub(terraRegressionAmt), terraPoolDelta)
 	}
 }
+
+func TestPruneEpochSwapIndicatorsRetention(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	for epoch := uint64(0); epoch < types.EpochSwapIndicatorsRetention+1; epoch++ {
+		input.MarketKeeper.SetEpochSwapIndicators(input.Ctx, types.EpochSwapIndicators{Epoch: epoch})
+	}
+
+	countEpochs := func() (count uint64) {
+		input.MarketKeeper.IterateEpochSwapIndicators(input.Ctx, func(types.EpochSwapIndicators) bool {
+			count++
+			return false
+		})
+		return count
+	}
+
+	// the last block of the epoch before the last one keeps them all
+	lastEpoch := int64(types.EpochSwapIndicatorsRetention)
+	EndBlocker(input.Ctx.WithBlockHeight((lastEpoch-1)*int64(core.BlocksPerWeek)-1), input.MarketKeeper)
+	require.Equal(t, types.EpochSwapIndicatorsRetention+1, countEpochs())
+
+	// the one of the last epoch drops the first, keeping the retention with the next epoch
+	EndBlocker(input.Ctx.WithBlockHeight(lastEpoch*int64(core.BlocksPerWeek)-1), input.MarketKeeper)
+	require.Equal(t, types.EpochSwapIndicatorsRetention, countEpochs())
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -25,7 +27,10 @@ func GetQueryCmd() *cobra.Command {
 
 	marketQueryCmd.AddCommand(
 		GetCmdQuerySwap(),
+		GetCmdQuerySwapQuote(),
 		GetCmdQueryTerraPoolDelta(),
+		GetCmdQueryDenomPools(),
+		GetCmdQuerySwapIndicators(),
 		GetCmdQueryParams(),
 	)
 
@@ -74,6 +79,52 @@ $ terrad query swap 5000000uluna usdr
 	return cmd
 }
 
+// GetCmdQuerySwapQuote implements the query swap quote command.
+func GetCmdQuerySwapQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-quote [offer-coin] [ask-denom] [[offer-coin] [ask-denom]...]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Query the breakdown of a sequence of swap operations",
+		Long: strings.TrimSpace(`
+Query the exchange rates, spreads, fee and terra pool delta of swap operations simulated in sequence,
+each one applied to the pool of the next one. Note; rates are dynamic and can quickly change.
+
+$ terrad query market swap-quote 5000000uluna usdr 1000000usdr ukrw
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args)%2 != 0 {
+				return fmt.Errorf("expected pairs of offer coin and ask denom, got %d arguments", len(args))
+			}
+
+			req := &types.QuerySwapQuoteRequest{}
+			for i := 0; i < len(args); i += 2 {
+				if _, err = sdk.ParseCoinNormalized(args[i]); err != nil {
+					return err
+				}
+
+				req.OfferCoins = append(req.OfferCoins, args[i])
+				req.AskDenoms = append(req.AskDenoms, args[i+1])
+			}
+
+			res, err := queryClient.SwapQuote(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryTerraPoolDelta implements the query mint pool delta command.
 func GetCmdQueryTerraPoolDelta() *cobra.Command {
 	cmd := &cobra.Command{
@@ -107,6 +158,95 @@ $ terrad query market terra-pool-delta
 	return cmd
 }
 
+// GetCmdQueryDenomPools implements the query denom pools command.
+func GetCmdQueryDenomPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-pools [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the depth of the denom pools",
+		Long: strings.TrimSpace(`
+Query the depth of the pools of the Terra denoms swapped with Luna through their own pool,
+in usdr unit. The other denoms share the pool of the terra pool delta.
+
+$ terrad query market denom-pools
+
+Or, can filter with denom
+
+$ terrad query market denom-pools ukrw
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.DenomPools(context.Background(), &types.QueryDenomPoolsRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.DenomPool(context.Background(), &types.QueryDenomPoolRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySwapIndicators implements the query swap indicators command.
+func GetCmdQuerySwapIndicators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-indicators [epochs]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the swap activity of the market in the recent epochs",
+		Long: strings.TrimSpace(`
+Query the swap activity of the market in each epoch: the usdr swap volume of each pair of denoms,
+the coins minted and burned by the swaps and the spread fees paid to the oracle pool.
+
+$ terrad query market swap-indicators
+
+Or, can query the epochs back from the current one
+
+$ terrad query market swap-indicators 4
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var epochs uint64
+			if len(args) == 1 {
+				epochs, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.SwapIndicators(context.Background(), &types.QuerySwapIndicatorsRequest{Epochs: epochs})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
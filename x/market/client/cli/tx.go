@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -14,6 +15,11 @@ import (
 	"github.com/terra-money/core/x/market/types"
 )
 
+const (
+	flagMinAskAmount = "min-ask-amount"
+	flagMaxSpread    = "max-spread"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	marketTxCmd := &cobra.Command{
@@ -26,6 +32,7 @@ func GetTxCmd() *cobra.Command {
 
 	marketTxCmd.AddCommand(
 		GetSwapCmd(),
+		GetSwapRouteCmd(),
 	)
 
 	return marketTxCmd
@@ -45,6 +52,11 @@ $ terrad market swap "1000ukrw" "uusd"
 The to-address can be specified. A default to-address is trader.
 
 $ terrad market swap "1000ukrw" "uusd" "terra1..."
+
+The swap fails when it receives less than --min-ask-amount or is charged
+a spread over --max-spread.
+
+$ terrad market swap "1000ukrw" "uusd" --min-ask-amount 10 --max-spread 0.01
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -64,6 +76,11 @@ $ terrad market swap "1000ukrw" "uusd" "terra1..."
 			askDenom := args[1]
 			fromAddress := clientCtx.GetFromAddress()
 
+			minAskAmount, maxSpread, err := parseSwapLimitFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			var msg sdk.Msg
 			if len(args) == 3 {
 				toAddress, err := sdk.AccAddressFromBech32(args[2])
@@ -71,7 +88,11 @@ $ terrad market swap "1000ukrw" "uusd" "terra1..."
 					return err
 				}
 
-				msg = types.NewMsgSwapSend(fromAddress, toAddress, offerCoin, askDenom)
+				swapSendMsg := types.NewMsgSwapSend(fromAddress, toAddress, offerCoin, askDenom)
+				swapSendMsg.MinAskAmount = minAskAmount
+				swapSendMsg.MaxSpread = maxSpread
+
+				msg = swapSendMsg
 				if err = msg.ValidateBasic(); err != nil {
 					return err
 				}
@@ -92,7 +113,11 @@ $ terrad market swap "1000ukrw" "uusd" "terra1..."
 						WithGasPrices("")
 				}
 			} else {
-				msg = types.NewMsgSwap(fromAddress, offerCoin, askDenom)
+				swapMsg := types.NewMsgSwap(fromAddress, offerCoin, askDenom)
+				swapMsg.MinAskAmount = minAskAmount
+				swapMsg.MaxSpread = maxSpread
+
+				msg = swapMsg
 				if err = msg.ValidateBasic(); err != nil {
 					return err
 				}
@@ -103,7 +128,87 @@ $ terrad market swap "1000ukrw" "uusd" "terra1..."
 		},
 	}
 
+	addSwapLimitFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// GetSwapRouteCmd will create and send a MsgSwapRoute
+func GetSwapRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-route [offer-coin] [ask-denoms]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Atomically swap currencies through a route of denoms",
+		Long: strings.TrimSpace(`
+Swap the offer-coin to each of the comma separated ask-denoms in order at the oracle's
+effective exchange rates. The whole route fails when it receives less than --min-ask-amount
+of the last ask denom or any hop is charged a spread over --max-spread.
+
+$ terrad market swap-route "1000ukrw" "uluna,uusd" --min-ask-amount 10 --max-spread 0.02
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			askDenoms := strings.Split(args[1], ",")
+			for i, askDenom := range askDenoms {
+				askDenoms[i] = strings.TrimSpace(askDenom)
+			}
+
+			minAskAmount, maxSpread, err := parseSwapLimitFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapRoute(clientCtx.GetFromAddress(), offerCoin, askDenoms)
+			msg.MinAskAmount = minAskAmount
+			msg.MaxSpread = maxSpread
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSwapLimitFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addSwapLimitFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagMinAskAmount, "", "Minimum amount of the ask denom to receive")
+	cmd.Flags().String(flagMaxSpread, "", "Maximum spread to be charged by a swap, e.g. 0.01")
+}
+
+// parseSwapLimitFlags returns the swap limits of the flags, nil when not set
+func parseSwapLimitFlags(cmd *cobra.Command) (minAskAmount *sdk.Int, maxSpread *sdk.Dec, err error) {
+	if str, _ := cmd.Flags().GetString(flagMinAskAmount); str != "" {
+		amount, ok := sdk.NewIntFromString(str)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid min ask amount %s", str)
+		}
+
+		minAskAmount = &amount
+	}
+
+	if str, _ := cmd.Flags().GetString(flagMaxSpread); str != "" {
+		spread, err := sdk.NewDecFromStr(str)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		maxSpread = &spread
+	}
+
+	return minAskAmount, maxSpread, nil
+}
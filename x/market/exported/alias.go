@@ -1,10 +1,12 @@
+// DONTCOVER
+//
 //nolint:deadcode,unused
-//DONTCOVER
 package exported
 
 import "github.com/terra-money/core/x/market/types"
 
 type (
-	MsgSwap     = types.MsgSwap
-	MsgSwapSend = types.MsgSwapSend
+	MsgSwap      = types.MsgSwap
+	MsgSwapSend  = types.MsgSwapSend
+	MsgSwapRoute = types.MsgSwapRoute
 )
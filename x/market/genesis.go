@@ -15,6 +15,18 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 	keeper.SetParams(ctx, data.Params)
 	keeper.SetTerraPoolDelta(ctx, data.TerraPoolDelta)
 
+	for _, poolDelta := range data.DenomPoolDeltas {
+		keeper.SetDenomPoolDelta(ctx, poolDelta.Denom, poolDelta.TerraPoolDelta)
+	}
+
+	for _, volume := range data.SwapVolumes {
+		keeper.SetSwapVolume(ctx, volume)
+	}
+
+	for _, indicators := range data.EpochSwapIndicators {
+		keeper.SetEpochSwapIndicators(ctx, indicators)
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.GetMarketAccount(ctx)
 	if moduleAcc == nil {
@@ -29,5 +41,27 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 	params := keeper.GetParams(ctx)
 	terraPoolDelta := keeper.GetTerraPoolDelta(ctx)
 
-	return types.NewGenesisState(terraPoolDelta, params)
+	denomPoolDeltas := []types.DenomPoolDelta{}
+	keeper.IterateDenomPoolDeltas(ctx, func(denom string, delta sdk.Dec) (stop bool) {
+		denomPoolDeltas = append(denomPoolDeltas, types.DenomPoolDelta{Denom: denom, TerraPoolDelta: delta})
+		return false
+	})
+
+	swapVolumes := []types.SwapVolume{}
+	keeper.IterateSwapVolumes(ctx, func(volume types.SwapVolume) (stop bool) {
+		swapVolumes = append(swapVolumes, volume)
+		return false
+	})
+
+	epochSwapIndicators := []types.EpochSwapIndicators{}
+	keeper.IterateEpochSwapIndicators(ctx, func(indicators types.EpochSwapIndicators) (stop bool) {
+		epochSwapIndicators = append(epochSwapIndicators, indicators)
+		return false
+	})
+
+	genesis := types.NewGenesisState(terraPoolDelta, params)
+	genesis.DenomPoolDeltas = denomPoolDeltas
+	genesis.SwapVolumes = swapVolumes
+	genesis.EpochSwapIndicators = epochSwapIndicators
+	return genesis
 }
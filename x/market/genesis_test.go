@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/market/keeper"
+	"github.com/terra-money/core/x/market/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -12,7 +15,26 @@ import (
 func TestExportInitGenesis(t *testing.T) {
 	input := keeper.CreateTestInput(t)
 	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, sdk.NewDec(1123))
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.DenomPools = types.DenomPoolList{types.NewDenomPool(core.MicroKRWDenom, sdk.NewDec(1000000), 100)}
+	params.SwapFeePairs = types.SwapFeePairList{types.NewSwapFeePair(core.MicroKRWDenom, core.MicroUSDDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000000), 100)}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+	input.MarketKeeper.SetDenomPoolDelta(input.Ctx, core.MicroKRWDenom, sdk.NewDec(-456))
+	input.MarketKeeper.SetSwapVolume(input.Ctx, types.SwapVolume{DenomA: core.MicroKRWDenom, DenomB: core.MicroUSDDenom, Volume: sdk.NewDec(789)})
+	input.MarketKeeper.SetEpochSwapIndicators(input.Ctx, types.EpochSwapIndicators{
+		Epoch:       3,
+		SwapVolumes: []types.SwapVolume{{DenomA: core.MicroLunaDenom, DenomB: core.MicroKRWDenom, Volume: sdk.NewDec(1000)}},
+		Minted:      sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 1000)),
+		Burned:      sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1)),
+		SwapFees:    sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 20)),
+	})
+
 	genesis := ExportGenesis(input.Ctx, input.MarketKeeper)
+	require.Len(t, genesis.DenomPoolDeltas, 1)
+	require.Len(t, genesis.SwapVolumes, 1)
+	require.Len(t, genesis.EpochSwapIndicators, 1)
+	require.NoError(t, types.ValidateGenesis(genesis))
 
 	newInput := keeper.CreateTestInput(t)
 	InitGenesis(newInput.Ctx, newInput.MarketKeeper, genesis)
@@ -22,6 +22,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgSwapSend:
 			res, err := msgServer.SwapSend(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSwapRoute:
+			res, err := msgServer.SwapRoute(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized market message type: %T", msg)
 		}
@@ -11,6 +11,7 @@ import (
 	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/market/keeper"
 	"github.com/terra-money/core/x/market/types"
+	oracletypes "github.com/terra-money/core/x/oracle/types"
 )
 
 func TestMarketFilters(t *testing.T) {
@@ -88,3 +89,164 @@ func TestSwapSendMsg(t *testing.T) {
 	balance := input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[1], core.MicroSDRDenom)
 	require.Equal(t, expectedAmt, balance.Amount)
 }
+
+func TestSwapMsgLimits(t *testing.T) {
+	input, h := setup(t)
+
+	amt := sdk.NewInt(1000)
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, amt)
+	retCoin, spread, err := input.MarketKeeper.ComputeSwap(input.Ctx, offerCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.True(t, spread.IsPositive())
+
+	expectedAmt := retCoin.Amount.Mul(sdk.OneDec().Sub(spread)).TruncateInt()
+	beforeBalance := input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[0], core.MicroLunaDenom)
+
+	// spread over the max spread
+	maxSpread := spread.Sub(sdk.NewDecWithPrec(1, 4))
+	swapMsg := types.NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	swapMsg.MaxSpread = &maxSpread
+	_, err = h(input.Ctx, swapMsg)
+	require.ErrorIs(t, err, types.ErrMaxSpread)
+
+	// swap coin below the min ask amount
+	minAskAmount := expectedAmt.AddRaw(1)
+	swapMsg = types.NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	swapMsg.MinAskAmount = &minAskAmount
+	_, err = h(input.Ctx, swapMsg)
+	require.ErrorIs(t, err, types.ErrMinAskAmount)
+
+	require.Equal(t, beforeBalance, input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[0], core.MicroLunaDenom))
+
+	// both limits met
+	swapSendMsg := types.NewMsgSwapSend(keeper.Addrs[0], keeper.Addrs[1], offerCoin, core.MicroSDRDenom)
+	swapSendMsg.MaxSpread = &spread
+	swapSendMsg.MinAskAmount = &expectedAmt
+	_, err = h(input.Ctx, swapSendMsg)
+	require.NoError(t, err)
+
+	balance := input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[1], core.MicroSDRDenom)
+	require.Equal(t, expectedAmt, balance.Amount)
+}
+
+func TestSwapRouteMsg(t *testing.T) {
+	input, h := setup(t)
+
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(25, 4))
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, sdk.NewDecWithPrec(35, 4))
+
+	amt := sdk.NewInt(1000000)
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, amt)
+
+	// Luna to SDR, then SDR to KRW
+	sdrDecCoin, sdrSpread, err := input.MarketKeeper.ComputeSwap(input.Ctx, offerCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+	sdrAmt := sdrDecCoin.Amount.Mul(sdk.OneDec().Sub(sdrSpread)).TruncateInt()
+
+	krwDecCoin, krwSpread, err := input.MarketKeeper.ComputeSwap(input.Ctx, sdk.NewCoin(core.MicroSDRDenom, sdrAmt), core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(35, 4), krwSpread)
+	krwAmt := krwDecCoin.Amount.Mul(sdk.OneDec().Sub(krwSpread)).TruncateInt()
+
+	beforeTerraPoolDelta := input.MarketKeeper.GetTerraPoolDelta(input.Ctx)
+	beforeBalance := input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[0], core.MicroLunaDenom)
+
+	// the min ask amount fails the whole route
+	minAskAmount := krwAmt.AddRaw(1)
+	swapRouteMsg := types.NewMsgSwapRoute(keeper.Addrs[0], offerCoin, []string{core.MicroSDRDenom, core.MicroKRWDenom})
+	swapRouteMsg.MinAskAmount = &minAskAmount
+	_, err = h(input.Ctx, swapRouteMsg)
+	require.ErrorIs(t, err, types.ErrMinAskAmount)
+
+	// the max spread applies to every hop
+	maxSpread := sdk.NewDecWithPrec(3, 3)
+	swapRouteMsg = types.NewMsgSwapRoute(keeper.Addrs[0], offerCoin, []string{core.MicroSDRDenom, core.MicroKRWDenom})
+	swapRouteMsg.MaxSpread = &maxSpread
+	_, err = h(input.Ctx, swapRouteMsg)
+	require.ErrorIs(t, err, types.ErrMaxSpread)
+
+	swapRouteMsg = types.NewMsgSwapRoute(keeper.Addrs[0], offerCoin, []string{core.MicroSDRDenom, core.MicroKRWDenom})
+	swapRouteMsg.MinAskAmount = &krwAmt
+	res, err := h(input.Ctx, swapRouteMsg)
+	require.NoError(t, err)
+
+	var swapRouteRes types.MsgSwapRouteResponse
+	require.NoError(t, swapRouteRes.Unmarshal(res.Data))
+	require.Equal(t, sdk.NewCoin(core.MicroKRWDenom, krwAmt), swapRouteRes.SwapCoin)
+	require.Len(t, swapRouteRes.SwapFees, 2)
+	require.Equal(t, core.MicroSDRDenom, swapRouteRes.SwapFees[0].Denom)
+	require.Equal(t, core.MicroKRWDenom, swapRouteRes.SwapFees[1].Denom)
+
+	// only the swap coin is received and the fees of both hops go to the oracle account
+	require.Equal(t, beforeBalance.Amount.Sub(amt), input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[0], core.MicroLunaDenom).Amount)
+	require.True(t, input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[0], core.MicroSDRDenom).IsZero())
+	require.Equal(t, krwAmt, input.BankKeeper.GetBalance(input.Ctx, keeper.Addrs[0], core.MicroKRWDenom).Amount)
+
+	oracleAcc := input.AccountKeeper.GetModuleAddress(oracletypes.ModuleName)
+	require.Equal(t, sdk.NewCoins(swapRouteRes.SwapFees...), input.BankKeeper.GetAllBalances(input.Ctx, oracleAcc))
+
+	// the Luna hop is applied to the pool
+	require.False(t, beforeTerraPoolDelta.Equal(input.MarketKeeper.GetTerraPoolDelta(input.Ctx)))
+
+	// the swap activity is recorded in the indicators of the epoch
+	indicators := input.MarketKeeper.GetEpochSwapIndicators(input.Ctx, input.MarketKeeper.GetEpoch(input.Ctx))
+	require.Equal(t, sdk.NewCoins(offerCoin), indicators.Burned)
+	require.Equal(t, sdk.NewCoins(swapRouteRes.SwapFees...).Add(swapRouteRes.SwapCoin), indicators.Minted)
+	require.Equal(t, sdk.NewCoins(swapRouteRes.SwapFees...), indicators.SwapFees)
+	require.Len(t, indicators.SwapVolumes, 2)
+
+	// the denoms of each hop are in alphabetical order
+	require.Equal(t, []string{core.MicroKRWDenom, core.MicroSDRDenom}, []string{indicators.SwapVolumes[0].DenomA, indicators.SwapVolumes[0].DenomB})
+	require.Equal(t, []string{core.MicroLunaDenom, core.MicroSDRDenom}, []string{indicators.SwapVolumes[1].DenomA, indicators.SwapVolumes[1].DenomB})
+}
+
+func TestSwapMsgDynamicFee(t *testing.T) {
+	input, h := setup(t)
+
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(25, 4))
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, sdk.NewDecWithPrec(35, 4))
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SwapFeePairs = types.SwapFeePairList{
+		types.NewSwapFeePair(core.MicroSDRDenom, core.MicroKRWDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000000), 10),
+	}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000000))
+	swapRouteMsg := types.NewMsgSwapRoute(keeper.Addrs[0], offerCoin, []string{core.MicroSDRDenom, core.MicroKRWDenom})
+	res, err := h(input.Ctx, swapRouteMsg)
+	require.NoError(t, err)
+
+	// the swap events carry the breakdown of the spread of each hop
+	var breakdowns [][2]string
+	for _, event := range res.Events {
+		if event.Type != types.EventSwap {
+			continue
+		}
+
+		var breakdown [2]string
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case types.AttributeKeyTobinTax:
+				breakdown[0] = string(attr.Value)
+			case types.AttributeKeyDynamicFee:
+				breakdown[1] = string(attr.Value)
+			}
+		}
+		breakdowns = append(breakdowns, breakdown)
+	}
+	require.Len(t, breakdowns, 2)
+	require.Equal(t, [2]string{sdk.ZeroDec().String(), sdk.ZeroDec().String()}, breakdowns[0])
+	require.Equal(t, sdk.NewDecWithPrec(35, 4).String(), breakdowns[1][0])
+
+	dynamicFee, err := sdk.NewDecFromStr(breakdowns[1][1])
+	require.NoError(t, err)
+	require.True(t, dynamicFee.IsPositive())
+
+	// the volume of the Terra hop is rolled over at the end of the block
+	blockVolume := input.MarketKeeper.GetBlockSwapVolume(input.Ctx, core.MicroKRWDenom, core.MicroSDRDenom)
+	require.True(t, blockVolume.IsPositive())
+
+	EndBlocker(input.Ctx, input.MarketKeeper)
+	require.Equal(t, blockVolume, input.MarketKeeper.GetSwapVolume(input.Ctx, core.MicroSDRDenom, core.MicroKRWDenom))
+}
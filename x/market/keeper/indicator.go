@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/market/types"
+)
+
+// GetEpoch returns the current epoch, the same as the one of the treasury indicators
+func (k Keeper) GetEpoch(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockHeight()) / core.BlocksPerWeek
+}
+
+// GetEpochSwapIndicators returns the swap activity of the market in the epoch
+func (k Keeper) GetEpochSwapIndicators(ctx sdk.Context, epoch uint64) types.EpochSwapIndicators {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEpochSwapIndicatorsKey(epoch))
+
+	indicators := types.EpochSwapIndicators{Epoch: epoch}
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &indicators)
+	}
+
+	indicators.SwapVolumes = k.getEpochSwapVolumes(ctx, epoch)
+	return indicators
+}
+
+// SetEpochSwapIndicators stores the swap activity of the market in the epoch,
+// the denoms of each swap volume in alphabetical order
+func (k Keeper) SetEpochSwapIndicators(ctx sdk.Context, indicators types.EpochSwapIndicators) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, volume := range indicators.SwapVolumes {
+		volume = types.NewSwapVolume(volume.DenomA, volume.DenomB, volume.Volume)
+		bz := k.cdc.MustMarshal(&volume)
+		store.Set(types.GetEpochSwapVolumeKey(indicators.Epoch, volume.DenomA, volume.DenomB), bz)
+	}
+
+	// the swap volumes are stored by pair
+	indicators.SwapVolumes = nil
+	bz := k.cdc.MustMarshal(&indicators)
+	store.Set(types.GetEpochSwapIndicatorsKey(indicators.Epoch), bz)
+}
+
+// IterateEpochSwapIndicators iterates over the swap activity of the market in the epochs with swaps
+func (k Keeper) IterateEpochSwapIndicators(ctx sdk.Context, handler func(indicators types.EpochSwapIndicators) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.EpochSwapIndicatorsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var indicators types.EpochSwapIndicators
+		k.cdc.MustUnmarshal(iter.Value(), &indicators)
+		indicators.SwapVolumes = k.getEpochSwapVolumes(ctx, indicators.Epoch)
+		if handler(indicators) {
+			break
+		}
+	}
+}
+
+// getEpochSwapVolumes returns the swap volumes of the pairs swapped in the epoch
+func (k Keeper) getEpochSwapVolumes(ctx sdk.Context, epoch uint64) []types.SwapVolume {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetEpochSwapVolumesPrefix(epoch))
+	defer iter.Close()
+
+	volumes := []types.SwapVolume{}
+	for ; iter.Valid(); iter.Next() {
+		var volume types.SwapVolume
+		k.cdc.MustUnmarshal(iter.Value(), &volume)
+		volumes = append(volumes, volume)
+	}
+
+	return volumes
+}
+
+// RecordEpochSwapVolume adds the usdr amount of the offer coin to the swap volume
+// of the pair of the offer and ask denoms in the current epoch. The volume is only
+// an indicator: without an exchange rate to usdr it is skipped, not failing the swap.
+func (k Keeper) RecordEpochSwapVolume(ctx sdk.Context, offerCoin sdk.Coin, askDenom string) {
+	offerBaseCoin, err := k.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(offerCoin), core.MicroSDRDenom)
+	if err != nil {
+		k.Logger(ctx).Info("swap volume not recorded", "offer", offerCoin, "ask", askDenom, "err", err)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetEpochSwapVolumeKey(k.GetEpoch(ctx), offerCoin.Denom, askDenom)
+
+	volume := types.NewSwapVolume(offerCoin.Denom, askDenom, sdk.ZeroDec())
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshal(bz, &volume)
+	}
+
+	volume.Volume = volume.Volume.Add(offerBaseCoin.Amount)
+	store.Set(key, k.cdc.MustMarshal(&volume))
+}
+
+// RecordEpochSwapSupply adds the coins burned and minted by a swap and its spread fees
+// paid to the oracle pool to the swap indicators of the current epoch
+func (k Keeper) RecordEpochSwapSupply(ctx sdk.Context, burned sdk.Coins, minted sdk.Coins, swapFees sdk.Coins) {
+	epoch := k.GetEpoch(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	indicators := types.EpochSwapIndicators{Epoch: epoch}
+	if bz := store.Get(types.GetEpochSwapIndicatorsKey(epoch)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &indicators)
+	}
+
+	indicators.Burned = indicators.Burned.Add(burned...)
+	indicators.Minted = indicators.Minted.Add(minted...)
+	indicators.SwapFees = indicators.SwapFees.Add(swapFees...)
+	store.Set(types.GetEpochSwapIndicatorsKey(epoch), k.cdc.MustMarshal(&indicators))
+}
+
+// PruneEpochSwapIndicators deletes the swap indicators and volumes of the epochs before the epoch
+func (k Keeper) PruneEpochSwapIndicators(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, prefix := range [][]byte{types.EpochSwapIndicatorsKey, types.EpochSwapVolumeKey} {
+		end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(epoch)...)
+
+		iter := store.Iterator(prefix, end)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/market/types"
+	oraclekeeper "github.com/terra-money/core/x/oracle/keeper"
+)
+
+func TestRecordEpochSwapVolume(t *testing.T) {
+	input := CreateTestInput(t)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.NewDec(2))
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.NewDec(2000))
+
+	// both directions of the pair are recorded in one volume, its denoms in alphabetical order
+	input.MarketKeeper.RecordEpochSwapVolume(input.Ctx, sdk.NewInt64Coin(core.MicroSDRDenom, 100), core.MicroKRWDenom)
+	input.MarketKeeper.RecordEpochSwapVolume(input.Ctx, sdk.NewInt64Coin(core.MicroKRWDenom, 100000), core.MicroSDRDenom)
+
+	indicators := input.MarketKeeper.GetEpochSwapIndicators(input.Ctx, 0)
+	require.Equal(t, []types.SwapVolume{
+		{DenomA: core.MicroKRWDenom, DenomB: core.MicroSDRDenom, Volume: sdk.NewDec(200)},
+	}, indicators.SwapVolumes)
+
+	// without an exchange rate to usdr the volume is skipped
+	input.OracleKeeper.(oraclekeeper.Keeper).DeleteLunaExchangeRate(input.Ctx, core.MicroSDRDenom)
+	input.MarketKeeper.RecordEpochSwapVolume(input.Ctx, sdk.NewInt64Coin(core.MicroLunaDenom, 100), core.MicroKRWDenom)
+	require.Len(t, input.MarketKeeper.GetEpochSwapIndicators(input.Ctx, 0).SwapVolumes, 1)
+}
+
+func TestSetEpochSwapIndicatorsSortsVolumes(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.MarketKeeper.SetEpochSwapIndicators(input.Ctx, types.EpochSwapIndicators{
+		Epoch:       1,
+		SwapVolumes: []types.SwapVolume{{DenomA: core.MicroSDRDenom, DenomB: core.MicroKRWDenom, Volume: sdk.NewDec(10)}},
+	})
+	input.MarketKeeper.SetSwapVolume(input.Ctx, types.SwapVolume{DenomA: core.MicroSDRDenom, DenomB: core.MicroKRWDenom, Volume: sdk.NewDec(20)})
+
+	require.Equal(t, []types.SwapVolume{
+		{DenomA: core.MicroKRWDenom, DenomB: core.MicroSDRDenom, Volume: sdk.NewDec(10)},
+	}, input.MarketKeeper.GetEpochSwapIndicators(input.Ctx, 1).SwapVolumes)
+
+	var volumes []types.SwapVolume
+	input.MarketKeeper.IterateSwapVolumes(input.Ctx, func(volume types.SwapVolume) bool {
+		volumes = append(volumes, volume)
+		return false
+	})
+	require.Equal(t, []types.SwapVolume{
+		{DenomA: core.MicroKRWDenom, DenomB: core.MicroSDRDenom, Volume: sdk.NewDec(20)},
+	}, volumes)
+}
+
+func TestPruneEpochSwapIndicators(t *testing.T) {
+	input := CreateTestInput(t)
+
+	for epoch := uint64(0); epoch < 4; epoch++ {
+		input.MarketKeeper.SetEpochSwapIndicators(input.Ctx, types.EpochSwapIndicators{
+			Epoch:       epoch,
+			Burned:      sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100)),
+			SwapVolumes: []types.SwapVolume{{DenomA: core.MicroKRWDenom, DenomB: core.MicroSDRDenom, Volume: sdk.NewDec(10)}},
+		})
+	}
+
+	input.MarketKeeper.PruneEpochSwapIndicators(input.Ctx, 2)
+
+	var epochs []uint64
+	input.MarketKeeper.IterateEpochSwapIndicators(input.Ctx, func(indicators types.EpochSwapIndicators) bool {
+		epochs = append(epochs, indicators.Epoch)
+		require.Len(t, indicators.SwapVolumes, 1)
+		return false
+	})
+	require.Equal(t, []uint64{2, 3}, epochs)
+
+	// the volumes of the pruned epochs are gone too
+	require.Empty(t, input.MarketKeeper.GetEpochSwapIndicators(input.Ctx, 1).SwapVolumes)
+}
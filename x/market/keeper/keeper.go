@@ -15,6 +15,7 @@ import (
 // Keeper of the market store
 type Keeper struct {
 	storeKey   sdk.StoreKey
+	tStoreKey  sdk.StoreKey
 	cdc        codec.BinaryCodec
 	paramSpace paramstypes.Subspace
 
@@ -27,6 +28,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey sdk.StoreKey,
+	tStoreKey sdk.StoreKey,
 	paramstore paramstypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -46,6 +48,7 @@ func NewKeeper(
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
+		tStoreKey:     tStoreKey,
 		paramSpace:    paramstore,
 		AccountKeeper: accountKeeper,
 		BankKeeper:    bankKeeper,
@@ -78,7 +81,92 @@ func (k Keeper) SetTerraPoolDelta(ctx sdk.Context, delta sdk.Dec) {
 	store.Set(types.TerraPoolDeltaKey, bz)
 }
 
-// ReplenishPools replenishes each pool(Terra,Luna) to BasePool
+// GetDenomPoolDelta returns the gap between the TerraPool and the BasePool of the pool of the denom
+func (k Keeper) GetDenomPoolDelta(ctx sdk.Context, denom string) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDenomPoolDeltaKey(denom))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+	return dp.Dec
+}
+
+// SetDenomPoolDelta updates the gap between the TerraPool and the BasePool of the pool of the denom
+func (k Keeper) SetDenomPoolDelta(ctx sdk.Context, denom string, delta sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: delta})
+	store.Set(types.GetDenomPoolDeltaKey(denom), bz)
+}
+
+// DeleteDenomPoolDelta deletes the gap of the pool of the denom
+func (k Keeper) DeleteDenomPoolDelta(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomPoolDeltaKey(denom))
+}
+
+// IterateDenomPoolDeltas iterates over the gaps of the denom pools in the store
+func (k Keeper) IterateDenomPoolDeltas(ctx sdk.Context, handler func(denom string, delta sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomPoolDeltaKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.DenomPoolDeltaKey):])
+		dp := sdk.DecProto{}
+		k.cdc.MustUnmarshal(iter.Value(), &dp)
+		if handler(denom, dp.Dec) {
+			break
+		}
+	}
+}
+
+// getTerraPool returns the base pool and the terra pool delta of the pool the swaps
+// between Luna and the Terra denom are applied to, its denom pool or the shared one
+func (k Keeper) getTerraPool(ctx sdk.Context, terraDenom string) (basePool sdk.Dec, terraPoolDelta sdk.Dec) {
+	if pool, ok := k.DenomPools(ctx).Get(terraDenom); ok {
+		return pool.BasePool, k.GetDenomPoolDelta(ctx, terraDenom)
+	}
+
+	return k.BasePool(ctx), k.GetTerraPoolDelta(ctx)
+}
+
+// setTerraPoolDelta updates the terra pool delta of the pool the swaps
+// between Luna and the Terra denom are applied to
+func (k Keeper) setTerraPoolDelta(ctx sdk.Context, terraDenom string, terraPoolDelta sdk.Dec) {
+	if _, ok := k.DenomPools(ctx).Get(terraDenom); ok {
+		k.SetDenomPoolDelta(ctx, terraDenom, terraPoolDelta)
+		return
+	}
+
+	k.SetTerraPoolDelta(ctx, terraPoolDelta)
+}
+
+// GetPoolDepth returns the current depth of the pool of the denom
+func (k Keeper) GetPoolDepth(ctx sdk.Context, denom string) (types.PoolDepth, bool) {
+	pool, ok := k.DenomPools(ctx).Get(denom)
+	if !ok {
+		return types.PoolDepth{}, false
+	}
+
+	return newPoolDepth(pool, k.GetDenomPoolDelta(ctx, denom)), true
+}
+
+// newPoolDepth returns the depth of the denom pool with the terra pool delta
+func newPoolDepth(pool types.DenomPool, terraPoolDelta sdk.Dec) types.PoolDepth {
+	terraPool := pool.BasePool.Add(terraPoolDelta)
+	return types.PoolDepth{
+		Denom:          pool.Denom,
+		BasePool:       pool.BasePool,
+		TerraPoolDelta: terraPoolDelta,
+		TerraPool:      terraPool,
+		LunaPool:       pool.BasePool.Mul(pool.BasePool).Quo(terraPool),
+	}
+}
+
+// ReplenishPools replenishes each pool(Terra,Luna) to BasePool, the shared one and the denom pools
+// with their own recovery period. The deltas of the denoms no longer having a pool are dropped.
 func (k Keeper) ReplenishPools(ctx sdk.Context) {
 	poolDelta := k.GetTerraPoolDelta(ctx)
 
@@ -90,4 +178,24 @@ func (k Keeper) ReplenishPools(ctx sdk.Context) {
 	poolDelta = poolDelta.Sub(poolRegressionAmt)
 
 	k.SetTerraPoolDelta(ctx, poolDelta)
+
+	denomPools := k.DenomPools(ctx)
+
+	var staleDenoms []string
+	k.IterateDenomPoolDeltas(ctx, func(denom string, delta sdk.Dec) (stop bool) {
+		if _, ok := denomPools.Get(denom); !ok {
+			staleDenoms = append(staleDenoms, denom)
+		}
+		return false
+	})
+
+	for _, denom := range staleDenoms {
+		k.DeleteDenomPoolDelta(ctx, denom)
+	}
+
+	for _, pool := range denomPools {
+		delta := k.GetDenomPoolDelta(ctx, pool.Denom)
+		delta = delta.Sub(delta.QuoInt64(int64(pool.PoolRecoveryPeriod)))
+		k.SetDenomPoolDelta(ctx, pool.Denom, delta)
+	}
 }
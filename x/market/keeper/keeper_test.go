@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/market/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -55,3 +56,74 @@ func TestReplenishPools(t *testing.T) {
 	expectedDelta = diff.Sub(replenishAmt)
 	require.Equal(t, expectedDelta, terraPoolDelta)
 }
+
+func TestReplenishDenomPools(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.DenomPools = types.DenomPoolList{types.NewDenomPool(core.MicroKRWDenom, sdk.NewDec(1000000), 10)}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, sdk.NewDec(1000))
+	input.MarketKeeper.SetDenomPoolDelta(input.Ctx, core.MicroKRWDenom, sdk.NewDec(1000))
+	input.MarketKeeper.SetDenomPoolDelta(input.Ctx, core.MicroUSDDenom, sdk.NewDec(1000))
+
+	input.MarketKeeper.ReplenishPools(input.Ctx)
+
+	// the denom pool recovers with its own period, the shared one with the default period
+	require.Equal(t, sdk.NewDec(900), input.MarketKeeper.GetDenomPoolDelta(input.Ctx, core.MicroKRWDenom))
+	expectedDelta := sdk.NewDec(1000).Sub(sdk.NewDec(1000).QuoInt64(int64(input.MarketKeeper.PoolRecoveryPeriod(input.Ctx))))
+	require.Equal(t, expectedDelta, input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+
+	// the delta of the denom without a pool is dropped
+	var denoms []string
+	input.MarketKeeper.IterateDenomPoolDeltas(input.Ctx, func(denom string, _ sdk.Dec) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+	require.Equal(t, []string{core.MicroKRWDenom}, denoms)
+
+	depth, found := input.MarketKeeper.GetPoolDepth(input.Ctx, core.MicroKRWDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(1000900), depth.TerraPool)
+	require.Equal(t, sdk.NewDec(1000000).Mul(sdk.NewDec(1000000)).Quo(sdk.NewDec(1000900)), depth.LunaPool)
+
+	_, found = input.MarketKeeper.GetPoolDepth(input.Ctx, core.MicroUSDDenom)
+	require.False(t, found)
+}
+
+func TestUpdateSwapVolumes(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SwapFeePairs = types.SwapFeePairList{
+		types.NewSwapFeePair(core.MicroUSDDenom, core.MicroKRWDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000000), 10),
+	}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	input.MarketKeeper.SetSwapVolume(input.Ctx, types.SwapVolume{DenomA: core.MicroUSDDenom, DenomB: core.MicroKRWDenom, Volume: sdk.NewDec(1000)})
+	input.MarketKeeper.SetSwapVolume(input.Ctx, types.SwapVolume{DenomA: core.MicroUSDDenom, DenomB: core.MicroSDRDenom, Volume: sdk.NewDec(1000)})
+
+	// swaps of the pair add to the volume of the block, in either direction
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.NewDec(1000))
+	krwCoin := sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(50000))
+	require.NoError(t, input.MarketKeeper.ApplySwapToPool(input.Ctx, krwCoin, sdk.NewDecCoin(core.MicroUSDDenom, sdk.NewInt(40))))
+	require.Equal(t, sdk.NewDec(50), input.MarketKeeper.GetBlockSwapVolume(input.Ctx, core.MicroUSDDenom, core.MicroKRWDenom))
+
+	// the Terra pool delta is untouched
+	require.True(t, input.MarketKeeper.GetTerraPoolDelta(input.Ctx).IsZero())
+
+	input.MarketKeeper.UpdateSwapVolumes(input.Ctx)
+
+	// the rolling volume decays over the window and adds the volume of the block
+	require.Equal(t, sdk.NewDec(950), input.MarketKeeper.GetSwapVolume(input.Ctx, core.MicroKRWDenom, core.MicroUSDDenom))
+
+	// the volume of the pair without a dynamic fee is dropped
+	var volumes []types.SwapVolume
+	input.MarketKeeper.IterateSwapVolumes(input.Ctx, func(volume types.SwapVolume) bool {
+		volumes = append(volumes, volume)
+		return false
+	})
+	require.Equal(t, []types.SwapVolume{{DenomA: core.MicroKRWDenom, DenomB: core.MicroUSDDenom, Volume: sdk.NewDec(950)}}, volumes)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/market/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2 by setting
+// the params added in version 2 to their defaults
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	paramSpace := m.keeper.paramSpace
+
+	for _, param := range []struct {
+		key   []byte
+		value interface{}
+	}{
+		{types.KeyMaxRateAge, types.DefaultMaxRateAge},
+		{types.KeyDenomPools, types.DefaultDenomPools},
+		{types.KeySwapFeePairs, types.DefaultSwapFeePairs},
+	} {
+		if !paramSpace.Has(ctx, param.key) {
+			paramSpace.Set(ctx, param.key, param.value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+
+	"github.com/terra-money/core/x/market/types"
+)
+
+// deleteParams removes the params from the param store, leaving it as an older version wrote it
+func deleteParams(input TestInput, keys ...[]byte) {
+	store := prefix.NewStore(input.Ctx.KVStore(input.ParamsKey), []byte(types.ModuleName+"/"))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestInput(t)
+
+	deleteParams(input, types.KeyMaxRateAge, types.KeyDenomPools, types.KeySwapFeePairs)
+	require.Panics(t, func() { input.MarketKeeper.GetParams(input.Ctx) })
+
+	require.NoError(t, NewMigrator(input.MarketKeeper).Migrate1to2(input.Ctx))
+
+	migrated := input.MarketKeeper.GetParams(input.Ctx)
+	require.NoError(t, migrated.Validate())
+	require.Equal(t, types.DefaultMaxRateAge, migrated.MaxRateAge)
+	require.Empty(t, migrated.DenomPools)
+	require.Empty(t, migrated.SwapFeePairs)
+
+	// the params set since are kept
+	migrated.MaxRateAge = 10
+	input.MarketKeeper.SetParams(input.Ctx, migrated)
+	require.NoError(t, NewMigrator(input.MarketKeeper).Migrate1to2(input.Ctx))
+	require.Equal(t, uint64(10), input.MarketKeeper.MaxRateAge(input.Ctx))
+}
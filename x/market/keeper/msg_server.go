@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/terra-money/core/x/market/types"
 	oracletypes "github.com/terra-money/core/x/oracle/types"
@@ -28,7 +29,15 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 		return nil, err
 	}
 
-	return k.handleSwapRequest(ctx, addr, addr, msg.OfferCoin, msg.AskDenom)
+	res, err := k.handleSwapRequest(ctx, addr, addr, msg.OfferCoin, []string{msg.AskDenom}, msg.MinAskAmount, msg.MaxSpread)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapResponse{
+		SwapCoin: res.SwapCoin,
+		SwapFee:  res.SwapFees[0],
+	}, nil
 }
 
 func (k msgServer) SwapSend(goCtx context.Context, msg *types.MsgSwapSend) (*types.MsgSwapSendResponse, error) {
@@ -43,50 +52,93 @@ func (k msgServer) SwapSend(goCtx context.Context, msg *types.MsgSwapSend) (*typ
 		return nil, err
 	}
 
-	res, err := k.handleSwapRequest(ctx, fromAddr, toAddr, msg.OfferCoin, msg.AskDenom)
+	res, err := k.handleSwapRequest(ctx, fromAddr, toAddr, msg.OfferCoin, []string{msg.AskDenom}, msg.MinAskAmount, msg.MaxSpread)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.MsgSwapSendResponse{
 		SwapCoin: res.SwapCoin,
-		SwapFee:  res.SwapFee,
+		SwapFee:  res.SwapFees[0],
 	}, nil
 }
 
-// handleMsgSwap handles the logic of a MsgSwap
+func (k msgServer) SwapRoute(goCtx context.Context, msg *types.MsgSwapRoute) (*types.MsgSwapRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(msg.Trader)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.handleSwapRequest(ctx, addr, addr, msg.OfferCoin, msg.AskDenoms, msg.MinAskAmount, msg.MaxSpread)
+}
+
+// handleSwapRequest handles the logic of the swap messages, swapping the offer coin to each ask denom
+// in order. The spread of every hop is checked against maxSpread and the final swap coin against
+// minAskAmount, both unlimited when nil; any failure reverts the whole swap.
 // This function does not repeat checks that have already been performed in msg.ValidateBasic()
 // Ex) assert(offerCoin.Denom != askDenom)
 func (k msgServer) handleSwapRequest(ctx sdk.Context,
 	trader sdk.AccAddress, receiver sdk.AccAddress,
-	offerCoin sdk.Coin, askDenom string) (*types.MsgSwapResponse, error) {
+	offerCoin sdk.Coin, askDenoms []string,
+	minAskAmount *sdk.Int, maxSpread *sdk.Dec) (*types.MsgSwapRouteResponse, error) {
+
+	swapCoin := offerCoin
+	feeCoins := make([]sdk.Coin, 0, len(askDenoms))
+	feeCoinsSum := sdk.NewCoins()
+	for _, askDenom := range askDenoms {
+		hopOfferCoin := swapCoin
+		if !hopOfferCoin.IsPositive() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidSwapRoute, "nothing left to swap to %s", askDenom)
+		}
 
-	// Compute exchange rates between the ask and offer
-	swapDecCoin, spread, err := k.ComputeSwap(ctx, offerCoin, askDenom)
-	if err != nil {
-		return nil, err
-	}
+		// Compute exchange rates between the ask and offer
+		swapDecCoin, swapSpread, err := k.computeSwap(ctx, hopOfferCoin, askDenom)
+		if err != nil {
+			return nil, err
+		}
 
-	// Charge a spread if applicable; the spread is burned
-	var feeDecCoin sdk.DecCoin
-	if spread.IsPositive() {
-		feeDecCoin = sdk.NewDecCoinFromDec(swapDecCoin.Denom, spread.Mul(swapDecCoin.Amount))
-	} else {
-		feeDecCoin = sdk.NewDecCoin(swapDecCoin.Denom, sdk.ZeroInt())
-	}
+		spread := swapSpread.spread
+		if maxSpread != nil && spread.GT(*maxSpread) {
+			return nil, sdkerrors.Wrapf(types.ErrMaxSpread, "swap to %s spread %s, max %s", askDenom, spread, maxSpread)
+		}
 
-	// Subtract fee from the swap coin
-	swapDecCoin.Amount = swapDecCoin.Amount.Sub(feeDecCoin.Amount)
+		// Charge a spread if applicable; the spread is burned
+		swapDecCoin, hopSwapCoin, feeCoin := applySpread(swapDecCoin, spread)
 
-	// Update pool delta
-	err = k.ApplySwapToPool(ctx, offerCoin, swapDecCoin)
-	if err != nil {
-		return nil, err
+		// Update pool delta
+		err = k.ApplySwapToPool(ctx, hopOfferCoin, swapDecCoin)
+		if err != nil {
+			return nil, err
+		}
+
+		k.RecordEpochSwapVolume(ctx, hopOfferCoin, askDenom)
+
+		swapCoin = hopSwapCoin
+		feeCoins = append(feeCoins, feeCoin)
+		feeCoinsSum = feeCoinsSum.Add(feeCoin)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventSwap,
+				sdk.NewAttribute(types.AttributeKeyOffer, hopOfferCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyTrader, trader.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, receiver.String()),
+				sdk.NewAttribute(types.AttributeKeySwapCoin, swapCoin.String()),
+				sdk.NewAttribute(types.AttributeKeySwapFee, feeCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyTobinTax, swapSpread.tobinTax.String()),
+				sdk.NewAttribute(types.AttributeKeyDynamicFee, swapSpread.dynamicFee.String()),
+			),
+		)
+	}
+
+	if minAskAmount != nil && swapCoin.Amount.LT(*minAskAmount) {
+		return nil, sdkerrors.Wrapf(types.ErrMinAskAmount, "swap coin %s, min ask amount %s", swapCoin, minAskAmount)
 	}
 
 	// Send offer coins to module account
 	offerCoins := sdk.NewCoins(offerCoin)
-	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, types.ModuleName, offerCoins)
+	err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, types.ModuleName, offerCoins)
 	if err != nil {
 		return nil, err
 	}
@@ -97,12 +149,8 @@ func (k msgServer) handleSwapRequest(ctx sdk.Context,
 		return nil, err
 	}
 
-	// Mint asked coins and credit Trader's account
-	swapCoin, decimalCoin := swapDecCoin.TruncateDecimal()
-	feeDecCoin = feeDecCoin.Add(decimalCoin) // add truncated decimalCoin to swapFee
-	feeCoin, _ := feeDecCoin.TruncateDecimal()
-
-	mintCoins := sdk.NewCoins(swapCoin.Add(feeCoin))
+	// Mint asked coins and the fees of all hops; the intermediate swap coins are never minted
+	mintCoins := feeCoinsSum.Add(swapCoin)
 	err = k.BankKeeper.MintCoins(ctx, types.ModuleName, mintCoins)
 	if err != nil {
 		return nil, err
@@ -116,31 +164,24 @@ func (k msgServer) handleSwapRequest(ctx sdk.Context,
 	}
 
 	// Send swap fee to oracle account
-	if feeCoin.IsPositive() {
-		feeCoins := sdk.NewCoins(feeCoin)
-		err = k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, feeCoins)
+	if !feeCoinsSum.IsZero() {
+		err = k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, feeCoinsSum)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventSwap,
-			sdk.NewAttribute(types.AttributeKeyOffer, offerCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyTrader, trader.String()),
-			sdk.NewAttribute(types.AttributeKeyRecipient, receiver.String()),
-			sdk.NewAttribute(types.AttributeKeySwapCoin, swapCoin.String()),
-			sdk.NewAttribute(types.AttributeKeySwapFee, feeCoin.String()),
-		),
+	k.RecordEpochSwapSupply(ctx, offerCoins, mintCoins, feeCoinsSum)
+
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
-	})
+	)
 
-	return &types.MsgSwapResponse{
+	return &types.MsgSwapRouteResponse{
 		SwapCoin: swapCoin,
-		SwapFee:  feeCoin,
+		SwapFees: feeCoins,
 	}, nil
 }
@@ -25,6 +25,25 @@ func (k Keeper) PoolRecoveryPeriod(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// MaxRateAge is the number of blocks since their last update after which the exchange rates
+// of the oracle are too stale to be used, unlimited when zero
+func (k Keeper) MaxRateAge(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxRateAge, &res)
+	return
+}
+
+// DenomPools are the Terra denoms swapped with Luna through their own pool instead of the shared one
+func (k Keeper) DenomPools(ctx sdk.Context) (res types.DenomPoolList) {
+	k.paramSpace.Get(ctx, types.KeyDenomPools, &res)
+	return
+}
+
+// SwapFeePairs are the pairs of Terra denoms charging a dynamic fee growing with their swap volume
+func (k Keeper) SwapFeePairs(ctx sdk.Context) (res types.SwapFeePairList) {
+	k.paramSpace.Get(ctx, types.KeySwapFeePairs, &res)
+	return
+}
+
 // GetParams returns the total set of market parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
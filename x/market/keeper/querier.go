@@ -54,6 +54,97 @@ func (q querier) Swap(c context.Context, req *types.QuerySwapRequest) (*types.Qu
 	return &types.QuerySwapResponse{ReturnCoin: retCoin}, nil
 }
 
+// SwapQuote queries for the breakdown of the simulation of swaps in sequence
+func (q querier) SwapQuote(c context.Context, req *types.QuerySwapQuoteRequest) (*types.QuerySwapQuoteResponse, error) {
+	if req == nil || len(req.OfferCoins) == 0 || len(req.OfferCoins) != len(req.AskDenoms) {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.OfferCoins) > types.MaxSwapQuotes {
+		return nil, status.Errorf(codes.InvalidArgument, "more than %d swaps", types.MaxSwapQuotes)
+	}
+
+	offerCoins := make([]sdk.Coin, len(req.OfferCoins))
+	for i, offerCoinStr := range req.OfferCoins {
+		if err := sdk.ValidateDenom(req.AskDenoms[i]); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid ask denom")
+		}
+
+		offerCoin, err := sdk.ParseCoinNormalized(offerCoinStr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		offerCoins[i] = offerCoin
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	quotes, err := q.SimulateSwaps(ctx, offerCoins, req.AskDenoms)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySwapQuoteResponse{Quotes: quotes}, nil
+}
+
+// DenomPools queries the depth of the denom pools
+func (q querier) DenomPools(c context.Context, req *types.QueryDenomPoolsRequest) (*types.QueryDenomPoolsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	denomPools := q.Keeper.DenomPools(ctx)
+	pools := make([]types.PoolDepth, len(denomPools))
+	for i, pool := range denomPools {
+		pools[i] = newPoolDepth(pool, q.GetDenomPoolDelta(ctx, pool.Denom))
+	}
+
+	return &types.QueryDenomPoolsResponse{Pools: pools}, nil
+}
+
+// DenomPool queries the depth of the pool of the denom
+func (q querier) DenomPool(c context.Context, req *types.QueryDenomPoolRequest) (*types.QueryDenomPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, ok := q.GetPoolDepth(ctx, req.Denom)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no pool of %s", req.Denom)
+	}
+
+	return &types.QueryDenomPoolResponse{Pool: pool}, nil
+}
+
+// SwapIndicators queries the swap activity of the market in the recent epochs
+func (q querier) SwapIndicators(c context.Context, req *types.QuerySwapIndicatorsRequest) (*types.QuerySwapIndicatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Epochs > types.MaxSwapIndicatorEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "more than %d epochs", types.MaxSwapIndicatorEpochs)
+	}
+
+	epochs := req.Epochs
+	if epochs == 0 {
+		epochs = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	curEpoch := q.GetEpoch(ctx)
+
+	var indicators []types.EpochSwapIndicators
+	for i := uint64(0); i < epochs && i <= curEpoch; i++ {
+		indicators = append(indicators, q.GetEpochSwapIndicators(ctx, curEpoch-i))
+	}
+
+	return &types.QuerySwapIndicatorsResponse{Indicators: indicators}, nil
+}
+
 // TerraPoolDelta queries terra pool delta
 func (q querier) TerraPoolDelta(c context.Context, req *types.QueryTerraPoolDeltaRequest) (*types.QueryTerraPoolDeltaResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -63,6 +63,81 @@ func TestQuerySwap(t *testing.T) {
 	require.True(t, res.ReturnCoin.Amount.IsPositive())
 }
 
+func TestQuerySwapQuote(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.MarketKeeper)
+
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.NewDec(2000))
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(25, 4))
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, sdk.NewDecWithPrec(35, 4))
+
+	lunaCoin := sdk.NewInt64Coin(core.MicroLunaDenom, 1000000)
+	sdrCoin := sdk.NewInt64Coin(core.MicroSDRDenom, 1000000)
+
+	// invalid requests cause error
+	_, err := querier.SwapQuote(ctx, &types.QuerySwapQuoteRequest{})
+	require.Error(t, err)
+
+	_, err = querier.SwapQuote(ctx, &types.QuerySwapQuoteRequest{OfferCoins: []string{lunaCoin.String()}})
+	require.Error(t, err)
+
+	_, err = querier.SwapQuote(ctx, &types.QuerySwapQuoteRequest{OfferCoins: []string{lunaCoin.String()}, AskDenoms: []string{core.MicroLunaDenom}})
+	require.Error(t, err)
+
+	swapRes, err := querier.Swap(ctx, &types.QuerySwapRequest{OfferCoin: lunaCoin.String(), AskDenom: core.MicroSDRDenom})
+	require.NoError(t, err)
+
+	res, err := querier.SwapQuote(ctx, &types.QuerySwapQuoteRequest{
+		OfferCoins: []string{lunaCoin.String(), sdrCoin.String(), lunaCoin.String()},
+		AskDenoms:  []string{core.MicroSDRDenom, core.MicroKRWDenom, core.MicroSDRDenom},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Quotes, 3)
+
+	// Luna swap charges the constant product spread, at least the min stability spread
+	lunaQuote := res.Quotes[0]
+	require.Equal(t, sdk.OneDec(), lunaQuote.OfferRate)
+	require.Equal(t, sdk.NewDecWithPrec(17, 1), lunaQuote.AskRate)
+	require.Equal(t, sdk.NewDec(1700000), lunaQuote.BaseOfferAmount)
+	require.True(t, lunaQuote.BaseAskAmount.LT(lunaQuote.BaseOfferAmount))
+	require.Equal(t, input.MarketKeeper.MinStabilitySpread(input.Ctx), lunaQuote.MinStabilitySpread)
+	require.Equal(t, sdk.MaxDec(lunaQuote.ConstantProductSpread, lunaQuote.MinStabilitySpread), lunaQuote.Spread)
+	require.True(t, lunaQuote.TobinTax.IsZero())
+	require.Equal(t, swapRes.ReturnCoin, lunaQuote.ReturnCoin)
+	require.Equal(t, sdk.NewInt(1700000), lunaQuote.ReturnCoin.Amount.Add(lunaQuote.FeeCoin.Amount))
+	require.True(t, lunaQuote.TerraPoolDeltaBefore.IsZero())
+	require.True(t, lunaQuote.TerraPoolDeltaAfter.IsNegative())
+
+	// Terra swap charges the tobin tax and leaves the pool
+	terraQuote := res.Quotes[1]
+	require.Equal(t, sdk.NewDecWithPrec(35, 4), terraQuote.TobinTax)
+	require.Equal(t, terraQuote.TobinTax, terraQuote.Spread)
+	require.True(t, terraQuote.ConstantProductSpread.IsZero())
+	require.Equal(t, terraQuote.BaseOfferAmount, terraQuote.BaseAskAmount)
+	require.Equal(t, sdk.NewInt64Coin(core.MicroKRWDenom, 1176470588-4117647), terraQuote.ReturnCoin)
+	require.Equal(t, lunaQuote.TerraPoolDeltaAfter, terraQuote.TerraPoolDeltaBefore)
+	require.Equal(t, terraQuote.TerraPoolDeltaBefore, terraQuote.TerraPoolDeltaAfter)
+
+	// the next swap sees the pool of the previous ones
+	nextQuote := res.Quotes[2]
+	require.Equal(t, terraQuote.TerraPoolDeltaAfter, nextQuote.TerraPoolDeltaBefore)
+	require.True(t, nextQuote.ReturnCoin.Amount.LTE(lunaQuote.ReturnCoin.Amount))
+
+	// nothing is written to the store
+	require.True(t, input.MarketKeeper.GetTerraPoolDelta(input.Ctx).IsZero())
+
+	// the quote matches the executed swap
+	msgServer := NewMsgServerImpl(input.MarketKeeper)
+	swapMsg := types.NewMsgSwap(Addrs[0], lunaCoin, core.MicroSDRDenom)
+	swapMsgRes, err := msgServer.Swap(ctx, swapMsg)
+	require.NoError(t, err)
+	require.Equal(t, lunaQuote.ReturnCoin, swapMsgRes.SwapCoin)
+	require.Equal(t, lunaQuote.FeeCoin, swapMsgRes.SwapFee)
+	require.Equal(t, lunaQuote.TerraPoolDeltaAfter, input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+}
+
 func TestQueryMintPoolDelta(t *testing.T) {
 
 	input := CreateTestInput(t)
@@ -77,3 +152,94 @@ func TestQueryMintPoolDelta(t *testing.T) {
 
 	require.Equal(t, poolDelta, res.TerraPoolDelta)
 }
+
+func TestQueryDenomPools(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.MarketKeeper)
+
+	res, err := querier.DenomPools(ctx, &types.QueryDenomPoolsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Pools)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.DenomPools = types.DenomPoolList{
+		types.NewDenomPool(core.MicroKRWDenom, sdk.NewDec(1000), 100),
+		types.NewDenomPool(core.MicroUSDDenom, sdk.NewDec(2000), 100),
+	}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+	input.MarketKeeper.SetDenomPoolDelta(input.Ctx, core.MicroKRWDenom, sdk.NewDec(-500))
+
+	res, err = querier.DenomPools(ctx, &types.QueryDenomPoolsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PoolDepth{
+		{
+			Denom:          core.MicroKRWDenom,
+			BasePool:       sdk.NewDec(1000),
+			TerraPoolDelta: sdk.NewDec(-500),
+			TerraPool:      sdk.NewDec(500),
+			LunaPool:       sdk.NewDec(2000),
+		},
+		{
+			Denom:          core.MicroUSDDenom,
+			BasePool:       sdk.NewDec(2000),
+			TerraPoolDelta: sdk.ZeroDec(),
+			TerraPool:      sdk.NewDec(2000),
+			LunaPool:       sdk.NewDec(2000),
+		},
+	}, res.Pools)
+
+	_, err = querier.DenomPool(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.DenomPool(ctx, &types.QueryDenomPoolRequest{})
+	require.Error(t, err)
+
+	_, err = querier.DenomPool(ctx, &types.QueryDenomPoolRequest{Denom: core.MicroSDRDenom})
+	require.Error(t, err)
+
+	poolRes, err := querier.DenomPool(ctx, &types.QueryDenomPoolRequest{Denom: core.MicroKRWDenom})
+	require.NoError(t, err)
+	require.Equal(t, res.Pools[0], poolRes.Pool)
+}
+
+func TestQuerySwapIndicators(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.MarketKeeper)
+
+	_, err := querier.SwapIndicators(sdk.WrapSDKContext(input.Ctx), nil)
+	require.Error(t, err)
+
+	_, err = querier.SwapIndicators(sdk.WrapSDKContext(input.Ctx), &types.QuerySwapIndicatorsRequest{Epochs: types.MaxSwapIndicatorEpochs + 1})
+	require.Error(t, err)
+
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+
+	// swaps in the first two epochs
+	input.MarketKeeper.RecordEpochSwapSupply(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100)), sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 170)), sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 3)))
+	input.MarketKeeper.RecordEpochSwapVolume(input.Ctx, sdk.NewInt64Coin(core.MicroLunaDenom, 100), core.MicroSDRDenom)
+
+	ctx := input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek))
+	input.MarketKeeper.RecordEpochSwapSupply(ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 10)), sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 10000)), sdk.Coins{})
+
+	res, err := querier.SwapIndicators(sdk.WrapSDKContext(ctx), &types.QuerySwapIndicatorsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Indicators, 1)
+	require.Equal(t, uint64(1), res.Indicators[0].Epoch)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 10)), res.Indicators[0].Burned)
+	require.Empty(t, res.Indicators[0].SwapVolumes)
+
+	// no more epochs than the current one back to the first
+	res, err = querier.SwapIndicators(sdk.WrapSDKContext(ctx), &types.QuerySwapIndicatorsRequest{Epochs: 4})
+	require.NoError(t, err)
+	require.Len(t, res.Indicators, 2)
+	require.Equal(t, uint64(0), res.Indicators[1].Epoch)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100)), res.Indicators[1].Burned)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 170)), res.Indicators[1].Minted)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 3)), res.Indicators[1].SwapFees)
+
+	require.Equal(t, []types.SwapVolume{
+		{DenomA: core.MicroLunaDenom, DenomB: core.MicroSDRDenom, Volume: lunaPriceInSDR.MulInt64(100)},
+	}, res.Indicators[1].SwapVolumes)
+}
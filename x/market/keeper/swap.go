@@ -12,13 +12,26 @@ import (
 // ApplySwapToPool updates each pool with offerCoin and askCoin taken from swap operation,
 // OfferPool = OfferPool + offerAmt (Fills the swap pool with offerAmt)
 // AskPool = AskPool - askAmt       (Uses askAmt from the swap pool)
+// Terra to Terra swaps only add to the swap volume of their pair in the block if it charges a dynamic fee.
 func (k Keeper) ApplySwapToPool(ctx sdk.Context, offerCoin sdk.Coin, askCoin sdk.DecCoin) error {
 	// No delta update in case Terra to Terra swap
 	if offerCoin.Denom != core.MicroLunaDenom && askCoin.Denom != core.MicroLunaDenom {
+		if _, ok := k.SwapFeePairs(ctx).Get(offerCoin.Denom, askCoin.Denom); !ok {
+			return nil
+		}
+
+		offerBaseCoin, err := k.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(offerCoin), core.MicroSDRDenom)
+		if err != nil {
+			return err
+		}
+
+		k.addBlockSwapVolume(ctx, offerCoin.Denom, askCoin.Denom, offerBaseCoin.Amount)
 		return nil
 	}
 
-	terraPoolDelta := k.GetTerraPoolDelta(ctx)
+	terraDenom := swapTerraDenom(offerCoin.Denom, askCoin.Denom)
+
+	_, terraPoolDelta := k.getTerraPool(ctx, terraDenom)
 
 	// In case swapping Terra to Luna, the terra swap pool(offer) must be increased and the luna swap pool(ask) must be decreased
 	if offerCoin.Denom != core.MicroLunaDenom && askCoin.Denom == core.MicroLunaDenom {
@@ -40,71 +53,123 @@ func (k Keeper) ApplySwapToPool(ctx sdk.Context, offerCoin sdk.Coin, askCoin sdk
 		terraPoolDelta = terraPoolDelta.Sub(askBaseCoin.Amount)
 	}
 
-	k.SetTerraPoolDelta(ctx, terraPoolDelta)
+	k.setTerraPoolDelta(ctx, terraDenom, terraPoolDelta)
 
 	return nil
 }
 
+// swapTerraDenom returns the Terra denom of a swap between Luna and Terra, the offer denom otherwise
+func swapTerraDenom(offerDenom string, askDenom string) string {
+	if offerDenom == core.MicroLunaDenom {
+		return askDenom
+	}
+
+	return offerDenom
+}
+
+// swapSpread is the spread charged by a swap with its breakdown for Terra to Terra swaps
+type swapSpread struct {
+	spread     sdk.Dec
+	tobinTax   sdk.Dec
+	dynamicFee sdk.Dec
+}
+
 // ComputeSwap returns the amount of asked coins should be returned for a given offerCoin at the effective
 // exchange rate registered with the oracle.
 // Returns an Error if the swap is recursive, or the coins to be traded are unknown by the oracle, or the amount
 // to trade is too small.
 func (k Keeper) ComputeSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string) (retDecCoin sdk.DecCoin, spread sdk.Dec, err error) {
+	retDecCoin, swapSpread, err := k.computeSwap(ctx, offerCoin, askDenom)
+	return retDecCoin, swapSpread.spread, err
+}
+
+// computeSwap computes the swap like ComputeSwap and returns the breakdown of its spread
+func (k Keeper) computeSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string) (retDecCoin sdk.DecCoin, swapSpread swapSpread, err error) {
+	swapSpread.tobinTax = sdk.ZeroDec()
+	swapSpread.dynamicFee = sdk.ZeroDec()
 
 	// Return invalid recursive swap err
 	if offerCoin.Denom == askDenom {
-		return sdk.DecCoin{}, sdk.ZeroDec(), sdkerrors.Wrap(types.ErrRecursiveSwap, askDenom)
+		swapSpread.spread = sdk.ZeroDec()
+		return sdk.DecCoin{}, swapSpread, sdkerrors.Wrap(types.ErrRecursiveSwap, askDenom)
 	}
 
 	// Swap offer coin to base denom for simplicity of swap process
 	baseOfferDecCoin, err := k.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(offerCoin), core.MicroSDRDenom)
 	if err != nil {
-		return sdk.DecCoin{}, sdk.Dec{}, err
+		return sdk.DecCoin{}, swapSpread, err
 	}
 
 	// Get swap amount based on the oracle price
 	retDecCoin, err = k.ComputeInternalSwap(ctx, baseOfferDecCoin, askDenom)
 	if err != nil {
-		return sdk.DecCoin{}, sdk.Dec{}, err
+		return sdk.DecCoin{}, swapSpread, err
 	}
 
 	// Terra => Terra swap
-	// Apply only tobin tax without constant product spread
+	// Apply only tobin tax and the dynamic fee of the pair without constant product spread
 	if offerCoin.Denom != core.MicroLunaDenom && askDenom != core.MicroLunaDenom {
-		var tobinTax sdk.Dec
-		offerTobinTax, err2 := k.OracleKeeper.GetTobinTax(ctx, offerCoin.Denom)
-		if err2 != nil {
-			return sdk.DecCoin{}, sdk.Dec{}, err2
-		}
-
-		askTobinTax, err2 := k.OracleKeeper.GetTobinTax(ctx, askDenom)
-		if err2 != nil {
-			return sdk.DecCoin{}, sdk.Dec{}, err2
+		swapSpread.tobinTax, err = k.computeTobinTax(ctx, offerCoin.Denom, askDenom)
+		if err != nil {
+			return sdk.DecCoin{}, swapSpread, err
 		}
 
-		// Apply highest tobin tax for the denoms in the swap operation
-		if askTobinTax.GT(offerTobinTax) {
-			tobinTax = askTobinTax
-		} else {
-			tobinTax = offerTobinTax
-		}
+		swapSpread.dynamicFee = k.computeDynamicFee(ctx, offerCoin.Denom, askDenom, baseOfferDecCoin.Amount)
 
-		spread = tobinTax
+		swapSpread.spread = sdk.MinDec(swapSpread.tobinTax.Add(swapSpread.dynamicFee), sdk.OneDec())
 		return
 	}
 
-	basePool := k.BasePool(ctx)
+	askBaseAmount := k.computeBaseAskAmount(ctx, offerCoin.Denom, askDenom, baseOfferDecCoin.Amount)
+
+	// Both baseOffer and baseAsk are usdr units, so spread can be calculated by
+	// spread = (baseOfferAmt - baseAskAmt) / baseOfferAmt
+	baseOfferAmount := baseOfferDecCoin.Amount
+	swapSpread.spread = baseOfferAmount.Sub(askBaseAmount).Quo(baseOfferAmount)
+
 	minSpread := k.MinStabilitySpread(ctx)
+	if swapSpread.spread.LT(minSpread) {
+		swapSpread.spread = minSpread
+	}
+
+	return
+}
+
+// computeTobinTax returns the highest tobin tax of the denoms of a Terra to Terra swap
+func (k Keeper) computeTobinTax(ctx sdk.Context, offerDenom string, askDenom string) (sdk.Dec, error) {
+	offerTobinTax, err := k.OracleKeeper.GetTobinTax(ctx, offerDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	askTobinTax, err := k.OracleKeeper.GetTobinTax(ctx, askDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// Apply highest tobin tax for the denoms in the swap operation
+	if askTobinTax.GT(offerTobinTax) {
+		return askTobinTax, nil
+	}
+
+	return offerTobinTax, nil
+}
+
+// computeBaseAskAmount returns the usdr amount the constant product of the pool of the Terra denom
+// returns for the usdr amount offered in the offer denom of a swap between Luna and Terra
+func (k Keeper) computeBaseAskAmount(ctx sdk.Context, offerDenom string, askDenom string, baseOfferAmount sdk.Dec) sdk.Dec {
+	terraDenom := swapTerraDenom(offerDenom, askDenom)
+
+	basePool, terraPoolDelta := k.getTerraPool(ctx, terraDenom)
 
 	// constant-product, which by construction is square of base(equilibrium) pool
 	cp := basePool.Mul(basePool)
-	terraPoolDelta := k.GetTerraPoolDelta(ctx)
 	terraPool := basePool.Add(terraPoolDelta)
 	lunaPool := cp.Quo(terraPool)
 
 	var offerPool sdk.Dec // base denom(usdr) unit
 	var askPool sdk.Dec   // base denom(usdr) unit
-	if offerCoin.Denom != core.MicroLunaDenom {
+	if offerDenom != core.MicroLunaDenom {
 		// Terra->Luna swap
 		offerPool = terraPool
 		askPool = lunaPool
@@ -117,18 +182,7 @@ func (k Keeper) ComputeSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string
 	// Get cp(constant-product) based swap amount
 	// askBaseAmount = askPool - cp / (offerPool + offerBaseAmount)
 	// askBaseAmount is base denom(usdr) unit
-	askBaseAmount := askPool.Sub(cp.Quo(offerPool.Add(baseOfferDecCoin.Amount)))
-
-	// Both baseOffer and baseAsk are usdr units, so spread can be calculated by
-	// spread = (baseOfferAmt - baseAskAmt) / baseOfferAmt
-	baseOfferAmount := baseOfferDecCoin.Amount
-	spread = baseOfferAmount.Sub(askBaseAmount).Quo(baseOfferAmount)
-
-	if spread.LT(minSpread) {
-		spread = minSpread
-	}
-
-	return
+	return askPool.Sub(cp.Quo(offerPool.Add(baseOfferAmount)))
 }
 
 // ComputeInternalSwap returns the amount of asked DecCoin should be returned for a given offerCoin at the effective
@@ -139,14 +193,14 @@ func (k Keeper) ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askD
 		return offerCoin, nil
 	}
 
-	offerRate, err := k.OracleKeeper.GetLunaExchangeRate(ctx, offerCoin.Denom)
+	offerRate, err := k.getLunaExchangeRate(ctx, offerCoin.Denom)
 	if err != nil {
-		return sdk.DecCoin{}, sdkerrors.Wrap(types.ErrNoEffectivePrice, offerCoin.Denom)
+		return sdk.DecCoin{}, err
 	}
 
-	askRate, err := k.OracleKeeper.GetLunaExchangeRate(ctx, askDenom)
+	askRate, err := k.getLunaExchangeRate(ctx, askDenom)
 	if err != nil {
-		return sdk.DecCoin{}, sdkerrors.Wrap(types.ErrNoEffectivePrice, askDenom)
+		return sdk.DecCoin{}, err
 	}
 
 	retAmount := offerCoin.Amount.Mul(askRate).Quo(offerRate)
@@ -157,6 +211,131 @@ func (k Keeper) ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askD
 	return sdk.NewDecCoinFromDec(askDenom, retAmount), nil
 }
 
+// getLunaExchangeRate returns the exchange rate of the denom registered with the oracle,
+// updated within the max rate age unless unlimited
+func (k Keeper) getLunaExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	maxRateAge := k.MaxRateAge(ctx)
+	if maxRateAge == 0 {
+		exchangeRate, err := k.OracleKeeper.GetLunaExchangeRate(ctx, denom)
+		if err != nil {
+			return sdk.Dec{}, sdkerrors.Wrap(types.ErrNoEffectivePrice, denom)
+		}
+
+		return exchangeRate, nil
+	}
+
+	exchangeRate, err := k.OracleKeeper.GetFreshLunaExchangeRate(ctx, denom, maxRateAge)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrNoEffectivePrice, err.Error())
+	}
+
+	return exchangeRate, nil
+}
+
+// applySpread subtracts the fee charged by the spread from the swap coin and returns it with the swap
+// coin and the fee truncated, the decimals truncated from the swap coin being added to the fee
+func applySpread(swapDecCoin sdk.DecCoin, spread sdk.Dec) (sdk.DecCoin, sdk.Coin, sdk.Coin) {
+	var feeDecCoin sdk.DecCoin
+	if spread.IsPositive() {
+		feeDecCoin = sdk.NewDecCoinFromDec(swapDecCoin.Denom, spread.Mul(swapDecCoin.Amount))
+	} else {
+		feeDecCoin = sdk.NewDecCoin(swapDecCoin.Denom, sdk.ZeroInt())
+	}
+
+	// Subtract fee from the swap coin
+	swapDecCoin.Amount = swapDecCoin.Amount.Sub(feeDecCoin.Amount)
+
+	swapCoin, decimalCoin := swapDecCoin.TruncateDecimal()
+	feeDecCoin = feeDecCoin.Add(decimalCoin) // add truncated decimalCoin to swapFee
+	feeCoin, _ := feeDecCoin.TruncateDecimal()
+
+	return swapDecCoin, swapCoin, feeCoin
+}
+
+// SimulateSwaps returns the quotes of the swaps simulated in order, each one applied to the pool
+// of the next one as if they were executed in the same block. Nothing is written to the store.
+func (k Keeper) SimulateSwaps(ctx sdk.Context, offerCoins []sdk.Coin, askDenoms []string) ([]types.SwapQuote, error) {
+	cacheCtx, _ := ctx.CacheContext()
+
+	quotes := make([]types.SwapQuote, len(offerCoins))
+	for i, offerCoin := range offerCoins {
+		quote, err := k.computeSwapQuote(cacheCtx, offerCoin, askDenoms[i])
+		if err != nil {
+			return nil, err
+		}
+
+		quotes[i] = quote
+	}
+
+	return quotes, nil
+}
+
+// computeSwapQuote computes the swap like the swap messages and applies it to the pool
+func (k Keeper) computeSwapQuote(ctx sdk.Context, offerCoin sdk.Coin, askDenom string) (types.SwapQuote, error) {
+	if askDenom == offerCoin.Denom {
+		return types.SwapQuote{}, sdkerrors.Wrap(types.ErrRecursiveSwap, askDenom)
+	}
+
+	if offerCoin.Amount.BigInt().BitLen() > 100 {
+		return types.SwapQuote{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, offerCoin.String())
+	}
+
+	terraDenom := swapTerraDenom(offerCoin.Denom, askDenom)
+
+	_, terraPoolDelta := k.getTerraPool(ctx, terraDenom)
+
+	swapDecCoin, swapSpread, err := k.computeSwap(ctx, offerCoin, askDenom)
+	if err != nil {
+		return types.SwapQuote{}, err
+	}
+
+	offerRate, err := k.getLunaExchangeRate(ctx, offerCoin.Denom)
+	if err != nil {
+		return types.SwapQuote{}, err
+	}
+
+	askRate, err := k.getLunaExchangeRate(ctx, askDenom)
+	if err != nil {
+		return types.SwapQuote{}, err
+	}
+
+	baseOfferDecCoin, err := k.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(offerCoin), core.MicroSDRDenom)
+	if err != nil {
+		return types.SwapQuote{}, err
+	}
+
+	quote := types.SwapQuote{
+		OfferCoin:             offerCoin,
+		AskDenom:              askDenom,
+		OfferRate:             offerRate,
+		AskRate:               askRate,
+		BaseOfferAmount:       baseOfferDecCoin.Amount,
+		BaseAskAmount:         baseOfferDecCoin.Amount,
+		ConstantProductSpread: sdk.ZeroDec(),
+		MinStabilitySpread:    sdk.ZeroDec(),
+		TobinTax:              swapSpread.tobinTax,
+		DynamicFee:            swapSpread.dynamicFee,
+		Spread:                swapSpread.spread,
+		TerraPoolDeltaBefore:  terraPoolDelta,
+	}
+
+	if offerCoin.Denom == core.MicroLunaDenom || askDenom == core.MicroLunaDenom {
+		quote.BaseAskAmount = k.computeBaseAskAmount(ctx, offerCoin.Denom, askDenom, quote.BaseOfferAmount)
+		quote.ConstantProductSpread = quote.BaseOfferAmount.Sub(quote.BaseAskAmount).Quo(quote.BaseOfferAmount)
+		quote.MinStabilitySpread = k.MinStabilitySpread(ctx)
+	}
+
+	swapDecCoin, quote.ReturnCoin, quote.FeeCoin = applySpread(swapDecCoin, swapSpread.spread)
+
+	err = k.ApplySwapToPool(ctx, offerCoin, swapDecCoin)
+	if err != nil {
+		return types.SwapQuote{}, err
+	}
+
+	_, quote.TerraPoolDeltaAfter = k.getTerraPool(ctx, terraDenom)
+	return quote, nil
+}
+
 // simulateSwap interface for simulate swap
 func (k Keeper) simulateSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string) (sdk.Coin, error) {
 	if askDenom == offerCoin.Denom {
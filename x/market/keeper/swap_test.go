@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/market/types"
+	oraclekeeper "github.com/terra-money/core/x/oracle/keeper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -65,6 +67,93 @@ func TestComputeSwap(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestComputeSwapDenomPools(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.MinStabilitySpread = sdk.ZeroDec()
+	params.DenomPools = types.DenomPoolList{types.NewDenomPool(core.MicroKRWDenom, sdk.NewDec(100000000), 100)}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.NewDec(2000))
+
+	lunaCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000000))
+	_, sdrSpread, err := input.MarketKeeper.ComputeSwap(input.Ctx, lunaCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+	_, krwSpread, err := input.MarketKeeper.ComputeSwap(input.Ctx, lunaCoin, core.MicroKRWDenom)
+	require.NoError(t, err)
+
+	// the smaller pool of ukrw charges a larger spread
+	require.True(t, krwSpread.GT(sdrSpread))
+
+	// a burst of ukrw swaps is applied to the pool of ukrw only
+	krwCoin := sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(100000000000))
+	require.NoError(t, input.MarketKeeper.ApplySwapToPool(input.Ctx, krwCoin, sdk.NewDecCoin(core.MicroLunaDenom, sdk.NewInt(50000000))))
+	require.True(t, input.MarketKeeper.GetTerraPoolDelta(input.Ctx).IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(17, 1).MulInt64(50000000), input.MarketKeeper.GetDenomPoolDelta(input.Ctx, core.MicroKRWDenom))
+
+	_, newSDRSpread, err := input.MarketKeeper.ComputeSwap(input.Ctx, lunaCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdrSpread, newSDRSpread)
+
+	// Luna to ukrw is cheaper, ukrw to Luna more expensive
+	_, newKRWSpread, err := input.MarketKeeper.ComputeSwap(input.Ctx, lunaCoin, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.True(t, newKRWSpread.LT(krwSpread))
+
+	_, reverseKRWSpread, err := input.MarketKeeper.ComputeSwap(input.Ctx, sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(2000000000)), core.MicroLunaDenom)
+	require.NoError(t, err)
+	require.True(t, reverseKRWSpread.GT(krwSpread))
+}
+
+func TestComputeSwapDynamicFee(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.NewDec(1000))
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(25, 4))
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, sdk.NewDecWithPrec(35, 4))
+
+	offerCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(100))
+
+	// the tobin tax only without a swap fee pair
+	_, spread, err := input.MarketKeeper.ComputeSwap(input.Ctx, offerCoin, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(35, 4), spread)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SwapFeePairs = types.SwapFeePairList{
+		types.NewSwapFeePair(core.MicroKRWDenom, core.MicroSDRDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000), 10),
+	}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	// the dynamic fee is charged at the volume including the swap
+	_, spread, err = input.MarketKeeper.ComputeSwap(input.Ctx, offerCoin, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(35, 4).Add(sdk.NewDecWithPrec(2, 3)), spread)
+
+	// and grows with the volume of the block and the rolling volume
+	retCoin, spread, err := input.MarketKeeper.ComputeSwap(input.Ctx, offerCoin, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.NoError(t, input.MarketKeeper.ApplySwapToPool(input.Ctx, offerCoin, retCoin))
+	input.MarketKeeper.SetSwapVolume(input.Ctx, types.SwapVolume{DenomA: core.MicroKRWDenom, DenomB: core.MicroSDRDenom, Volume: sdk.NewDec(300)})
+
+	_, spread, err = input.MarketKeeper.ComputeSwap(input.Ctx, sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(100000)), core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(35, 4).Add(sdk.NewDecWithPrec(1, 2)), spread)
+
+	// up to the max fee at the volume limit
+	_, spread, err = input.MarketKeeper.ComputeSwap(input.Ctx, sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(10000)), core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(35, 4).Add(sdk.NewDecWithPrec(2, 2)), spread)
+
+	// Luna swaps are not charged
+	_, spread, err = input.MarketKeeper.ComputeSwap(input.Ctx, sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(100)), core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, input.MarketKeeper.MinStabilitySpread(input.Ctx), spread)
+}
+
 func TestComputeInternalSwap(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -84,6 +173,41 @@ func TestComputeInternalSwap(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestComputeInternalSwapMaxRateAge(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Set Oracle Price through a tally at height 10
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	input.OracleKeeper.(oraclekeeper.Keeper).ApplyExchangeRate(input.Ctx, sdk.ZeroDec(), core.MicroSDRDenom, lunaPriceInSDR)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.MaxRateAge = 5
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	offerCoin := sdk.NewDecCoin(core.MicroSDRDenom, sdk.NewInt(1700))
+	input.Ctx = input.Ctx.WithBlockHeight(15)
+	retCoin, err := input.MarketKeeper.ComputeInternalSwap(input.Ctx, offerCoin, core.MicroLunaDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1000), retCoin.Amount)
+
+	// too stale to swap
+	input.Ctx = input.Ctx.WithBlockHeight(16)
+	_, err = input.MarketKeeper.ComputeInternalSwap(input.Ctx, offerCoin, core.MicroLunaDenom)
+	require.ErrorIs(t, err, types.ErrNoEffectivePrice)
+
+	// a rate without update height is stale
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.NewDec(2000))
+	_, err = input.MarketKeeper.ComputeInternalSwap(input.Ctx, sdk.NewDecCoin(core.MicroKRWDenom, sdk.NewInt(2000)), core.MicroLunaDenom)
+	require.Error(t, err)
+
+	// unlimited
+	params.MaxRateAge = 0
+	input.MarketKeeper.SetParams(input.Ctx, params)
+	_, err = input.MarketKeeper.ComputeInternalSwap(input.Ctx, offerCoin, core.MicroLunaDenom)
+	require.NoError(t, err)
+}
+
 func TestIlliquidTobinTaxListParams(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/market/types"
+)
+
+// GetSwapVolume returns the rolling swap volume of the pair of denoms, in either order
+func (k Keeper) GetSwapVolume(ctx sdk.Context, denomA string, denomB string) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSwapVolumeKey(denomA, denomB))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var volume types.SwapVolume
+	k.cdc.MustUnmarshal(bz, &volume)
+	return volume.Volume
+}
+
+// SetSwapVolume sets the rolling swap volume of the pair of denoms, stored in alphabetical order
+func (k Keeper) SetSwapVolume(ctx sdk.Context, volume types.SwapVolume) {
+	volume = types.NewSwapVolume(volume.DenomA, volume.DenomB, volume.Volume)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&volume)
+	store.Set(types.GetSwapVolumeKey(volume.DenomA, volume.DenomB), bz)
+}
+
+// DeleteSwapVolume deletes the rolling swap volume of the pair of denoms
+func (k Keeper) DeleteSwapVolume(ctx sdk.Context, denomA string, denomB string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSwapVolumeKey(denomA, denomB))
+}
+
+// IterateSwapVolumes iterates over the rolling swap volumes in the store
+func (k Keeper) IterateSwapVolumes(ctx sdk.Context, handler func(volume types.SwapVolume) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SwapVolumeKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var volume types.SwapVolume
+		k.cdc.MustUnmarshal(iter.Value(), &volume)
+		if handler(volume) {
+			break
+		}
+	}
+}
+
+// GetBlockSwapVolume returns the swap volume of the pair of denoms in the current block
+func (k Keeper) GetBlockSwapVolume(ctx sdk.Context, denomA string, denomB string) sdk.Dec {
+	store := ctx.TransientStore(k.tStoreKey)
+	bz := store.Get(types.GetSwapVolumeKey(denomA, denomB))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+	return dp.Dec
+}
+
+// addBlockSwapVolume adds the usdr amount swapped to the swap volume of the pair of denoms in the current block
+func (k Keeper) addBlockSwapVolume(ctx sdk.Context, denomA string, denomB string, baseAmount sdk.Dec) {
+	volume := k.GetBlockSwapVolume(ctx, denomA, denomB).Add(baseAmount)
+
+	store := ctx.TransientStore(k.tStoreKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: volume})
+	store.Set(types.GetSwapVolumeKey(denomA, denomB), bz)
+}
+
+// computeDynamicFee returns the dynamic fee of a Terra to Terra swap of the usdr amount, charged at
+// the swap volume of the pair including the swap; zero unless the pair charges a dynamic fee
+func (k Keeper) computeDynamicFee(ctx sdk.Context, offerDenom string, askDenom string, baseOfferAmount sdk.Dec) sdk.Dec {
+	pair, ok := k.SwapFeePairs(ctx).Get(offerDenom, askDenom)
+	if !ok {
+		return sdk.ZeroDec()
+	}
+
+	volume := k.GetSwapVolume(ctx, offerDenom, askDenom).
+		Add(k.GetBlockSwapVolume(ctx, offerDenom, askDenom)).
+		Add(baseOfferAmount)
+
+	return pair.DynamicFee(volume)
+}
+
+// UpdateSwapVolumes decays the rolling swap volume of each swap fee pair over its volume window and adds
+// the swap volume of the current block. The volumes of the pairs no longer charging a dynamic fee are dropped.
+func (k Keeper) UpdateSwapVolumes(ctx sdk.Context) {
+	swapFeePairs := k.SwapFeePairs(ctx)
+
+	var staleVolumes []types.SwapVolume
+	k.IterateSwapVolumes(ctx, func(volume types.SwapVolume) (stop bool) {
+		if _, ok := swapFeePairs.Get(volume.DenomA, volume.DenomB); !ok {
+			staleVolumes = append(staleVolumes, volume)
+		}
+		return false
+	})
+
+	for _, volume := range staleVolumes {
+		k.DeleteSwapVolume(ctx, volume.DenomA, volume.DenomB)
+	}
+
+	for _, pair := range swapFeePairs {
+		volume := k.GetSwapVolume(ctx, pair.DenomA, pair.DenomB)
+		volume = volume.Sub(volume.QuoInt64(int64(pair.VolumeWindow))).
+			Add(k.GetBlockSwapVolume(ctx, pair.DenomA, pair.DenomB))
+
+		if volume.IsZero() {
+			k.DeleteSwapVolume(ctx, pair.DenomA, pair.DenomB)
+			continue
+		}
+
+		k.SetSwapVolume(ctx, types.SwapVolume{DenomA: pair.DenomA, DenomB: pair.DenomB, Volume: volume})
+	}
+}
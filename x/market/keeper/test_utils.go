@@ -121,6 +121,7 @@ type TestInput struct {
 	BankKeeper    bankkeeper.Keeper
 	OracleKeeper  types.OracleKeeper
 	MarketKeeper  Keeper
+	ParamsKey     sdk.StoreKey
 }
 
 // CreateTestInput nolint
@@ -133,6 +134,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(types.StoreKey)
+	tKeyMarket := sdk.NewTransientStoreKey(types.TStoreKey)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -148,6 +150,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyMarket, sdk.StoreTypeTransient, db)
 
 	require.NoError(t, ms.LoadLatestVersion())
 
@@ -246,14 +249,14 @@ func CreateTestInput(t *testing.T) TestInput {
 
 	keeper := NewKeeper(
 		appCodec,
-		keyMarket, paramsKeeper.Subspace(types.ModuleName),
+		keyMarket, tKeyMarket, paramsKeeper.Subspace(types.ModuleName),
 		accountKeeper,
 		bankKeeper,
 		oracleKeeper,
 	)
 	keeper.SetParams(ctx, types.DefaultParams())
 
-	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, oracleKeeper, keeper}
+	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, oracleKeeper, keeper, keyParams}
 }
 
 // FundAccount is a utility function that funds an account by minting and
@@ -21,6 +21,13 @@ func Migrate(
 			BasePool:           marketGenState.Params.BasePool,
 			PoolRecoveryPeriod: uint64(marketGenState.Params.PoolRecoveryPeriod),
 			MinStabilitySpread: marketGenState.Params.MinStabilitySpread,
+			MaxRateAge:         v05market.DefaultMaxRateAge,
+			DenomPools:         v05market.DefaultDenomPools,
+			SwapFeePairs:       v05market.DefaultSwapFeePairs,
 		},
+		DenomPoolDeltas: []v05market.DenomPoolDelta{},
+		SwapVolumes:     []v05market.SwapVolume{},
+
+		EpochSwapIndicators: []v05market.EpochSwapIndicators{},
 	}
 }
@@ -50,11 +50,17 @@ func TestMigrate(t *testing.T) {
 	// Make sure about:
 	// - BasePool to Mint & Burn pool
 	expected := `{
+	"denom_pool_deltas": [],
+	"epoch_swap_indicators": [],
 	"params": {
 		"base_pool": "1000000.000000000000000000",
+		"denom_pools": [],
+		"max_rate_age": "0",
 		"min_stability_spread": "0.020000000000000000",
-		"pool_recovery_period": "10000"
+		"pool_recovery_period": "10000",
+		"swap_fee_pairs": []
 	},
+	"swap_volumes": [],
 	"terra_pool_delta": "0.000000000000000000"
 }`
 
@@ -21,6 +21,21 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &deltaA)
 			cdc.MustUnmarshal(kvB.Value, &deltaB)
 			return fmt.Sprintf("%v\n%v", deltaA, deltaB)
+		case bytes.Equal(kvA.Key[:1], types.DenomPoolDeltaKey):
+			var deltaA, deltaB sdk.DecProto
+			cdc.MustUnmarshal(kvA.Value, &deltaA)
+			cdc.MustUnmarshal(kvB.Value, &deltaB)
+			return fmt.Sprintf("%v\n%v", deltaA, deltaB)
+		case bytes.Equal(kvA.Key[:1], types.SwapVolumeKey), bytes.Equal(kvA.Key[:1], types.EpochSwapVolumeKey):
+			var volumeA, volumeB types.SwapVolume
+			cdc.MustUnmarshal(kvA.Value, &volumeA)
+			cdc.MustUnmarshal(kvB.Value, &volumeB)
+			return fmt.Sprintf("%v\n%v", volumeA, volumeB)
+		case bytes.Equal(kvA.Key[:1], types.EpochSwapIndicatorsKey):
+			var indicatorsA, indicatorsB types.EpochSwapIndicators
+			cdc.MustUnmarshal(kvA.Value, &indicatorsA)
+			cdc.MustUnmarshal(kvB.Value, &indicatorsB)
+			return fmt.Sprintf("%v\n%v", indicatorsA, indicatorsB)
 		default:
 			panic(fmt.Sprintf("invalid market key prefix %X", kvA.Key[:1]))
 		}
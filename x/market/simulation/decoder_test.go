@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
+	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/market/keeper"
 	"github.com/terra-money/core/x/market/types"
 )
@@ -18,10 +19,16 @@ func TestDecodeDistributionStore(t *testing.T) {
 	dec := NewDecodeStore(cdc)
 
 	terraDelta := sdk.NewDecWithPrec(12, 2)
+	swapVolume := types.SwapVolume{DenomA: core.MicroKRWDenom, DenomB: core.MicroUSDDenom, Volume: sdk.NewDec(1000)}
+	indicators := types.EpochSwapIndicators{Epoch: 2, Burned: sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100))}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.TerraPoolDeltaKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: terraDelta})},
+			{Key: types.GetDenomPoolDeltaKey(core.MicroKRWDenom), Value: cdc.MustMarshal(&sdk.DecProto{Dec: terraDelta})},
+			{Key: types.GetSwapVolumeKey(core.MicroKRWDenom, core.MicroUSDDenom), Value: cdc.MustMarshal(&swapVolume)},
+			{Key: types.GetEpochSwapIndicatorsKey(2), Value: cdc.MustMarshal(&indicators)},
+			{Key: types.GetEpochSwapVolumeKey(2, core.MicroKRWDenom, core.MicroUSDDenom), Value: cdc.MustMarshal(&swapVolume)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -31,6 +38,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"TerraPoolDelta", fmt.Sprintf("%v\n%v", terraDelta, terraDelta)},
+		{"DenomPoolDelta", fmt.Sprintf("%v\n%v", terraDelta, terraDelta)},
+		{"SwapVolume", fmt.Sprintf("%v\n%v", swapVolume, swapVolume)},
+		{"EpochSwapIndicators", fmt.Sprintf("%v\n%v", indicators, indicators)},
+		{"EpochSwapVolume", fmt.Sprintf("%v\n%v", swapVolume, swapVolume)},
 		{"other", ""},
 	}
 
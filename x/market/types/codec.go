@@ -13,6 +13,7 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwap{}, "market/MsgSwap", nil)
 	cdc.RegisterConcrete(&MsgSwapSend{}, "market/MsgSwapSend", nil)
+	cdc.RegisterConcrete(&MsgSwapRoute{}, "market/MsgSwapRoute", nil)
 }
 
 // RegisterInterfaces registers the x/market interfaces types with the interface registry
@@ -20,6 +21,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSwap{},
 		&MsgSwapSend{},
+		&MsgSwapRoute{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
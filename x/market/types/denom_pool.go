@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+)
+
+// NewDenomPool returns the pool of the denom
+func NewDenomPool(denom string, basePool sdk.Dec, poolRecoveryPeriod uint64) DenomPool {
+	return DenomPool{
+		Denom:              denom,
+		BasePool:           basePool,
+		PoolRecoveryPeriod: poolRecoveryPeriod,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (p DenomPool) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate performs basic validation on the denom pool
+func (p DenomPool) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid denom pool denom: %w", err)
+	}
+
+	if p.Denom == core.MicroLunaDenom {
+		return fmt.Errorf("denom pool denom must not be %s", core.MicroLunaDenom)
+	}
+
+	if p.BasePool.IsNil() || !p.BasePool.IsPositive() {
+		return fmt.Errorf("base pool of denom pool %s should be positive, is %s", p.Denom, p.BasePool)
+	}
+
+	if p.PoolRecoveryPeriod == 0 {
+		return fmt.Errorf("pool recovery period of denom pool %s should be positive, is %d", p.Denom, p.PoolRecoveryPeriod)
+	}
+
+	return nil
+}
+
+// DenomPoolList is array of DenomPool
+type DenomPoolList []DenomPool
+
+// String implements fmt.Stringer interface
+func (pl DenomPoolList) String() (out string) {
+	for _, p := range pl {
+		out += p.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// Get returns the pool of the denom
+func (pl DenomPoolList) Get(denom string) (DenomPool, bool) {
+	for _, p := range pl {
+		if p.Denom == denom {
+			return p, true
+		}
+	}
+
+	return DenomPool{}, false
+}
+
+// Validate performs basic validation on the denom pools, which must be unique
+func (pl DenomPoolList) Validate() error {
+	denoms := make(map[string]bool, len(pl))
+	for _, p := range pl {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		if denoms[p.Denom] {
+			return fmt.Errorf("duplicated denom pool %s", p.Denom)
+		}
+
+		denoms[p.Denom] = true
+	}
+
+	return nil
+}
@@ -8,4 +8,7 @@ import (
 var (
 	ErrRecursiveSwap    = sdkerrors.Register(ModuleName, 2, "recursive swap")
 	ErrNoEffectivePrice = sdkerrors.Register(ModuleName, 3, "no price registered with oracle")
+	ErrMaxSpread        = sdkerrors.Register(ModuleName, 4, "spread exceeds the max spread")
+	ErrMinAskAmount     = sdkerrors.Register(ModuleName, 5, "ask amount below the min ask amount")
+	ErrInvalidSwapRoute = sdkerrors.Register(ModuleName, 6, "invalid swap route")
 )
@@ -4,11 +4,13 @@ package types
 const (
 	EventSwap = "swap"
 
-	AttributeKeyOffer     = "offer"
-	AttributeKeyTrader    = "trader"
-	AttributeKeyRecipient = "recipient"
-	AttributeKeySwapCoin  = "swap_coin"
-	AttributeKeySwapFee   = "swap_fee"
+	AttributeKeyOffer      = "offer"
+	AttributeKeyTrader     = "trader"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeySwapCoin   = "swap_coin"
+	AttributeKeySwapFee    = "swap_fee"
+	AttributeKeyTobinTax   = "tobin_tax"
+	AttributeKeyDynamicFee = "dynamic_fee"
 
 	AttributeValueCategory = ModuleName
 )
@@ -30,6 +30,7 @@ type BankKeeper interface {
 // OracleKeeper defines expected oracle keeper
 type OracleKeeper interface {
 	GetLunaExchangeRate(ctx sdk.Context, denom string) (price sdk.Dec, err error)
+	GetFreshLunaExchangeRate(ctx sdk.Context, denom string, maxAge uint64) (price sdk.Dec, err error)
 	GetTobinTax(ctx sdk.Context, denom string) (tobinTax sdk.Dec, err error)
 
 	// only used for simulation
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,14 +19,66 @@ func NewGenesisState(terraPoolDelta sdk.Dec, params Params) *GenesisState {
 // DefaultGenesisState returns raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		TerraPoolDelta: sdk.ZeroDec(),
-		Params:         DefaultParams(),
+		TerraPoolDelta:  sdk.ZeroDec(),
+		Params:          DefaultParams(),
+		DenomPoolDeltas: []DenomPoolDelta{},
+		SwapVolumes:     []SwapVolume{},
+
+		EpochSwapIndicators: []EpochSwapIndicators{},
 	}
 }
 
 // ValidateGenesis validates the provided market genesis state
 func ValidateGenesis(data *GenesisState) error {
-	return data.Params.Validate()
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	denoms := make(map[string]bool, len(data.DenomPoolDeltas))
+	for _, poolDelta := range data.DenomPoolDeltas {
+		if _, ok := data.Params.DenomPools.Get(poolDelta.Denom); !ok {
+			return fmt.Errorf("no denom pool of %s", poolDelta.Denom)
+		}
+
+		if denoms[poolDelta.Denom] {
+			return fmt.Errorf("duplicated denom pool delta %s", poolDelta.Denom)
+		}
+
+		denoms[poolDelta.Denom] = true
+	}
+
+	pairs := make(map[string]bool, len(data.SwapVolumes))
+	for _, volume := range data.SwapVolumes {
+		if _, ok := data.Params.SwapFeePairs.Get(volume.DenomA, volume.DenomB); !ok {
+			return fmt.Errorf("no swap fee pair of %s/%s", volume.DenomA, volume.DenomB)
+		}
+
+		pair := string(GetSwapVolumeKey(volume.DenomA, volume.DenomB))
+		if pairs[pair] {
+			return fmt.Errorf("duplicated swap volume %s/%s", volume.DenomA, volume.DenomB)
+		}
+
+		pairs[pair] = true
+
+		if volume.Volume.IsNil() || volume.Volume.IsNegative() {
+			return fmt.Errorf("swap volume of %s/%s should not be negative, is %s", volume.DenomA, volume.DenomB, volume.Volume)
+		}
+	}
+
+	epochs := make(map[uint64]bool, len(data.EpochSwapIndicators))
+	for _, indicators := range data.EpochSwapIndicators {
+		if epochs[indicators.Epoch] {
+			return fmt.Errorf("duplicated swap indicators of epoch %d", indicators.Epoch)
+		}
+
+		epochs[indicators.Epoch] = true
+
+		if err := indicators.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // GetGenesisStateFromAppState returns x/market GenesisState given raw application
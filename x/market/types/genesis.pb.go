@@ -30,6 +30,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// the gap between the TerraPool and the BasePool
 	TerraPoolDelta github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=terra_pool_delta,json=terraPoolDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"terra_pool_delta"`
+	// the gaps between the Terra pools and the base pools of the denom pools
+	DenomPoolDeltas []DenomPoolDelta `protobuf:"bytes,3,rep,name=denom_pool_deltas,json=denomPoolDeltas,proto3" json:"denom_pool_deltas"`
+	// the rolling swap volumes of the swap fee pairs
+	SwapVolumes []SwapVolume `protobuf:"bytes,4,rep,name=swap_volumes,json=swapVolumes,proto3" json:"swap_volumes"`
+	// the swap activity of the market in each epoch with swaps
+	EpochSwapIndicators []EpochSwapIndicators `protobuf:"bytes,5,rep,name=epoch_swap_indicators,json=epochSwapIndicators,proto3" json:"epoch_swap_indicators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -72,6 +78,27 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetDenomPoolDeltas() []DenomPoolDelta {
+	if m != nil {
+		return m.DenomPoolDeltas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSwapVolumes() []SwapVolume {
+	if m != nil {
+		return m.SwapVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochSwapIndicators() []EpochSwapIndicators {
+	if m != nil {
+		return m.EpochSwapIndicators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "terra.market.v1beta1.GenesisState")
 }
@@ -81,24 +108,31 @@ func init() {
 }
 
 var fileDescriptor_e30414b001901db3 = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x49, 0x2d, 0x2a,
-	0x4a, 0xd4, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x01, 0xab, 0xd1, 0x83, 0xa8, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb1, 0x9a, 0x07, 0xd5, 0x0a, 0x56, 0xa2, 0xb4, 0x84,
-	0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x41, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x5b, 0x41,
-	0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c, 0x1e, 0x36, 0x0b,
-	0xf5, 0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x10, 0x8a, 0xe0,
-	0x12, 0x00, 0x2b, 0x8e, 0x2f, 0xc8, 0xcf, 0xcf, 0x89, 0x4f, 0x49, 0xcd, 0x29, 0x49, 0x94, 0x60,
-	0x52, 0x60, 0xd4, 0xe0, 0x71, 0xd2, 0x03, 0xa9, 0xbb, 0x75, 0x4f, 0x5e, 0x2d, 0x3d, 0xb3, 0x24,
-	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x18, 0x4a, 0xe9,
-	0x16, 0xa7, 0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16, 0xeb, 0xb9, 0xa4, 0x26, 0x07, 0xf1, 0x81,
-	0xcd, 0x09, 0xc8, 0xcf, 0xcf, 0x71, 0x01, 0x99, 0xe2, 0xe4, 0x72, 0xe2, 0x91, 0x1c, 0xe3, 0x85,
-	0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x5a, 0x48, 0x26, 0x82, 0x35, 0xe9, 0xe6, 0xe6, 0xe7, 0xa5, 0x56,
-	0xea, 0x27, 0xe7, 0x17, 0xa5, 0xea, 0x57, 0xc0, 0xfc, 0x0e, 0x36, 0x39, 0x89, 0x0d, 0xec, 0x67,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0x67, 0xa8, 0x02, 0x68, 0x01, 0x00, 0x00,
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6a, 0xea, 0x40,
+	0x14, 0x87, 0x93, 0xab, 0xd7, 0x45, 0x94, 0xfb, 0x27, 0xd7, 0x0b, 0x41, 0x2e, 0x31, 0x57, 0x4a,
+	0xb1, 0x05, 0x67, 0xd0, 0xee, 0xba, 0x94, 0x94, 0xe2, 0x4e, 0x14, 0xa4, 0x74, 0x13, 0xc6, 0xe4,
+	0x10, 0x83, 0x49, 0x4e, 0xc8, 0x8c, 0x5a, 0xdf, 0xa2, 0x0f, 0xd2, 0x07, 0x71, 0xe9, 0xb2, 0x74,
+	0x21, 0x45, 0x5f, 0xa4, 0x64, 0x4c, 0xc5, 0x42, 0x56, 0x09, 0x67, 0xbe, 0xdf, 0x77, 0x0e, 0x67,
+	0x46, 0x6b, 0x09, 0x48, 0x53, 0x46, 0x23, 0x96, 0xce, 0x41, 0xd0, 0x65, 0x77, 0x0a, 0x82, 0x75,
+	0xa9, 0x0f, 0x31, 0xf0, 0x80, 0x93, 0x24, 0x45, 0x81, 0x7a, 0x5d, 0x32, 0xe4, 0xc8, 0x90, 0x9c,
+	0x69, 0xd4, 0x7d, 0xf4, 0x51, 0x02, 0x34, 0xfb, 0x3b, 0xb2, 0x8d, 0xff, 0x85, 0xbe, 0x3c, 0x2a,
+	0x91, 0xd6, 0x4b, 0x49, 0xab, 0xdd, 0x1f, 0x1b, 0x8c, 0x05, 0x13, 0xa0, 0xdf, 0x6a, 0x95, 0x84,
+	0xa5, 0x2c, 0xe2, 0x86, 0x6a, 0xa9, 0xed, 0x6a, 0xef, 0x1f, 0x29, 0x6a, 0x48, 0x86, 0x92, 0xe9,
+	0x97, 0x37, 0xbb, 0xa6, 0x32, 0xca, 0x13, 0xfa, 0x83, 0xf6, 0x4b, 0xc2, 0x4e, 0x82, 0x18, 0x3a,
+	0x1e, 0x84, 0x82, 0x19, 0xdf, 0x2c, 0xb5, 0x5d, 0xeb, 0x93, 0x8c, 0x7b, 0xdb, 0x35, 0x2f, 0xfd,
+	0x40, 0xcc, 0x16, 0x53, 0xe2, 0x62, 0x44, 0x5d, 0xe4, 0x11, 0xf2, 0xfc, 0xd3, 0xe1, 0xde, 0x9c,
+	0x8a, 0x75, 0x02, 0x9c, 0xd8, 0xe0, 0x8e, 0x7e, 0x48, 0xcf, 0x10, 0x31, 0xb4, 0x33, 0x8b, 0x3e,
+	0xd1, 0x7e, 0x7b, 0x10, 0x63, 0x74, 0x66, 0xe6, 0x46, 0xc9, 0x2a, 0xb5, 0xab, 0xbd, 0x8b, 0xe2,
+	0x01, 0xed, 0x0c, 0x3f, 0x09, 0xf2, 0x41, 0x7f, 0x7a, 0x5f, 0xaa, 0x5c, 0x1f, 0x68, 0x35, 0xbe,
+	0x62, 0x89, 0xb3, 0xc4, 0x70, 0x11, 0x01, 0x37, 0xca, 0x52, 0x69, 0x15, 0x2b, 0xc7, 0x2b, 0x96,
+	0x4c, 0x24, 0x98, 0xeb, 0xaa, 0xfc, 0x54, 0xe1, 0xba, 0xab, 0xfd, 0x85, 0x04, 0xdd, 0x99, 0x23,
+	0x85, 0x41, 0xec, 0x05, 0x2e, 0x13, 0x98, 0x72, 0xe3, 0xbb, 0x74, 0x5e, 0x15, 0x3b, 0xef, 0xb2,
+	0x48, 0x26, 0x1e, 0x9c, 0x02, 0xb9, 0xfc, 0x0f, 0x14, 0x1c, 0xd9, 0x9b, 0xbd, 0xa9, 0x6e, 0xf7,
+	0xa6, 0xfa, 0xbe, 0x37, 0xd5, 0xe7, 0x83, 0xa9, 0x6c, 0x0f, 0xa6, 0xf2, 0x7a, 0x30, 0x95, 0xc7,
+	0xeb, 0xb3, 0xcd, 0xca, 0x4e, 0x9d, 0x08, 0x63, 0x58, 0x53, 0x17, 0x53, 0xa0, 0x4f, 0x9f, 0x6f,
+	0x40, 0x6e, 0x78, 0x5a, 0x91, 0x77, 0x7f, 0xf3, 0x31, 0x00, 0xbd, 0xc9, 0x31, 0x01, 0x70, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +155,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EpochSwapIndicators) > 0 {
+		for iNdEx := len(m.EpochSwapIndicators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochSwapIndicators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SwapVolumes) > 0 {
+		for iNdEx := len(m.SwapVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DenomPoolDeltas) > 0 {
+		for iNdEx := len(m.DenomPoolDeltas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPoolDeltas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.TerraPoolDelta.Size()
 		i -= size
@@ -165,6 +241,24 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TerraPoolDelta.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenomPoolDeltas) > 0 {
+		for _, e := range m.DenomPoolDeltas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SwapVolumes) > 0 {
+		for _, e := range m.SwapVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochSwapIndicators) > 0 {
+		for _, e := range m.EpochSwapIndicators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +363,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPoolDeltas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPoolDeltas = append(m.DenomPoolDeltas, DenomPoolDelta{})
+			if err := m.DenomPoolDeltas[len(m.DenomPoolDeltas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapVolumes = append(m.SwapVolumes, SwapVolume{})
+			if err := m.SwapVolumes[len(m.SwapVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSwapIndicators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochSwapIndicators = append(m.EpochSwapIndicators, EpochSwapIndicators{})
+			if err := m.EpochSwapIndicators[len(m.EpochSwapIndicators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
 )
 
 func TestGenesisValidation(t *testing.T) {
@@ -22,4 +24,60 @@ func TestGenesisValidation(t *testing.T) {
 	genState = DefaultGenesisState()
 	genState.Params.MinStabilitySpread = sdk.NewDec(-1)
 	require.Error(t, ValidateGenesis(genState))
+
+	// denom pool delta without a denom pool
+	genState = DefaultGenesisState()
+	genState.DenomPoolDeltas = []DenomPoolDelta{{Denom: core.MicroKRWDenom, TerraPoolDelta: sdk.OneDec()}}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.DenomPools = DenomPoolList{NewDenomPool(core.MicroKRWDenom, sdk.NewDec(1000000), 100)}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// duplicated denom pool delta
+	genState.DenomPoolDeltas = append(genState.DenomPoolDeltas, genState.DenomPoolDeltas[0])
+	require.Error(t, ValidateGenesis(genState))
+
+	// swap volume without a swap fee pair
+	genState = DefaultGenesisState()
+	genState.SwapVolumes = []SwapVolume{{DenomA: core.MicroKRWDenom, DenomB: core.MicroUSDDenom, Volume: sdk.NewDec(1000)}}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.SwapFeePairs = SwapFeePairList{NewSwapFeePair(core.MicroUSDDenom, core.MicroKRWDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000000), 100)}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// duplicated swap volume, in either order
+	genState.SwapVolumes = append(genState.SwapVolumes, SwapVolume{DenomA: core.MicroUSDDenom, DenomB: core.MicroKRWDenom, Volume: sdk.NewDec(1000)})
+	require.Error(t, ValidateGenesis(genState))
+
+	// negative swap volume
+	genState.SwapVolumes = []SwapVolume{{DenomA: core.MicroKRWDenom, DenomB: core.MicroUSDDenom, Volume: sdk.NewDec(-1)}}
+	require.Error(t, ValidateGenesis(genState))
+
+	// epoch swap indicators
+	genState = DefaultGenesisState()
+	genState.EpochSwapIndicators = []EpochSwapIndicators{{
+		Epoch:       1,
+		SwapVolumes: []SwapVolume{{DenomA: core.MicroLunaDenom, DenomB: core.MicroKRWDenom, Volume: sdk.NewDec(1000)}},
+		Minted:      sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 1000)),
+	}}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// duplicated epoch
+	genState.EpochSwapIndicators = append(genState.EpochSwapIndicators, EpochSwapIndicators{Epoch: 1})
+	require.Error(t, ValidateGenesis(genState))
+
+	// duplicated pair, in either order
+	genState.EpochSwapIndicators = genState.EpochSwapIndicators[:1]
+	genState.EpochSwapIndicators[0].SwapVolumes = append(genState.EpochSwapIndicators[0].SwapVolumes,
+		SwapVolume{DenomA: core.MicroKRWDenom, DenomB: core.MicroLunaDenom, Volume: sdk.NewDec(1000)})
+	require.Error(t, ValidateGenesis(genState))
+
+	// negative swap volume
+	genState.EpochSwapIndicators[0].SwapVolumes = []SwapVolume{{DenomA: core.MicroLunaDenom, DenomB: core.MicroKRWDenom, Volume: sdk.NewDec(-1)}}
+	require.Error(t, ValidateGenesis(genState))
+
+	// invalid coins
+	genState.EpochSwapIndicators[0].SwapVolumes = nil
+	genState.EpochSwapIndicators[0].Burned = sdk.Coins{sdk.Coin{Denom: core.MicroLunaDenom, Amount: sdk.NewInt(-1)}}
+	require.Error(t, ValidateGenesis(genState))
 }
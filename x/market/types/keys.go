@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the market module
 	ModuleName = "market"
@@ -7,6 +11,9 @@ const (
 	// StoreKey is the string store representation
 	StoreKey = ModuleName
 
+	// TStoreKey is the string transient store representation
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the msg router key for the market module
 	RouterKey = ModuleName
 
@@ -18,7 +25,54 @@ const (
 // Items are stored with the following key: values
 //
 // - 0x01: sdk.Dec
+//
+// - 0x02<denom_Bytes>: sdk.Dec
+//
+// - 0x03<denom_Bytes><0x00><denom_Bytes>: SwapVolume
+//
+// - 0x04<epoch_Bytes>: EpochSwapIndicators, without the swap volumes
+//
+// - 0x05<epoch_Bytes><denom_Bytes><0x00><denom_Bytes>: SwapVolume
 var (
 	// Keys for store prefixed
 	TerraPoolDeltaKey = []byte{0x01} // key for terra pool delta which gap between MintPool from BasePool
+	DenomPoolDeltaKey = []byte{0x02} // prefix for each key to the terra pool delta of a denom pool
+	SwapVolumeKey     = []byte{0x03} // prefix for each key to the swap volume of a swap fee pair
+
+	// Keys for store prefixes of the swap indicators
+	EpochSwapIndicatorsKey = []byte{0x04} // prefix for each key to the swap indicators of an epoch
+	EpochSwapVolumeKey     = []byte{0x05} // prefix for each key to the swap volume of a pair in an epoch
 )
+
+// GetDenomPoolDeltaKey - stored by *denom*
+func GetDenomPoolDeltaKey(denom string) []byte {
+	return append(DenomPoolDeltaKey, []byte(denom)...)
+}
+
+// GetSwapVolumeKey - stored by the *denoms* of the pair in alphabetical order,
+// also the key of the swap volume of the current block in the transient store
+func GetSwapVolumeKey(denomA string, denomB string) []byte {
+	if denomA > denomB {
+		denomA, denomB = denomB, denomA
+	}
+
+	key := append([]byte{}, SwapVolumeKey...)
+	key = append(key, []byte(denomA)...)
+	key = append(key, 0x00)
+	return append(key, []byte(denomB)...)
+}
+
+// GetEpochSwapIndicatorsKey - stored by *epoch*
+func GetEpochSwapIndicatorsKey(epoch uint64) []byte {
+	return append(append([]byte{}, EpochSwapIndicatorsKey...), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetEpochSwapVolumesPrefix - prefix of the swap volumes of the *epoch*
+func GetEpochSwapVolumesPrefix(epoch uint64) []byte {
+	return append(append([]byte{}, EpochSwapVolumeKey...), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetEpochSwapVolumeKey - stored by *epoch* and the *denoms* of the pair in alphabetical order
+func GetEpochSwapVolumeKey(epoch uint64, denomA string, denomB string) []byte {
+	return append(GetEpochSwapVolumesPrefix(epoch), GetSwapVolumeKey(denomA, denomB)[len(SwapVolumeKey):]...)
+}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,6 +30,14 @@ type Params struct {
 	BasePool           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_pool,json=basePool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_pool" yaml:"base_pool"`
 	PoolRecoveryPeriod uint64                                 `protobuf:"varint,2,opt,name=pool_recovery_period,json=poolRecoveryPeriod,proto3" json:"pool_recovery_period,omitempty" yaml:"pool_recovery_period"`
 	MinStabilitySpread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_stability_spread,json=minStabilitySpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_stability_spread" yaml:"min_stability_spread"`
+	// max_rate_age is the number of blocks since their last update after which the exchange
+	// rates of the oracle are too stale to swap and to update the tax caps; unlimited when zero
+	MaxRateAge uint64 `protobuf:"varint,4,opt,name=max_rate_age,json=maxRateAge,proto3" json:"max_rate_age,omitempty" yaml:"max_rate_age"`
+	// denom_pools are the Terra denoms swapped with Luna through their own pool instead of the shared one
+	DenomPools DenomPoolList `protobuf:"bytes,5,rep,name=denom_pools,json=denomPools,proto3,castrepeated=DenomPoolList" json:"denom_pools" yaml:"denom_pools"`
+	// swap_fee_pairs are the pairs of Terra denoms charging a dynamic fee growing with their swap volume
+	// on top of the tobin tax
+	SwapFeePairs SwapFeePairList `protobuf:"bytes,6,rep,name=swap_fee_pairs,json=swapFeePairs,proto3,castrepeated=SwapFeePairList" json:"swap_fee_pairs" yaml:"swap_fee_pairs"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -70,35 +79,305 @@ func (m *Params) GetPoolRecoveryPeriod() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxRateAge() uint64 {
+	if m != nil {
+		return m.MaxRateAge
+	}
+	return 0
+}
+
+func (m *Params) GetDenomPools() DenomPoolList {
+	if m != nil {
+		return m.DenomPools
+	}
+	return nil
+}
+
+func (m *Params) GetSwapFeePairs() SwapFeePairList {
+	if m != nil {
+		return m.SwapFeePairs
+	}
+	return nil
+}
+
+// DenomPool defines the constant-product pool of the swaps between Luna and a Terra denom
+type DenomPool struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// base_pool is the liquidity of the pool in usdr unit, like the shared base pool
+	BasePool           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_pool,json=basePool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_pool" yaml:"base_pool"`
+	PoolRecoveryPeriod uint64                                 `protobuf:"varint,3,opt,name=pool_recovery_period,json=poolRecoveryPeriod,proto3" json:"pool_recovery_period,omitempty" yaml:"pool_recovery_period"`
+}
+
+func (m *DenomPool) Reset()      { *m = DenomPool{} }
+func (*DenomPool) ProtoMessage() {}
+func (*DenomPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{1}
+}
+func (m *DenomPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPool.Merge(m, src)
+}
+func (m *DenomPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPool proto.InternalMessageInfo
+
+// DenomPoolDelta defines the gap between the Terra pool and the base pool of a denom pool
+type DenomPoolDelta struct {
+	Denom          string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	TerraPoolDelta github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=terra_pool_delta,json=terraPoolDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"terra_pool_delta" yaml:"terra_pool_delta"`
+}
+
+func (m *DenomPoolDelta) Reset()         { *m = DenomPoolDelta{} }
+func (m *DenomPoolDelta) String() string { return proto.CompactTextString(m) }
+func (*DenomPoolDelta) ProtoMessage()    {}
+func (*DenomPoolDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{2}
+}
+func (m *DenomPoolDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPoolDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPoolDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPoolDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPoolDelta.Merge(m, src)
+}
+func (m *DenomPoolDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPoolDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPoolDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPoolDelta proto.InternalMessageInfo
+
+// SwapFeePair defines the dynamic fee of the swaps between two Terra denoms, in both directions.
+// The fee grows linearly with the rolling swap volume of the pair up to the max fee at the volume limit.
+type SwapFeePair struct {
+	DenomA string                                 `protobuf:"bytes,1,opt,name=denom_a,json=denomA,proto3" json:"denom_a,omitempty" yaml:"denom_a"`
+	DenomB string                                 `protobuf:"bytes,2,opt,name=denom_b,json=denomB,proto3" json:"denom_b,omitempty" yaml:"denom_b"`
+	MaxFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_fee,json=maxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_fee" yaml:"max_fee"`
+	// volume_limit is the rolling swap volume in usdr unit at which the max fee is charged
+	VolumeLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=volume_limit,json=volumeLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume_limit" yaml:"volume_limit"`
+	// volume_window is the number of blocks over which the swap volume of a block decays
+	VolumeWindow uint64 `protobuf:"varint,5,opt,name=volume_window,json=volumeWindow,proto3" json:"volume_window,omitempty" yaml:"volume_window"`
+}
+
+func (m *SwapFeePair) Reset()      { *m = SwapFeePair{} }
+func (*SwapFeePair) ProtoMessage() {}
+func (*SwapFeePair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{3}
+}
+func (m *SwapFeePair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapFeePair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapFeePair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapFeePair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapFeePair.Merge(m, src)
+}
+func (m *SwapFeePair) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapFeePair) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapFeePair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapFeePair proto.InternalMessageInfo
+
+// SwapVolume defines the swap volume of a pair of denoms in usdr unit, rolling for the swap fee pairs
+type SwapVolume struct {
+	DenomA string                                 `protobuf:"bytes,1,opt,name=denom_a,json=denomA,proto3" json:"denom_a,omitempty" yaml:"denom_a"`
+	DenomB string                                 `protobuf:"bytes,2,opt,name=denom_b,json=denomB,proto3" json:"denom_b,omitempty" yaml:"denom_b"`
+	Volume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume" yaml:"volume"`
+}
+
+func (m *SwapVolume) Reset()         { *m = SwapVolume{} }
+func (m *SwapVolume) String() string { return proto.CompactTextString(m) }
+func (*SwapVolume) ProtoMessage()    {}
+func (*SwapVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{4}
+}
+func (m *SwapVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapVolume.Merge(m, src)
+}
+func (m *SwapVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapVolume proto.InternalMessageInfo
+
+// EpochSwapIndicators defines the swap activity of the market in an epoch
+type EpochSwapIndicators struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty" yaml:"epoch"`
+	// swap_volumes are the usdr amounts offered by the swaps between each pair of denoms
+	SwapVolumes []SwapVolume `protobuf:"bytes,2,rep,name=swap_volumes,json=swapVolumes,proto3" json:"swap_volumes" yaml:"swap_volumes"`
+	// minted and burned are the coins minted and burned by the swaps
+	Minted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted" yaml:"minted"`
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned" yaml:"burned"`
+	// swap_fees are the spread fees of the swaps paid to the oracle pool
+	SwapFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees" yaml:"swap_fees"`
+}
+
+func (m *EpochSwapIndicators) Reset()         { *m = EpochSwapIndicators{} }
+func (m *EpochSwapIndicators) String() string { return proto.CompactTextString(m) }
+func (*EpochSwapIndicators) ProtoMessage()    {}
+func (*EpochSwapIndicators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{5}
+}
+func (m *EpochSwapIndicators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSwapIndicators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSwapIndicators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSwapIndicators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSwapIndicators.Merge(m, src)
+}
+func (m *EpochSwapIndicators) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSwapIndicators) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSwapIndicators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSwapIndicators proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "terra.market.v1beta1.Params")
+	proto.RegisterType((*DenomPool)(nil), "terra.market.v1beta1.DenomPool")
+	proto.RegisterType((*DenomPoolDelta)(nil), "terra.market.v1beta1.DenomPoolDelta")
+	proto.RegisterType((*SwapFeePair)(nil), "terra.market.v1beta1.SwapFeePair")
+	proto.RegisterType((*SwapVolume)(nil), "terra.market.v1beta1.SwapVolume")
+	proto.RegisterType((*EpochSwapIndicators)(nil), "terra.market.v1beta1.EpochSwapIndicators")
 }
 
 func init() { proto.RegisterFile("terra/market/v1beta1/market.proto", fileDescriptor_114ea92c5ae3e66f) }
 
 var fileDescriptor_114ea92c5ae3e66f = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x31, 0x4b, 0xf3, 0x40,
-	0x18, 0xc7, 0x73, 0x7d, 0x5f, 0x4a, 0x0d, 0x0e, 0x12, 0x32, 0x14, 0x85, 0x5c, 0xcd, 0x20, 0x45,
-	0x68, 0x8e, 0xe2, 0xd6, 0x31, 0x74, 0x15, 0x6a, 0xba, 0xb9, 0x84, 0x4b, 0x7a, 0xd4, 0xd0, 0x5c,
-	0x9f, 0x70, 0x77, 0x16, 0x33, 0xf9, 0x15, 0x1c, 0x1d, 0xfb, 0x6d, 0xec, 0xd8, 0x51, 0x1c, 0x82,
-	0xb4, 0x8b, 0x73, 0x3f, 0x81, 0xe4, 0x7a, 0x15, 0x87, 0x2e, 0x4e, 0xf7, 0xdc, 0x8f, 0xdf, 0x3d,
-	0xf7, 0x87, 0xbf, 0x7d, 0xa9, 0x98, 0x10, 0x94, 0x70, 0x2a, 0x66, 0x4c, 0x91, 0x45, 0x3f, 0x61,
-	0x8a, 0xf6, 0xcd, 0x35, 0x28, 0x04, 0x28, 0x70, 0x5c, 0xad, 0x04, 0x86, 0x19, 0xe5, 0xdc, 0x9d,
-	0xc2, 0x14, 0xb4, 0x40, 0xea, 0x69, 0xef, 0xfa, 0x6f, 0x0d, 0xbb, 0x39, 0xa2, 0x82, 0x72, 0xe9,
-	0xc4, 0xf6, 0x49, 0x42, 0x25, 0x8b, 0x0b, 0x80, 0xbc, 0x8d, 0x3a, 0xa8, 0x7b, 0x1a, 0x86, 0xab,
-	0x0a, 0x5b, 0x1f, 0x15, 0xbe, 0x9a, 0x66, 0xea, 0xe1, 0x31, 0x09, 0x52, 0xe0, 0x24, 0x05, 0xc9,
-	0x41, 0x9a, 0xa3, 0x27, 0x27, 0x33, 0xa2, 0xca, 0x82, 0xc9, 0x60, 0xc8, 0xd2, 0x5d, 0x85, 0xcf,
-	0x4a, 0xca, 0xf3, 0x81, 0xff, 0xb3, 0xc8, 0x8f, 0x5a, 0xf5, 0x3c, 0x02, 0xc8, 0x9d, 0x3b, 0xdb,
-	0xad, 0x51, 0x2c, 0x58, 0x0a, 0x0b, 0x26, 0xca, 0xb8, 0x60, 0x22, 0x83, 0x49, 0xbb, 0xd1, 0x41,
-	0xdd, 0xff, 0x21, 0xde, 0x55, 0xf8, 0x62, 0xff, 0xfa, 0x98, 0xe5, 0x47, 0x4e, 0x8d, 0x23, 0x43,
-	0x47, 0x1a, 0x3a, 0xcf, 0xb6, 0xcb, 0xb3, 0x79, 0x2c, 0x15, 0x4d, 0xb2, 0x3c, 0x53, 0x65, 0x2c,
-	0x0b, 0xc1, 0xe8, 0xa4, 0xfd, 0x4f, 0xc7, 0xbf, 0xfd, 0x73, 0x7c, 0x13, 0xe0, 0xd8, 0x4e, 0x3f,
-	0x72, 0x78, 0x36, 0x1f, 0x1f, 0xe8, 0x58, 0xc3, 0x41, 0xeb, 0x75, 0x89, 0xad, 0xaf, 0x25, 0x46,
-	0xe1, 0x70, 0xb5, 0xf1, 0xd0, 0x7a, 0xe3, 0xa1, 0xcf, 0x8d, 0x87, 0x5e, 0xb6, 0x9e, 0xb5, 0xde,
-	0x7a, 0xd6, 0xfb, 0xd6, 0xb3, 0xee, 0xaf, 0x7f, 0x7d, 0xaf, 0xab, 0xe9, 0x71, 0x98, 0xb3, 0x92,
-	0xa4, 0x20, 0x18, 0x79, 0x3a, 0x54, 0xa9, 0x63, 0x24, 0x4d, 0x5d, 0xcb, 0xcd, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xd8, 0x4a, 0x5f, 0x32, 0xe7, 0x01, 0x00, 0x00,
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x8e, 0x9b, 0x8c, 0x1d, 0x13, 0x4d, 0x8c, 0xba, 0xb4, 0x92, 0x37, 0x9d, 0x43,
+	0x15, 0x51, 0xd5, 0xa6, 0x70, 0x22, 0x12, 0x82, 0x2c, 0x6e, 0xa5, 0x4a, 0x45, 0x0a, 0x13, 0x89,
+	0x0a, 0x2e, 0xcb, 0xd8, 0xfb, 0xe2, 0xac, 0xea, 0xdd, 0x59, 0xed, 0x4c, 0x7e, 0x58, 0x42, 0xe2,
+	0xda, 0x23, 0x12, 0x17, 0x8e, 0x39, 0xf3, 0x0f, 0xf0, 0x2f, 0x94, 0x5b, 0x8f, 0x88, 0xc3, 0x82,
+	0x92, 0x0b, 0x07, 0x4e, 0x96, 0x38, 0x70, 0x43, 0xf3, 0xc3, 0x9b, 0x4d, 0x65, 0x50, 0x17, 0x09,
+	0x4e, 0xde, 0x79, 0xf3, 0xcd, 0xf7, 0xbd, 0xf7, 0xe6, 0xbd, 0x37, 0x46, 0x77, 0x24, 0x64, 0x19,
+	0x1b, 0xc4, 0x2c, 0x7b, 0x06, 0x72, 0x70, 0xf2, 0x60, 0x04, 0x92, 0x3d, 0xb0, 0xcb, 0x7e, 0x9a,
+	0x71, 0xc9, 0x71, 0x57, 0x43, 0xfa, 0xd6, 0x66, 0x21, 0xb7, 0xba, 0x13, 0x3e, 0xe1, 0x1a, 0x30,
+	0x50, 0x5f, 0x06, 0x7b, 0xab, 0x37, 0xe6, 0x22, 0xe6, 0x62, 0x30, 0x62, 0x02, 0x0a, 0xb6, 0x31,
+	0x8f, 0x12, 0xb3, 0x4f, 0x7e, 0x6f, 0xa0, 0xe6, 0x3e, 0xcb, 0x58, 0x2c, 0x70, 0x80, 0xd6, 0x15,
+	0x2a, 0x48, 0x39, 0x9f, 0xba, 0xce, 0xb6, 0xb3, 0xd3, 0xf6, 0xfd, 0x17, 0xb9, 0x57, 0xfb, 0x39,
+	0xf7, 0xee, 0x4e, 0x22, 0x79, 0x74, 0x3c, 0xea, 0x8f, 0x79, 0x3c, 0xb0, 0x84, 0xe6, 0xe7, 0xbe,
+	0x08, 0x9f, 0x0d, 0xe4, 0x2c, 0x05, 0xd1, 0x1f, 0xc2, 0x78, 0x9e, 0x7b, 0x9b, 0x33, 0x16, 0x4f,
+	0x77, 0x49, 0x41, 0x44, 0xe8, 0x9a, 0xfa, 0xde, 0xe7, 0x7c, 0x8a, 0x3f, 0x45, 0x5d, 0x65, 0x0a,
+	0x32, 0x18, 0xf3, 0x13, 0xc8, 0x66, 0x41, 0x0a, 0x59, 0xc4, 0x43, 0xb7, 0xbe, 0xed, 0xec, 0x34,
+	0x7c, 0x6f, 0x9e, 0x7b, 0xb7, 0xcd, 0xe9, 0x65, 0x28, 0x42, 0xb1, 0x32, 0x53, 0x6b, 0xdd, 0xd7,
+	0x46, 0xfc, 0x35, 0xea, 0xc6, 0x51, 0x12, 0x08, 0xc9, 0x46, 0xd1, 0x34, 0x92, 0xb3, 0x40, 0xa4,
+	0x19, 0xb0, 0xd0, 0x5d, 0xd1, 0xee, 0x7f, 0x52, 0xd9, 0x7d, 0xeb, 0xc0, 0x32, 0x4e, 0x42, 0x71,
+	0x1c, 0x25, 0x07, 0x0b, 0xeb, 0x81, 0x36, 0xe2, 0xf7, 0x51, 0x3b, 0x66, 0x67, 0x41, 0xc6, 0x24,
+	0x04, 0x6c, 0x02, 0x6e, 0x43, 0xc7, 0x72, 0x73, 0x9e, 0x7b, 0x5b, 0x96, 0xaa, 0xb4, 0x4b, 0x28,
+	0x8a, 0xd9, 0x19, 0x65, 0x12, 0xf6, 0x26, 0x80, 0x63, 0xd4, 0x0a, 0x21, 0xe1, 0xb1, 0xce, 0x93,
+	0x70, 0x57, 0xb7, 0x57, 0x76, 0x5a, 0xef, 0x7a, 0xfd, 0x65, 0x97, 0xdb, 0x1f, 0x2a, 0xa0, 0x4a,
+	0xa2, 0x7f, 0x4f, 0xc5, 0x34, 0xcf, 0x3d, 0x6c, 0xe8, 0x4b, 0x0c, 0xe4, 0xfb, 0x5f, 0xbc, 0x8d,
+	0x02, 0xf8, 0x24, 0x12, 0x92, 0xa2, 0x70, 0xb1, 0x14, 0x78, 0x86, 0x3a, 0xe2, 0x94, 0xa5, 0xc1,
+	0x21, 0x40, 0x90, 0xb2, 0x28, 0x13, 0x6e, 0x53, 0x2b, 0xde, 0x59, 0xae, 0x78, 0x70, 0xca, 0xd2,
+	0x47, 0x00, 0xfb, 0x2c, 0xca, 0xfc, 0x77, 0xac, 0xe6, 0x9b, 0x46, 0xf3, 0x3a, 0x8d, 0x92, 0x7d,
+	0xa3, 0x84, 0xd6, 0xc2, 0x6d, 0x71, 0x65, 0x10, 0xbb, 0x6b, 0xdf, 0x9d, 0x7b, 0xb5, 0xdf, 0xce,
+	0x3d, 0x87, 0xfc, 0xe1, 0xa0, 0xf5, 0xc2, 0x45, 0x7c, 0x17, 0xad, 0x6a, 0x07, 0x75, 0xb5, 0xad,
+	0xfb, 0x9b, 0xf3, 0xdc, 0x6b, 0x97, 0xc2, 0x22, 0xd4, 0x6c, 0x5f, 0xaf, 0xcc, 0xfa, 0xff, 0x58,
+	0x99, 0x2b, 0xff, 0xba, 0x32, 0x77, 0xdb, 0xcf, 0xcf, 0xbd, 0x5a, 0x11, 0xf7, 0x0f, 0x0e, 0xea,
+	0x14, 0x71, 0x0f, 0x61, 0x2a, 0xd9, 0x6b, 0x07, 0x2f, 0xd0, 0xa6, 0xbe, 0x20, 0xed, 0x74, 0x10,
+	0xaa, 0xb3, 0x36, 0x07, 0x8f, 0x2b, 0xe7, 0xe0, 0xa6, 0x11, 0x78, 0x95, 0x8f, 0xd0, 0x8e, 0x36,
+	0x15, 0xce, 0xed, 0xae, 0x3d, 0x37, 0x9e, 0xd7, 0xc8, 0x9f, 0x75, 0xd4, 0x2a, 0xdd, 0x2e, 0xbe,
+	0x87, 0x6e, 0x98, 0x9a, 0x63, 0xd6, 0x71, 0x3c, 0xcf, 0xbd, 0x4e, 0xb9, 0x18, 0x19, 0xa1, 0x4d,
+	0xfd, 0xb5, 0x77, 0x05, 0x1e, 0xb9, 0xf5, 0xe5, 0xe0, 0xd1, 0x02, 0xec, 0xe3, 0xcf, 0xd1, 0x0d,
+	0xd5, 0x2c, 0x87, 0x00, 0xb6, 0x7d, 0x3f, 0xaa, 0x1c, 0x5f, 0xe7, 0xaa, 0xe7, 0x0e, 0x01, 0x08,
+	0x6d, 0xc6, 0xec, 0xec, 0x11, 0x00, 0x3e, 0x42, 0xed, 0x13, 0x3e, 0x3d, 0x8e, 0x21, 0x98, 0x46,
+	0x71, 0x24, 0x75, 0x97, 0xb6, 0xfd, 0x87, 0x95, 0xf9, 0x6d, 0x4f, 0x97, 0xb9, 0x08, 0x6d, 0x99,
+	0xe5, 0x13, 0xb5, 0xc2, 0x1f, 0xa0, 0x0d, 0xbb, 0x7b, 0x1a, 0x25, 0x21, 0x3f, 0x75, 0x57, 0x75,
+	0x09, 0xb9, 0xf3, 0xdc, 0xeb, 0x5e, 0x3b, 0x6c, 0xb6, 0x09, 0xb5, 0x8e, 0x3d, 0xd5, 0xcb, 0x57,
+	0xaa, 0xe6, 0x47, 0x07, 0x21, 0x95, 0xfb, 0xcf, 0x34, 0xe4, 0x3f, 0x4c, 0xfd, 0x53, 0xd4, 0x34,
+	0x6e, 0xd8, 0xcc, 0x7f, 0x58, 0x39, 0x33, 0x1b, 0xe5, 0xe0, 0x08, 0xb5, 0x74, 0xa5, 0x3a, 0xfa,
+	0xb6, 0x81, 0xb6, 0x1e, 0xa6, 0x7c, 0x7c, 0xa4, 0x02, 0x7a, 0x9c, 0x84, 0xd1, 0x98, 0x49, 0x9e,
+	0x09, 0xd5, 0x06, 0xa0, 0xcc, 0x3a, 0xa4, 0x46, 0xb9, 0x0d, 0xb4, 0x99, 0x50, 0xb3, 0x8d, 0xbf,
+	0x44, 0x7a, 0xa6, 0x04, 0x86, 0x58, 0xb8, 0x75, 0x3d, 0xbc, 0xb6, 0xff, 0x7e, 0x78, 0x99, 0xa4,
+	0xf9, 0xb7, 0xed, 0xec, 0xda, 0x2a, 0xcd, 0x2e, 0xcb, 0x41, 0x68, 0x4b, 0x14, 0x40, 0x81, 0x25,
+	0x6a, 0xc6, 0x51, 0x22, 0x41, 0xb5, 0xbd, 0xe2, 0x7e, 0xab, 0x6f, 0x62, 0xed, 0xab, 0x31, 0x51,
+	0x50, 0x7f, 0xcc, 0xa3, 0xc4, 0xdf, 0xb3, 0xa4, 0x1b, 0xc5, 0x73, 0x21, 0x21, 0x54, 0x83, 0x70,
+	0xe7, 0x35, 0x12, 0xa6, 0x18, 0x04, 0xb5, 0x5a, 0x4a, 0x75, 0x74, 0x9c, 0x25, 0x10, 0xba, 0x8d,
+	0x8a, 0xaa, 0xe6, 0x58, 0x45, 0x55, 0x73, 0x08, 0x7f, 0x85, 0xd6, 0x17, 0x53, 0x7c, 0xf1, 0xf2,
+	0xfc, 0x83, 0xf0, 0xd0, 0x0a, 0x6f, 0x5e, 0x9f, 0xff, 0xa2, 0x9a, 0xf6, 0x9a, 0x7d, 0x13, 0xc4,
+	0x55, 0x55, 0xf8, 0xc3, 0x17, 0x17, 0x3d, 0xe7, 0xe5, 0x45, 0xcf, 0xf9, 0xf5, 0xa2, 0xe7, 0x7c,
+	0x73, 0xd9, 0xab, 0xbd, 0xbc, 0xec, 0xd5, 0x7e, 0xba, 0xec, 0xd5, 0xbe, 0x78, 0xbb, 0xc4, 0xab,
+	0xef, 0xf8, 0x7e, 0xcc, 0x13, 0x98, 0x0d, 0xc6, 0x3c, 0x83, 0xc1, 0xd9, 0xe2, 0xff, 0x91, 0xe6,
+	0x1f, 0x35, 0xf5, 0x7f, 0x99, 0xf7, 0xfe, 0x1a, 0x00, 0x4c, 0xb6, 0x42, 0x05, 0x3c, 0x09, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -129,6 +408,91 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinStabilitySpread.Equal(that1.MinStabilitySpread) {
 		return false
 	}
+	if this.MaxRateAge != that1.MaxRateAge {
+		return false
+	}
+	if len(this.DenomPools) != len(that1.DenomPools) {
+		return false
+	}
+	for i := range this.DenomPools {
+		if !this.DenomPools[i].Equal(&that1.DenomPools[i]) {
+			return false
+		}
+	}
+	if len(this.SwapFeePairs) != len(that1.SwapFeePairs) {
+		return false
+	}
+	for i := range this.SwapFeePairs {
+		if !this.SwapFeePairs[i].Equal(&that1.SwapFeePairs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DenomPool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomPool)
+	if !ok {
+		that2, ok := that.(DenomPool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.BasePool.Equal(that1.BasePool) {
+		return false
+	}
+	if this.PoolRecoveryPeriod != that1.PoolRecoveryPeriod {
+		return false
+	}
+	return true
+}
+func (this *SwapFeePair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwapFeePair)
+	if !ok {
+		that2, ok := that.(SwapFeePair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomA != that1.DenomA {
+		return false
+	}
+	if this.DenomB != that1.DenomB {
+		return false
+	}
+	if !this.MaxFee.Equal(that1.MaxFee) {
+		return false
+	}
+	if !this.VolumeLimit.Equal(that1.VolumeLimit) {
+		return false
+	}
+	if this.VolumeWindow != that1.VolumeWindow {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -151,6 +515,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SwapFeePairs) > 0 {
+		for iNdEx := len(m.SwapFeePairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFeePairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DenomPools) > 0 {
+		for iNdEx := len(m.DenomPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxRateAge != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxRateAge))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.MinStabilitySpread.Size()
 		i -= size
@@ -172,47 +569,1122 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.BasePool.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolRecoveryPeriod != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.PoolRecoveryPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BasePool.Size()
+		i -= size
+		if _, err := m.BasePool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPoolDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPoolDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPoolDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TerraPoolDelta.Size()
+		i -= size
+		if _, err := m.TerraPoolDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapFeePair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapFeePair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapFeePair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VolumeWindow != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.VolumeWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.VolumeLimit.Size()
+		i -= size
+		if _, err := m.VolumeLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DenomB) > 0 {
+		i -= len(m.DenomB)
+		copy(dAtA[i:], m.DenomB)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.DenomB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomA) > 0 {
+		i -= len(m.DenomA)
+		copy(dAtA[i:], m.DenomA)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.DenomA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DenomB) > 0 {
+		i -= len(m.DenomB)
+		copy(dAtA[i:], m.DenomB)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.DenomB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomA) > 0 {
+		i -= len(m.DenomA)
+		copy(dAtA[i:], m.DenomA)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.DenomA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochSwapIndicators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSwapIndicators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSwapIndicators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SwapVolumes) > 0 {
+		for iNdEx := len(m.SwapVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BasePool.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.PoolRecoveryPeriod != 0 {
+		n += 1 + sovMarket(uint64(m.PoolRecoveryPeriod))
+	}
+	l = m.MinStabilitySpread.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.MaxRateAge != 0 {
+		n += 1 + sovMarket(uint64(m.MaxRateAge))
+	}
+	if len(m.DenomPools) > 0 {
+		for _, e := range m.DenomPools {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.SwapFeePairs) > 0 {
+		for _, e := range m.SwapFeePairs {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.BasePool.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.PoolRecoveryPeriod != 0 {
+		n += 1 + sovMarket(uint64(m.PoolRecoveryPeriod))
+	}
+	return n
+}
+
+func (m *DenomPoolDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.TerraPoolDelta.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *SwapFeePair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomA)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.DenomB)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.MaxFee.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.VolumeLimit.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.VolumeWindow != 0 {
+		n += 1 + sovMarket(uint64(m.VolumeWindow))
+	}
+	return n
+}
+
+func (m *SwapVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomA)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.DenomB)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *EpochSwapIndicators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovMarket(uint64(m.Epoch))
+	}
+	if len(m.SwapVolumes) > 0 {
+		for _, e := range m.SwapVolumes {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarket(x uint64) (n int) {
+	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePool", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecoveryPeriod", wireType)
+			}
+			m.PoolRecoveryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolRecoveryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStabilitySpread", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStabilitySpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateAge", wireType)
+			}
+			m.MaxRateAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRateAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPools = append(m.DenomPools, DenomPool{})
+			if err := m.DenomPools[len(m.DenomPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeePairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFeePairs = append(m.SwapFeePairs, SwapFeePair{})
+			if err := m.SwapFeePairs[len(m.SwapFeePairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePool", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecoveryPeriod", wireType)
+			}
+			m.PoolRecoveryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolRecoveryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPoolDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPoolDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPoolDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerraPoolDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TerraPoolDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapFeePair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapFeePair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapFeePair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeLimit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolumeLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeWindow", wireType)
+			}
+			m.VolumeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VolumeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.BasePool.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	if m.PoolRecoveryPeriod != 0 {
-		n += 1 + sovMarket(uint64(m.PoolRecoveryPeriod))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.MinStabilitySpread.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	return n
-}
-
-func sovMarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMarket(x uint64) (n int) {
-	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SwapVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -235,15 +1707,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -270,15 +1806,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BasePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochSwapIndicators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochSwapIndicators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochSwapIndicators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecoveryPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.PoolRecoveryPeriod = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -288,16 +1874,50 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolRecoveryPeriod |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapVolumes = append(m.SwapVolumes, SwapVolume{})
+			if err := m.SwapVolumes[len(m.SwapVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStabilitySpread", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -307,22 +1927,91 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinStabilitySpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFees = append(m.SwapFees, types.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9,14 +9,19 @@ import (
 var (
 	_ sdk.Msg = &MsgSwap{}
 	_ sdk.Msg = &MsgSwapSend{}
+	_ sdk.Msg = &MsgSwapRoute{}
 )
 
 // market message types
 const (
-	TypeMsgSwap     = "swap"
-	TypeMsgSwapSend = "swap_send"
+	TypeMsgSwap      = "swap"
+	TypeMsgSwapSend  = "swap_send"
+	TypeMsgSwapRoute = "swap_route"
 )
 
+// MaxSwapRouteLength is the maximum number of hops of a swap route
+const MaxSwapRouteLength = 4
+
 //--------------------------------------------------------
 //--------------------------------------------------------
 
@@ -65,7 +70,7 @@ func (msg MsgSwap) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrRecursiveSwap, msg.AskDenom)
 	}
 
-	return nil
+	return validateSwapLimits(msg.MinAskAmount, msg.MaxSpread)
 }
 
 // NewMsgSwapSend conducts market swap and send all the result coins to recipient
@@ -119,5 +124,79 @@ func (msg MsgSwapSend) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrRecursiveSwap, msg.AskDenom)
 	}
 
+	return validateSwapLimits(msg.MinAskAmount, msg.MaxSpread)
+}
+
+// NewMsgSwapRoute conducts market swaps through the denoms of the route
+func NewMsgSwapRoute(traderAddress sdk.AccAddress, offerCoin sdk.Coin, askDenoms []string) *MsgSwapRoute {
+	return &MsgSwapRoute{
+		Trader:    traderAddress.String(),
+		OfferCoin: offerCoin,
+		AskDenoms: askDenoms,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSwapRoute) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSwapRoute) Type() string { return TypeMsgSwapRoute }
+
+// GetSignBytes Implements Msg
+func (msg MsgSwapRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgSwapRoute) GetSigners() []sdk.AccAddress {
+	trader, err := sdk.AccAddressFromBech32(msg.Trader)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{trader}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgSwapRoute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Trader)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid trader address (%s)", err)
+	}
+
+	if msg.OfferCoin.Amount.LTE(sdk.ZeroInt()) || msg.OfferCoin.Amount.BigInt().BitLen() > 100 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.OfferCoin.String())
+	}
+
+	if len(msg.AskDenoms) == 0 || len(msg.AskDenoms) > MaxSwapRouteLength {
+		return sdkerrors.Wrapf(ErrInvalidSwapRoute, "route must have between 1 and %d denoms", MaxSwapRouteLength)
+	}
+
+	offerDenom := msg.OfferCoin.Denom
+	for _, askDenom := range msg.AskDenoms {
+		if err := sdk.ValidateDenom(askDenom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidSwapRoute, err.Error())
+		}
+
+		if offerDenom == askDenom {
+			return sdkerrors.Wrap(ErrRecursiveSwap, askDenom)
+		}
+
+		offerDenom = askDenom
+	}
+
+	return validateSwapLimits(msg.MinAskAmount, msg.MaxSpread)
+}
+
+// validateSwapLimits validates the optional limits of a swap
+func validateSwapLimits(minAskAmount *sdk.Int, maxSpread *sdk.Dec) error {
+	if minAskAmount != nil && (minAskAmount.IsNegative() || minAskAmount.BigInt().BitLen() > 100) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid min ask amount %s", minAskAmount)
+	}
+
+	if maxSpread != nil && (maxSpread.IsNegative() || maxSpread.GT(sdk.OneDec())) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max spread must be between [0, 1], is %s", maxSpread)
+	}
+
 	return nil
 }
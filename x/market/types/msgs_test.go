@@ -72,3 +72,87 @@ func TestMsgSwapSend(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSwapLimits(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	minAskAmount := sdk.NewInt(10)
+	negativeMinAskAmount := sdk.NewInt(-1)
+	maxSpread := sdk.NewDecWithPrec(1, 2)
+	negativeMaxSpread := sdk.NewDecWithPrec(-1, 2)
+	overMaxSpread := sdk.NewDecWithPrec(11, 1)
+
+	tests := []struct {
+		minAskAmount *sdk.Int
+		maxSpread    *sdk.Dec
+		expectedErr  string
+	}{
+		{nil, nil, ""},
+		{&minAskAmount, &maxSpread, ""},
+		{&negativeMinAskAmount, nil, "invalid min ask amount -1: invalid request"},
+		{nil, &negativeMaxSpread, "max spread must be between [0, 1], is -0.010000000000000000: invalid request"},
+		{nil, &overMaxSpread, "max spread must be between [0, 1], is 1.100000000000000000: invalid request"},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSwap(addr, sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom)
+		msg.MinAskAmount = tc.minAskAmount
+		msg.MaxSpread = tc.maxSpread
+
+		sendMsg := NewMsgSwapSend(addr, addr, sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom)
+		sendMsg.MinAskAmount = tc.minAskAmount
+		sendMsg.MaxSpread = tc.maxSpread
+
+		if tc.expectedErr == "" {
+			require.Nil(t, msg.ValidateBasic())
+			require.Nil(t, sendMsg.ValidateBasic())
+		} else {
+			require.EqualError(t, msg.ValidateBasic(), tc.expectedErr)
+			require.EqualError(t, sendMsg.ValidateBasic(), tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSwapSignBytesWithoutLimits(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	msg := NewMsgSwap(addr, sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom)
+	require.NotContains(t, string(msg.GetSignBytes()), "min_ask_amount")
+	require.NotContains(t, string(msg.GetSignBytes()), "max_spread")
+
+	maxSpread := sdk.NewDecWithPrec(1, 2)
+	msg.MaxSpread = &maxSpread
+	require.Contains(t, string(msg.GetSignBytes()), `"max_spread":"0.010000000000000000"`)
+}
+
+func TestMsgSwapRoute(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	tests := []struct {
+		trader      sdk.AccAddress
+		offerCoin   sdk.Coin
+		askDenoms   []string
+		expectedErr string
+	}{
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroLunaDenom, core.MicroSDRDenom}, ""},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroSDRDenom}, ""},
+		{sdk.AccAddress{}, sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroSDRDenom}, "Invalid trader address (empty address string is not allowed): invalid address"},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.ZeroInt()), []string{core.MicroSDRDenom}, "0ukrw: invalid coins"},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{}, "route must have between 1 and 4 denoms: invalid swap route"},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroLunaDenom, core.MicroSDRDenom, core.MicroLunaDenom, core.MicroUSDDenom, core.MicroLunaDenom}, "route must have between 1 and 4 denoms: invalid swap route"},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroLunaDenom, core.MicroLunaDenom}, "uluna: recursive swap"},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroKRWDenom}, "ukrw: recursive swap"},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{"1"}, "invalid denom: 1: invalid swap route"},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSwapRoute(tc.trader, tc.offerCoin, tc.askDenoms)
+		if tc.expectedErr == "" {
+			require.Nil(t, msg.ValidateBasic())
+		} else {
+			require.EqualError(t, msg.ValidateBasic(), tc.expectedErr)
+		}
+	}
+}
@@ -19,6 +19,12 @@ var (
 	KeyPoolRecoveryPeriod = []byte("PoolRecoveryPeriod")
 	// Min spread
 	KeyMinStabilitySpread = []byte("MinStabilitySpread")
+	// Max age of the oracle exchange rates
+	KeyMaxRateAge = []byte("MaxRateAge")
+	// Terra denoms with their own pool
+	KeyDenomPools = []byte("DenomPools")
+	// Pairs of Terra denoms charging a dynamic fee
+	KeySwapFeePairs = []byte("SwapFeePairs")
 )
 
 // Default parameter values
@@ -26,6 +32,9 @@ var (
 	DefaultBasePool           = sdk.NewDec(1000000 * core.MicroUnit) // 1000,000sdr = 1000,000,000,000usdr
 	DefaultPoolRecoveryPeriod = core.BlocksPerDay                    // 14,400
 	DefaultMinStabilitySpread = sdk.NewDecWithPrec(2, 2)             // 2%
+	DefaultMaxRateAge         = uint64(0)                            // unlimited
+	DefaultDenomPools         = DenomPoolList{}                      // all denoms share the base pool
+	DefaultSwapFeePairs       = SwapFeePairList{}                    // tobin tax only
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -36,6 +45,9 @@ func DefaultParams() Params {
 		BasePool:           DefaultBasePool,
 		PoolRecoveryPeriod: DefaultPoolRecoveryPeriod,
 		MinStabilitySpread: DefaultMinStabilitySpread,
+		MaxRateAge:         DefaultMaxRateAge,
+		DenomPools:         DefaultDenomPools,
+		SwapFeePairs:       DefaultSwapFeePairs,
 	}
 }
 
@@ -57,6 +69,9 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyBasePool, &p.BasePool, validateBasePool),
 		paramstypes.NewParamSetPair(KeyPoolRecoveryPeriod, &p.PoolRecoveryPeriod, validatePoolRecoveryPeriod),
 		paramstypes.NewParamSetPair(KeyMinStabilitySpread, &p.MinStabilitySpread, validateMinStabilitySpread),
+		paramstypes.NewParamSetPair(KeyMaxRateAge, &p.MaxRateAge, validateMaxRateAge),
+		paramstypes.NewParamSetPair(KeyDenomPools, &p.DenomPools, validateDenomPools),
+		paramstypes.NewParamSetPair(KeySwapFeePairs, &p.SwapFeePairs, validateSwapFeePairs),
 	}
 }
 
@@ -72,7 +87,11 @@ func (p Params) Validate() error {
 		return fmt.Errorf("market minimum stability spead should be a value between [0,1], is %s", p.MinStabilitySpread)
 	}
 
-	return nil
+	if err := p.DenomPools.Validate(); err != nil {
+		return err
+	}
+
+	return p.SwapFeePairs.Validate()
 }
 
 func validateBasePool(i interface{}) error {
@@ -117,3 +136,30 @@ func validateMinStabilitySpread(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxRateAge(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDenomPools(i interface{}) error {
+	v, ok := i.(DenomPoolList)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateSwapFeePairs(i interface{}) error {
+	v, ok := i.(SwapFeePairList)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -33,4 +35,80 @@ func TestParamsEqual(t *testing.T) {
 	p5 := DefaultParams()
 	require.NotNil(t, p5.ParamSetPairs())
 	require.NotNil(t, p5.String())
+
+	// valid denom pools
+	p6 := DefaultParams()
+	p6.DenomPools = DenomPoolList{
+		NewDenomPool(core.MicroKRWDenom, sdk.NewDec(1000000), 100),
+		NewDenomPool(core.MicroUSDDenom, sdk.NewDec(2000000), 200),
+	}
+	require.NoError(t, p6.Validate())
+
+	// invalid denom pools
+	for _, pool := range []DenomPool{
+		NewDenomPool(core.MicroLunaDenom, sdk.NewDec(1000000), 100),
+		NewDenomPool("1", sdk.NewDec(1000000), 100),
+		NewDenomPool(core.MicroKRWDenom, sdk.ZeroDec(), 100),
+		NewDenomPool(core.MicroKRWDenom, sdk.NewDec(1000000), 0),
+		NewDenomPool(core.MicroKRWDenom, sdk.Dec{}, 100),
+	} {
+		p7 := DefaultParams()
+		p7.DenomPools = DenomPoolList{pool}
+		require.Error(t, p7.Validate())
+	}
+
+	// duplicated denom pools
+	p8 := DefaultParams()
+	p8.DenomPools = DenomPoolList{
+		NewDenomPool(core.MicroKRWDenom, sdk.NewDec(1000000), 100),
+		NewDenomPool(core.MicroKRWDenom, sdk.NewDec(2000000), 200),
+	}
+	require.Error(t, p8.Validate())
+
+	// valid swap fee pairs
+	p9 := DefaultParams()
+	p9.SwapFeePairs = SwapFeePairList{
+		NewSwapFeePair(core.MicroKRWDenom, core.MicroUSDDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000000), 100),
+		NewSwapFeePair(core.MicroKRWDenom, core.MicroSDRDenom, sdk.ZeroDec(), sdk.NewDec(1000000), 1),
+	}
+	require.NoError(t, p9.Validate())
+
+	// invalid swap fee pairs
+	for _, pair := range []SwapFeePair{
+		NewSwapFeePair(core.MicroLunaDenom, core.MicroUSDDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000000), 100),
+		NewSwapFeePair(core.MicroKRWDenom, core.MicroKRWDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000000), 100),
+		NewSwapFeePair("1", core.MicroUSDDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000000), 100),
+		NewSwapFeePair(core.MicroKRWDenom, core.MicroUSDDenom, sdk.NewDec(2), sdk.NewDec(1000000), 100),
+		NewSwapFeePair(core.MicroKRWDenom, core.MicroUSDDenom, sdk.NewDec(-1), sdk.NewDec(1000000), 100),
+		NewSwapFeePair(core.MicroKRWDenom, core.MicroUSDDenom, sdk.NewDecWithPrec(2, 2), sdk.ZeroDec(), 100),
+		NewSwapFeePair(core.MicroKRWDenom, core.MicroUSDDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000000), 0),
+		NewSwapFeePair(core.MicroKRWDenom, core.MicroUSDDenom, sdk.Dec{}, sdk.NewDec(1000000), 100),
+	} {
+		p10 := DefaultParams()
+		p10.SwapFeePairs = SwapFeePairList{pair}
+		require.Error(t, p10.Validate())
+	}
+
+	// duplicated swap fee pairs, in either order
+	p11 := DefaultParams()
+	p11.SwapFeePairs = SwapFeePairList{
+		NewSwapFeePair(core.MicroKRWDenom, core.MicroUSDDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000000), 100),
+		NewSwapFeePair(core.MicroUSDDenom, core.MicroKRWDenom, sdk.NewDecWithPrec(1, 2), sdk.NewDec(1000000), 100),
+	}
+	require.Error(t, p11.Validate())
+}
+
+func TestSwapFeePairDynamicFee(t *testing.T) {
+	pair := NewSwapFeePair(core.MicroKRWDenom, core.MicroUSDDenom, sdk.NewDecWithPrec(2, 2), sdk.NewDec(1000), 100)
+
+	require.True(t, pair.DynamicFee(sdk.ZeroDec()).IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), pair.DynamicFee(sdk.NewDec(500)))
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), pair.DynamicFee(sdk.NewDec(1000)))
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), pair.DynamicFee(sdk.NewDec(5000)))
+
+	pairs := SwapFeePairList{pair}
+	_, found := pairs.Get(core.MicroUSDDenom, core.MicroKRWDenom)
+	require.True(t, found)
+	_, found = pairs.Get(core.MicroUSDDenom, core.MicroSDRDenom)
+	require.False(t, found)
 }
@@ -11,6 +11,12 @@ const (
 	QueryParameters     = "parameters"
 )
 
+// MaxSwapQuotes is the maximum number of swaps simulated by a swap quote query
+const MaxSwapQuotes = 10
+
+// MaxSwapIndicatorEpochs is the maximum number of epochs returned by a swap indicators query, a year
+const MaxSwapIndicatorEpochs = 52
+
 // QuerySwapParams for query
 // - 'custom/market/swap'
 type QuerySwapParams struct {
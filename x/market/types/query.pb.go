@@ -118,6 +118,154 @@ func (m *QuerySwapResponse) GetReturnCoin() types.Coin {
 	return types.Coin{}
 }
 
+// QuerySwapQuoteRequest is the request type for the Query/SwapQuote RPC method.
+type QuerySwapQuoteRequest struct {
+	// offer_coins defines the coins offered by the swaps in order (i.e. 1000000uluna)
+	OfferCoins []string `protobuf:"bytes,1,rep,name=offer_coins,json=offerCoins,proto3" json:"offer_coins,omitempty"`
+	// ask_denoms defines the denoms to swap to, one per offer coin
+	AskDenoms []string `protobuf:"bytes,2,rep,name=ask_denoms,json=askDenoms,proto3" json:"ask_denoms,omitempty"`
+}
+
+func (m *QuerySwapQuoteRequest) Reset()         { *m = QuerySwapQuoteRequest{} }
+func (m *QuerySwapQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapQuoteRequest) ProtoMessage()    {}
+func (*QuerySwapQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{2}
+}
+func (m *QuerySwapQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapQuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapQuoteRequest.Merge(m, src)
+}
+func (m *QuerySwapQuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapQuoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapQuoteRequest proto.InternalMessageInfo
+
+// QuerySwapQuoteResponse is the response type for the Query/SwapQuote RPC method.
+type QuerySwapQuoteResponse struct {
+	// quotes defines the breakdown of the swaps in order
+	Quotes []SwapQuote `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes"`
+}
+
+func (m *QuerySwapQuoteResponse) Reset()         { *m = QuerySwapQuoteResponse{} }
+func (m *QuerySwapQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapQuoteResponse) ProtoMessage()    {}
+func (*QuerySwapQuoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{3}
+}
+func (m *QuerySwapQuoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapQuoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapQuoteResponse.Merge(m, src)
+}
+func (m *QuerySwapQuoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapQuoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapQuoteResponse proto.InternalMessageInfo
+
+func (m *QuerySwapQuoteResponse) GetQuotes() []SwapQuote {
+	if m != nil {
+		return m.Quotes
+	}
+	return nil
+}
+
+// SwapQuote defines the breakdown of a simulated swap
+type SwapQuote struct {
+	OfferCoin types.Coin `protobuf:"bytes,1,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin"`
+	AskDenom  string     `protobuf:"bytes,2,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty"`
+	// offer_rate and ask_rate define the Luna exchange rates of the offer and ask denoms
+	OfferRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=offer_rate,json=offerRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"offer_rate"`
+	AskRate   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=ask_rate,json=askRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ask_rate"`
+	// base_offer_amount defines the offer coin in usdr
+	BaseOfferAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=base_offer_amount,json=baseOfferAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_offer_amount"`
+	// base_ask_amount defines the usdr returned by the constant product, the base offer amount for Terra swaps
+	BaseAskAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=base_ask_amount,json=baseAskAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_ask_amount"`
+	// constant_product_spread and min_stability_spread define the spreads of Luna swaps, zero for Terra swaps
+	ConstantProductSpread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=constant_product_spread,json=constantProductSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"constant_product_spread"`
+	MinStabilitySpread    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_stability_spread,json=minStabilitySpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_stability_spread"`
+	// tobin_tax and dynamic_fee define the spread of Terra swaps, zero for Luna swaps
+	TobinTax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=tobin_tax,json=tobinTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tobin_tax"`
+	// spread defines the spread charged by the swap
+	Spread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=spread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spread"`
+	// return_coin defines the coin received and fee_coin the fee charged by the spread
+	ReturnCoin types.Coin `protobuf:"bytes,11,opt,name=return_coin,json=returnCoin,proto3" json:"return_coin"`
+	FeeCoin    types.Coin `protobuf:"bytes,12,opt,name=fee_coin,json=feeCoin,proto3" json:"fee_coin"`
+	// terra_pool_delta_before and terra_pool_delta_after define the terra pool delta around the swap,
+	// of the pool of the Terra denom of the swap, the offer denom for Terra swaps
+	TerraPoolDeltaBefore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=terra_pool_delta_before,json=terraPoolDeltaBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"terra_pool_delta_before"`
+	TerraPoolDeltaAfter  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=terra_pool_delta_after,json=terraPoolDeltaAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"terra_pool_delta_after"`
+	DynamicFee           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=dynamic_fee,json=dynamicFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dynamic_fee"`
+}
+
+func (m *SwapQuote) Reset()         { *m = SwapQuote{} }
+func (m *SwapQuote) String() string { return proto.CompactTextString(m) }
+func (*SwapQuote) ProtoMessage()    {}
+func (*SwapQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{4}
+}
+func (m *SwapQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapQuote.Merge(m, src)
+}
+func (m *SwapQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapQuote proto.InternalMessageInfo
+
 // QueryTerraPoolDeltaRequest is the request type for the Query/TerraPoolDelta RPC method.
 type QueryTerraPoolDeltaRequest struct {
 }
@@ -126,7 +274,7 @@ func (m *QueryTerraPoolDeltaRequest) Reset()         { *m = QueryTerraPoolDeltaR
 func (m *QueryTerraPoolDeltaRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTerraPoolDeltaRequest) ProtoMessage()    {}
 func (*QueryTerraPoolDeltaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c172d0f188bf2fb6, []int{2}
+	return fileDescriptor_c172d0f188bf2fb6, []int{5}
 }
 func (m *QueryTerraPoolDeltaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +313,7 @@ func (m *QueryTerraPoolDeltaResponse) Reset()         { *m = QueryTerraPoolDelta
 func (m *QueryTerraPoolDeltaResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTerraPoolDeltaResponse) ProtoMessage()    {}
 func (*QueryTerraPoolDeltaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c172d0f188bf2fb6, []int{3}
+	return fileDescriptor_c172d0f188bf2fb6, []int{6}
 }
 func (m *QueryTerraPoolDeltaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,6 +342,301 @@ func (m *QueryTerraPoolDeltaResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTerraPoolDeltaResponse proto.InternalMessageInfo
 
+// QueryDenomPoolsRequest is the request type for the Query/DenomPools RPC method.
+type QueryDenomPoolsRequest struct {
+}
+
+func (m *QueryDenomPoolsRequest) Reset()         { *m = QueryDenomPoolsRequest{} }
+func (m *QueryDenomPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPoolsRequest) ProtoMessage()    {}
+func (*QueryDenomPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{7}
+}
+func (m *QueryDenomPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPoolsRequest.Merge(m, src)
+}
+func (m *QueryDenomPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPoolsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomPoolsRequest proto.InternalMessageInfo
+
+// QueryDenomPoolsResponse is the response type for the Query/DenomPools RPC method.
+type QueryDenomPoolsResponse struct {
+	// pools defines the depth of the denom pools
+	Pools []PoolDepth `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+}
+
+func (m *QueryDenomPoolsResponse) Reset()         { *m = QueryDenomPoolsResponse{} }
+func (m *QueryDenomPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPoolsResponse) ProtoMessage()    {}
+func (*QueryDenomPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{8}
+}
+func (m *QueryDenomPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPoolsResponse.Merge(m, src)
+}
+func (m *QueryDenomPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomPoolsResponse proto.InternalMessageInfo
+
+func (m *QueryDenomPoolsResponse) GetPools() []PoolDepth {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// QueryDenomPoolRequest is the request type for the Query/DenomPool RPC method.
+type QueryDenomPoolRequest struct {
+	// denom defines the denom of the pool
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomPoolRequest) Reset()         { *m = QueryDenomPoolRequest{} }
+func (m *QueryDenomPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPoolRequest) ProtoMessage()    {}
+func (*QueryDenomPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{9}
+}
+func (m *QueryDenomPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPoolRequest.Merge(m, src)
+}
+func (m *QueryDenomPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomPoolRequest proto.InternalMessageInfo
+
+// QueryDenomPoolResponse is the response type for the Query/DenomPool RPC method.
+type QueryDenomPoolResponse struct {
+	// pool defines the depth of the pool of the denom
+	Pool PoolDepth `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
+}
+
+func (m *QueryDenomPoolResponse) Reset()         { *m = QueryDenomPoolResponse{} }
+func (m *QueryDenomPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPoolResponse) ProtoMessage()    {}
+func (*QueryDenomPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{10}
+}
+func (m *QueryDenomPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPoolResponse.Merge(m, src)
+}
+func (m *QueryDenomPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomPoolResponse proto.InternalMessageInfo
+
+func (m *QueryDenomPoolResponse) GetPool() PoolDepth {
+	if m != nil {
+		return m.Pool
+	}
+	return PoolDepth{}
+}
+
+// PoolDepth defines the current depth of a denom pool, in usdr unit
+type PoolDepth struct {
+	Denom          string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	BasePool       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_pool,json=basePool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_pool"`
+	TerraPoolDelta github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=terra_pool_delta,json=terraPoolDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"terra_pool_delta"`
+	TerraPool      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=terra_pool,json=terraPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"terra_pool"`
+	LunaPool       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=luna_pool,json=lunaPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"luna_pool"`
+}
+
+func (m *PoolDepth) Reset()         { *m = PoolDepth{} }
+func (m *PoolDepth) String() string { return proto.CompactTextString(m) }
+func (*PoolDepth) ProtoMessage()    {}
+func (*PoolDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{11}
+}
+func (m *PoolDepth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDepth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDepth.Merge(m, src)
+}
+func (m *PoolDepth) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDepth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDepth proto.InternalMessageInfo
+
+// QuerySwapIndicatorsRequest is the request type for the Query/SwapIndicators RPC method.
+type QuerySwapIndicatorsRequest struct {
+	// epochs defines the number of epochs to return back from the current one, only the current one when zero
+	Epochs uint64 `protobuf:"varint,1,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *QuerySwapIndicatorsRequest) Reset()         { *m = QuerySwapIndicatorsRequest{} }
+func (m *QuerySwapIndicatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapIndicatorsRequest) ProtoMessage()    {}
+func (*QuerySwapIndicatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{12}
+}
+func (m *QuerySwapIndicatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapIndicatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapIndicatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapIndicatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapIndicatorsRequest.Merge(m, src)
+}
+func (m *QuerySwapIndicatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapIndicatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapIndicatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapIndicatorsRequest proto.InternalMessageInfo
+
+// QuerySwapIndicatorsResponse is the response type for the Query/SwapIndicators RPC method.
+type QuerySwapIndicatorsResponse struct {
+	// indicators defines the swap activity of each epoch, the current one first
+	Indicators []EpochSwapIndicators `protobuf:"bytes,1,rep,name=indicators,proto3" json:"indicators"`
+}
+
+func (m *QuerySwapIndicatorsResponse) Reset()         { *m = QuerySwapIndicatorsResponse{} }
+func (m *QuerySwapIndicatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapIndicatorsResponse) ProtoMessage()    {}
+func (*QuerySwapIndicatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c172d0f188bf2fb6, []int{13}
+}
+func (m *QuerySwapIndicatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapIndicatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapIndicatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapIndicatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapIndicatorsResponse.Merge(m, src)
+}
+func (m *QuerySwapIndicatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapIndicatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapIndicatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapIndicatorsResponse proto.InternalMessageInfo
+
+func (m *QuerySwapIndicatorsResponse) GetIndicators() []EpochSwapIndicators {
+	if m != nil {
+		return m.Indicators
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -202,7 +645,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c172d0f188bf2fb6, []int{4}
+	return fileDescriptor_c172d0f188bf2fb6, []int{14}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +684,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c172d0f188bf2fb6, []int{5}
+	return fileDescriptor_c172d0f188bf2fb6, []int{15}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,8 +723,18 @@ func (m *QueryParamsResponse) GetParams() Params {
 func init() {
 	proto.RegisterType((*QuerySwapRequest)(nil), "terra.market.v1beta1.QuerySwapRequest")
 	proto.RegisterType((*QuerySwapResponse)(nil), "terra.market.v1beta1.QuerySwapResponse")
+	proto.RegisterType((*QuerySwapQuoteRequest)(nil), "terra.market.v1beta1.QuerySwapQuoteRequest")
+	proto.RegisterType((*QuerySwapQuoteResponse)(nil), "terra.market.v1beta1.QuerySwapQuoteResponse")
+	proto.RegisterType((*SwapQuote)(nil), "terra.market.v1beta1.SwapQuote")
 	proto.RegisterType((*QueryTerraPoolDeltaRequest)(nil), "terra.market.v1beta1.QueryTerraPoolDeltaRequest")
 	proto.RegisterType((*QueryTerraPoolDeltaResponse)(nil), "terra.market.v1beta1.QueryTerraPoolDeltaResponse")
+	proto.RegisterType((*QueryDenomPoolsRequest)(nil), "terra.market.v1beta1.QueryDenomPoolsRequest")
+	proto.RegisterType((*QueryDenomPoolsResponse)(nil), "terra.market.v1beta1.QueryDenomPoolsResponse")
+	proto.RegisterType((*QueryDenomPoolRequest)(nil), "terra.market.v1beta1.QueryDenomPoolRequest")
+	proto.RegisterType((*QueryDenomPoolResponse)(nil), "terra.market.v1beta1.QueryDenomPoolResponse")
+	proto.RegisterType((*PoolDepth)(nil), "terra.market.v1beta1.PoolDepth")
+	proto.RegisterType((*QuerySwapIndicatorsRequest)(nil), "terra.market.v1beta1.QuerySwapIndicatorsRequest")
+	proto.RegisterType((*QuerySwapIndicatorsResponse)(nil), "terra.market.v1beta1.QuerySwapIndicatorsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "terra.market.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "terra.market.v1beta1.QueryParamsResponse")
 }
@@ -289,41 +742,81 @@ func init() {
 func init() { proto.RegisterFile("terra/market/v1beta1/query.proto", fileDescriptor_c172d0f188bf2fb6) }
 
 var fileDescriptor_c172d0f188bf2fb6 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xb5, 0xfb, 0xf5, 0x8b, 0x9a, 0x29, 0xaa, 0xca, 0x90, 0x45, 0x71, 0x83, 0x53, 0x2c, 0x14,
-	0x02, 0x52, 0x67, 0x48, 0xd9, 0x75, 0x85, 0x42, 0x1e, 0xa0, 0x0d, 0x20, 0x55, 0x6c, 0xa2, 0x49,
-	0x32, 0x35, 0x56, 0x62, 0x5f, 0x77, 0x66, 0x42, 0x89, 0xd8, 0xc1, 0x86, 0x25, 0x12, 0x2f, 0xd0,
-	0x0d, 0x2f, 0xc0, 0x53, 0x74, 0x59, 0x89, 0x0d, 0x62, 0x51, 0xa1, 0x84, 0x05, 0x8f, 0x81, 0xe6,
-	0x27, 0xd0, 0x54, 0x56, 0x81, 0x55, 0xe2, 0x7b, 0xcf, 0x3d, 0xe7, 0xf8, 0xdc, 0x6b, 0xb4, 0xa5,
-	0xb8, 0x10, 0x8c, 0xa6, 0x4c, 0x0c, 0xb9, 0xa2, 0x2f, 0x9b, 0x3d, 0xae, 0x58, 0x93, 0x1e, 0x8d,
-	0xb9, 0x98, 0x90, 0x5c, 0x80, 0x02, 0x5c, 0x31, 0x08, 0x62, 0x11, 0xc4, 0x21, 0x82, 0x4a, 0x0c,
-	0x31, 0x18, 0x00, 0xd5, 0xff, 0x2c, 0x36, 0xa8, 0xc6, 0x00, 0xf1, 0x88, 0x53, 0x96, 0x27, 0x94,
-	0x65, 0x19, 0x28, 0xa6, 0x12, 0xc8, 0xa4, 0xeb, 0xde, 0x2e, 0xd4, 0x72, 0xc4, 0x16, 0x12, 0xf6,
-	0x41, 0xa6, 0x20, 0x69, 0x8f, 0x49, 0xfe, 0x0b, 0xd1, 0x87, 0x24, 0xb3, 0xfd, 0xe8, 0x00, 0xad,
-	0xef, 0x6b, 0x6f, 0x4f, 0x8e, 0x59, 0xde, 0xe1, 0x47, 0x63, 0x2e, 0x15, 0xbe, 0x85, 0x10, 0x1c,
-	0x1e, 0x72, 0xd1, 0xd5, 0xb8, 0x0d, 0x7f, 0xcb, 0x6f, 0x94, 0x3b, 0x65, 0x53, 0x79, 0x0c, 0x49,
-	0x86, 0x37, 0x51, 0x99, 0xc9, 0x61, 0x77, 0xc0, 0x33, 0x48, 0x37, 0x96, 0x4c, 0x77, 0x85, 0xc9,
-	0x61, 0x5b, 0x3f, 0xef, 0xae, 0xbc, 0x3b, 0xa9, 0x79, 0x3f, 0x4e, 0x6a, 0x5e, 0xf4, 0x0c, 0x5d,
-	0xbf, 0xc0, 0x2c, 0x73, 0xc8, 0x24, 0xc7, 0x8f, 0xd0, 0xaa, 0xe0, 0x6a, 0x2c, 0xb2, 0xdf, 0xdc,
-	0xab, 0x3b, 0x37, 0x89, 0x35, 0x49, 0xb4, 0xc9, 0x79, 0x20, 0x44, 0x6b, 0xb5, 0x96, 0x4f, 0xcf,
-	0x6b, 0x5e, 0x07, 0xd9, 0x19, 0x5d, 0x89, 0xaa, 0x28, 0x30, 0xb4, 0x4f, 0xf5, 0xab, 0xef, 0x01,
-	0x8c, 0xda, 0x7c, 0xa4, 0x98, 0xb3, 0x1e, 0x1d, 0xa3, 0xcd, 0xc2, 0xae, 0x93, 0x3f, 0x40, 0xeb,
-	0x26, 0xb2, 0x6e, 0x0e, 0x30, 0xea, 0x0e, 0x74, 0xcf, 0x78, 0xb8, 0xd6, 0x22, 0x5a, 0xe8, 0xeb,
-	0x79, 0xad, 0x1e, 0x27, 0xea, 0xc5, 0xb8, 0x47, 0xfa, 0x90, 0x52, 0x17, 0x9d, 0xfd, 0xd9, 0x96,
-	0x83, 0x21, 0x55, 0x93, 0x9c, 0x4b, 0xd2, 0xe6, 0xfd, 0xce, 0x9a, 0x5a, 0x50, 0x88, 0x2a, 0x08,
-	0x1b, 0xe1, 0x3d, 0x26, 0x58, 0x2a, 0xe7, 0x76, 0xf6, 0xd1, 0x8d, 0x85, 0xaa, 0xb3, 0xb1, 0x8b,
-	0x4a, 0xb9, 0xa9, 0xb8, 0x00, 0xaa, 0xa4, 0xe8, 0x24, 0x88, 0x9d, 0x72, 0x19, 0xb8, 0x89, 0x9d,
-	0x4f, 0xff, 0xa1, 0xff, 0x0d, 0x27, 0x7e, 0x8d, 0x96, 0x75, 0xb6, 0xb8, 0x5e, 0x3c, 0x7d, 0x79,
-	0xad, 0xc1, 0xdd, 0x3f, 0xe2, 0xac, 0xbd, 0x28, 0x7a, 0xf3, 0xf9, 0xfb, 0x87, 0xa5, 0x2a, 0x0e,
-	0x68, 0xe1, 0x7d, 0x49, 0x2d, 0xfa, 0xd1, 0x47, 0x6b, 0x8b, 0x21, 0xe3, 0x07, 0x57, 0xf0, 0x17,
-	0x6e, 0x2b, 0x68, 0xfe, 0xc3, 0x84, 0xf3, 0x46, 0x8c, 0xb7, 0x06, 0xae, 0x17, 0x7b, 0xbb, 0xbc,
-	0x5d, 0xfc, 0xd6, 0x47, 0x25, 0x9b, 0x23, 0x6e, 0x5c, 0xa1, 0xb6, 0xb0, 0xb6, 0xe0, 0xde, 0x5f,
-	0x20, 0x9d, 0x9f, 0x3b, 0xc6, 0x4f, 0x88, 0xab, 0xc5, 0x7e, 0xec, 0xd2, 0x5a, 0xed, 0xd3, 0x69,
-	0xe8, 0x9f, 0x4d, 0x43, 0xff, 0xdb, 0x34, 0xf4, 0xdf, 0xcf, 0x42, 0xef, 0x6c, 0x16, 0x7a, 0x5f,
-	0x66, 0xa1, 0xf7, 0xfc, 0xfe, 0x85, 0x7b, 0x33, 0x0c, 0xdb, 0x29, 0x64, 0x7c, 0x42, 0xfb, 0x20,
-	0x38, 0x7d, 0x35, 0xa7, 0x33, 0x77, 0xd7, 0x2b, 0x99, 0x4f, 0xf6, 0xe1, 0xcf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xd8, 0x99, 0x62, 0x65, 0x63, 0x04, 0x00, 0x00,
+	// 1174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0xbd, 0x69, 0xe2, 0x78, 0x1f, 0xb7, 0x69, 0x3b, 0xcd, 0x9f, 0xfd, 0x39, 0xf9, 0xd9,
+	0xe9, 0x02, 0xa9, 0x43, 0x9b, 0x5d, 0x12, 0x0e, 0x88, 0x20, 0x2a, 0x12, 0x42, 0xa5, 0x0a, 0xa1,
+	0x24, 0x4e, 0x29, 0x55, 0x2f, 0xcb, 0xd8, 0x1e, 0x27, 0x2b, 0xdb, 0x3b, 0x9b, 0x9d, 0x31, 0x4d,
+	0x54, 0x71, 0x81, 0x0b, 0xc7, 0x4a, 0x70, 0xe1, 0x44, 0x0f, 0xf0, 0x26, 0x78, 0x05, 0x3d, 0x56,
+	0xe2, 0x82, 0x38, 0x54, 0x28, 0xe1, 0xc0, 0x89, 0xd7, 0x80, 0x66, 0x76, 0x76, 0xfd, 0x27, 0x5b,
+	0xc7, 0x59, 0x71, 0x4a, 0x76, 0xe6, 0x79, 0x3e, 0xcf, 0x77, 0xfe, 0x7d, 0x67, 0x0c, 0x8b, 0x9c,
+	0x04, 0x01, 0xb6, 0xdb, 0x38, 0x68, 0x12, 0x6e, 0x7f, 0xb5, 0x5a, 0x25, 0x1c, 0xaf, 0xda, 0x87,
+	0x1d, 0x12, 0x1c, 0x5b, 0x7e, 0x40, 0x39, 0x45, 0xd3, 0x32, 0xc2, 0x0a, 0x23, 0x2c, 0x15, 0x51,
+	0x98, 0xde, 0xa7, 0xfb, 0x54, 0x06, 0xd8, 0xe2, 0xbf, 0x30, 0xb6, 0xb0, 0xb0, 0x4f, 0xe9, 0x7e,
+	0x8b, 0xd8, 0xd8, 0x77, 0x6d, 0xec, 0x79, 0x94, 0x63, 0xee, 0x52, 0x8f, 0xa9, 0xde, 0x9b, 0x89,
+	0xb5, 0x14, 0x38, 0x0c, 0x29, 0xd6, 0x28, 0x6b, 0x53, 0x66, 0x57, 0x31, 0x23, 0x71, 0x44, 0x8d,
+	0xba, 0x5e, 0xd8, 0x6f, 0x3e, 0x82, 0x6b, 0xbb, 0x42, 0xdb, 0xde, 0x13, 0xec, 0x57, 0xc8, 0x61,
+	0x87, 0x30, 0x8e, 0xfe, 0x0f, 0x40, 0x1b, 0x0d, 0x12, 0x38, 0x22, 0xce, 0xd0, 0x16, 0xb5, 0xb2,
+	0x5e, 0xd1, 0x65, 0xcb, 0xc7, 0xd4, 0xf5, 0xd0, 0x3c, 0xe8, 0x98, 0x35, 0x9d, 0x3a, 0xf1, 0x68,
+	0xdb, 0x18, 0x93, 0xbd, 0x39, 0xcc, 0x9a, 0x5b, 0xe2, 0x7b, 0x3d, 0xf7, 0xdd, 0xf3, 0x52, 0xe6,
+	0xef, 0xe7, 0xa5, 0x8c, 0xf9, 0x39, 0x5c, 0xef, 0x21, 0x33, 0x9f, 0x7a, 0x8c, 0xa0, 0x8f, 0x20,
+	0x1f, 0x10, 0xde, 0x09, 0xbc, 0x2e, 0x3b, 0xbf, 0xf6, 0x3f, 0x2b, 0x14, 0x69, 0x09, 0x91, 0xd1,
+	0x84, 0x58, 0xa2, 0xd6, 0xe6, 0xf8, 0x8b, 0x57, 0xa5, 0x4c, 0x05, 0xc2, 0x1c, 0xd1, 0x62, 0x62,
+	0x98, 0x89, 0xb1, 0xbb, 0x1d, 0xca, 0x49, 0xa4, 0xba, 0x04, 0xf9, 0xae, 0x6a, 0x66, 0x68, 0x8b,
+	0x97, 0xca, 0x7a, 0x05, 0x62, 0xd9, 0x4c, 0x0c, 0x2b, 0xd6, 0xcd, 0x8c, 0x31, 0xd9, 0xaf, 0x47,
+	0xc2, 0x59, 0x8f, 0xf2, 0x2f, 0x60, 0x76, 0xb0, 0x84, 0x92, 0xff, 0x21, 0x64, 0x0f, 0x45, 0x43,
+	0x88, 0xcf, 0xaf, 0x95, 0xac, 0xa4, 0xb5, 0xb4, 0xe2, 0x44, 0xa5, 0x5f, 0x25, 0x99, 0xbf, 0xea,
+	0xa0, 0xc7, 0x7d, 0xe8, 0xee, 0x99, 0x69, 0x1e, 0x61, 0x2a, 0x46, 0x5c, 0x07, 0xf4, 0x59, 0x04,
+	0x0f, 0x30, 0x27, 0xc6, 0xa5, 0x45, 0xad, 0x7c, 0x79, 0xd3, 0x12, 0x84, 0x3f, 0x5e, 0x95, 0x96,
+	0xf6, 0x5d, 0x7e, 0xd0, 0xa9, 0x5a, 0x35, 0xda, 0xb6, 0xd5, 0xf6, 0x08, 0xff, 0xac, 0xb0, 0x7a,
+	0xd3, 0xe6, 0xc7, 0x3e, 0x61, 0xd6, 0x16, 0xa9, 0xa9, 0x5a, 0x15, 0xcc, 0x09, 0xba, 0x0f, 0x02,
+	0x1d, 0xc2, 0xc6, 0x53, 0xc1, 0x26, 0x31, 0x6b, 0x4a, 0xd4, 0x63, 0xb8, 0x2e, 0x06, 0xe7, 0x84,
+	0xf2, 0x70, 0x9b, 0x76, 0x3c, 0x6e, 0x4c, 0xa4, 0x62, 0x5e, 0x15, 0xa0, 0x6d, 0xc1, 0xd9, 0x90,
+	0x18, 0xf4, 0x10, 0x64, 0x93, 0x23, 0xb4, 0x2a, 0x72, 0x36, 0x15, 0xf9, 0x8a, 0xc0, 0x6c, 0xb0,
+	0xa6, 0xe2, 0x36, 0x60, 0xae, 0x46, 0x3d, 0xc6, 0xb1, 0xc7, 0x1d, 0x3f, 0xa0, 0xf5, 0x4e, 0x8d,
+	0x3b, 0xcc, 0x0f, 0x08, 0xae, 0x1b, 0x93, 0xa9, 0xf8, 0x33, 0x11, 0x6e, 0x27, 0xa4, 0xed, 0x49,
+	0x18, 0xfa, 0x12, 0xa6, 0xdb, 0xae, 0xe7, 0x30, 0x8e, 0xab, 0x6e, 0xcb, 0xe5, 0xc7, 0x51, 0x91,
+	0x5c, 0xaa, 0x22, 0xa8, 0xed, 0x7a, 0x7b, 0x11, 0x4a, 0x55, 0xf8, 0x14, 0x74, 0x4e, 0xab, 0xae,
+	0xe7, 0x70, 0x7c, 0x64, 0xe8, 0xa9, 0xb0, 0x39, 0x09, 0x78, 0x80, 0x8f, 0xd0, 0x3d, 0xc8, 0x2a,
+	0x81, 0x90, 0x8a, 0xa4, 0xb2, 0x07, 0x5d, 0x21, 0x7f, 0x61, 0x57, 0x40, 0xeb, 0x90, 0x6b, 0x10,
+	0x12, 0xa6, 0x5f, 0x1e, 0x2d, 0x7d, 0xb2, 0x41, 0x88, 0xcc, 0x25, 0x30, 0x27, 0x4f, 0xb1, 0xe3,
+	0x53, 0xda, 0x72, 0xea, 0xa4, 0xc5, 0xb1, 0x53, 0x25, 0x0d, 0x1a, 0x10, 0xe3, 0x4a, 0xaa, 0x61,
+	0x85, 0x06, 0xbf, 0x43, 0x69, 0x6b, 0x4b, 0xc0, 0x36, 0x25, 0x0b, 0xd5, 0x60, 0xf6, 0x4c, 0x19,
+	0xdc, 0xe0, 0x24, 0x30, 0xa6, 0x52, 0x55, 0xb9, 0xd1, 0x5f, 0x65, 0x43, 0xa0, 0xd0, 0x36, 0xe4,
+	0xeb, 0xc7, 0x1e, 0x6e, 0xbb, 0x35, 0xa7, 0x41, 0x88, 0x71, 0x35, 0x15, 0x19, 0x14, 0xe2, 0x1e,
+	0x21, 0x3d, 0xae, 0xb8, 0x00, 0x05, 0xe9, 0x8a, 0x0f, 0xfa, 0xca, 0x2a, 0xf7, 0x35, 0x9f, 0xc0,
+	0x7c, 0x62, 0xaf, 0x32, 0xce, 0x47, 0x70, 0x6d, 0x70, 0xf0, 0x86, 0x96, 0x4a, 0xdc, 0x54, 0xff,
+	0xb0, 0x4d, 0x43, 0x99, 0xb5, 0xb4, 0x3d, 0xd1, 0xcc, 0x22, 0x49, 0x0f, 0x61, 0xee, 0x4c, 0x8f,
+	0x92, 0xf3, 0x01, 0x4c, 0x08, 0x21, 0xe7, 0xd8, 0x78, 0x58, 0xc4, 0xe7, 0x07, 0x6a, 0xc7, 0x84,
+	0x39, 0xe6, 0x7b, 0x30, 0xd3, 0xcf, 0x8d, 0x6e, 0xa0, 0x69, 0x98, 0x08, 0xcd, 0x38, 0xbc, 0x32,
+	0xc3, 0x8f, 0x9e, 0x19, 0xdc, 0x1b, 0x94, 0x1a, 0xeb, 0x79, 0x1f, 0xc6, 0x05, 0x5b, 0x5d, 0x02,
+	0x23, 0xca, 0x91, 0x29, 0xe6, 0x3f, 0x63, 0xa0, 0xc7, 0x3d, 0xc9, 0x12, 0xc4, 0xa1, 0x97, 0xb6,
+	0x28, 0x6b, 0x8c, 0xa5, 0x3b, 0xf4, 0x02, 0x20, 0xea, 0x24, 0x2e, 0xe5, 0xa5, 0xff, 0x62, 0x29,
+	0xc5, 0x9d, 0xd5, 0x25, 0xa7, 0xbc, 0x66, 0xf4, 0x98, 0x29, 0x46, 0xdd, 0xea, 0x78, 0x8a, 0x96,
+	0xee, 0x82, 0xc9, 0x09, 0x80, 0x80, 0xf5, 0xac, 0xe2, 0x5d, 0x75, 0x0e, 0xc4, 0x45, 0x7e, 0xdf,
+	0xab, 0xbb, 0x35, 0xcc, 0x69, 0x10, 0x6d, 0x3a, 0x34, 0x0b, 0x59, 0xe2, 0xd3, 0xda, 0x01, 0x93,
+	0x2b, 0x30, 0x5e, 0x51, 0x5f, 0x3d, 0xf9, 0x1e, 0xcc, 0x27, 0xe6, 0xab, 0xad, 0xb0, 0x0d, 0xe0,
+	0xc6, 0xad, 0x6a, 0x7f, 0x2e, 0x27, 0x6f, 0x88, 0x4f, 0x04, 0xba, 0x1f, 0x13, 0x59, 0x63, 0x17,
+	0x61, 0x4e, 0x03, 0x92, 0xf5, 0x76, 0x70, 0x80, 0xdb, 0xf1, 0xe1, 0xd8, 0x85, 0x1b, 0x7d, 0xad,
+	0xaa, 0xfa, 0x3a, 0x64, 0x7d, 0xd9, 0xa2, 0xb6, 0xe2, 0xc2, 0x6b, 0xb6, 0xa2, 0x8c, 0x89, 0x5e,
+	0x37, 0x61, 0xc6, 0xda, 0x4f, 0x39, 0x98, 0x90, 0x4c, 0xf4, 0x14, 0xc6, 0x85, 0x2c, 0xb4, 0x94,
+	0x9c, 0x3d, 0xf8, 0xe0, 0x2c, 0xdc, 0x3a, 0x37, 0x2e, 0x94, 0x67, 0x9a, 0xdf, 0xfc, 0xf6, 0xd7,
+	0xf7, 0x63, 0x0b, 0xa8, 0x60, 0x27, 0xbe, 0x7c, 0x99, 0x28, 0xfa, 0x4c, 0xeb, 0x7d, 0x64, 0xdd,
+	0x3e, 0x07, 0xdd, 0xfb, 0x84, 0x2c, 0xdc, 0x19, 0x2d, 0x58, 0x89, 0x29, 0x4b, 0x31, 0x26, 0x5a,
+	0x7c, 0xbd, 0x18, 0x47, 0x3e, 0xfc, 0xd0, 0x2f, 0x1a, 0x4c, 0xf5, 0x1b, 0x23, 0x7a, 0x67, 0x48,
+	0xa9, 0x44, 0x87, 0x2d, 0xac, 0x5e, 0x20, 0x43, 0x29, 0xb4, 0xa4, 0xc2, 0x32, 0x5a, 0x4a, 0x56,
+	0x38, 0x78, 0x8c, 0xd1, 0x0f, 0x1a, 0x40, 0xd7, 0x2d, 0xd1, 0xb0, 0xe9, 0x38, 0x63, 0xb7, 0x85,
+	0x95, 0x11, 0xa3, 0x95, 0xb6, 0x65, 0xa9, 0xed, 0x0d, 0x74, 0x33, 0x59, 0x9b, 0x34, 0x2e, 0xa9,
+	0x8d, 0xa1, 0x1f, 0x35, 0xd0, 0x63, 0xc2, 0xd0, 0x15, 0x1d, 0xb4, 0xe4, 0xc2, 0x9d, 0xd1, 0x82,
+	0x95, 0xa6, 0x55, 0xa9, 0xe9, 0x36, 0x5a, 0x3e, 0x57, 0x93, 0xfd, 0x54, 0x7e, 0x7c, 0x8d, 0x7e,
+	0xd6, 0x60, 0xaa, 0xff, 0x08, 0x0e, 0x5d, 0xda, 0x44, 0xd3, 0x28, 0xac, 0x5e, 0x20, 0x43, 0x49,
+	0x5d, 0x91, 0x52, 0x6f, 0xa1, 0xb7, 0x86, 0x6c, 0xbe, 0xae, 0x09, 0xa0, 0x6f, 0x35, 0xc8, 0x86,
+	0x87, 0x16, 0x95, 0x87, 0x14, 0xeb, 0xf3, 0x88, 0xc2, 0xf2, 0x08, 0x91, 0x4a, 0xce, 0x9b, 0x52,
+	0x4e, 0x11, 0x2d, 0x24, 0xcb, 0x09, 0x1d, 0x62, 0x73, 0xeb, 0xc5, 0x49, 0x51, 0x7b, 0x79, 0x52,
+	0xd4, 0xfe, 0x3c, 0x29, 0x6a, 0xcf, 0x4e, 0x8b, 0x99, 0x97, 0xa7, 0xc5, 0xcc, 0xef, 0xa7, 0xc5,
+	0xcc, 0xe3, 0xb7, 0x7b, 0x0c, 0x59, 0x12, 0x56, 0xda, 0xd4, 0x23, 0xc7, 0x76, 0x8d, 0x06, 0xc4,
+	0x3e, 0x8a, 0x70, 0xd2, 0x98, 0xab, 0x59, 0xf9, 0xcb, 0xf5, 0xdd, 0x7f, 0x07, 0x00, 0x94, 0xe4,
+	0xab, 0xa7, 0x6a, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,8 +833,16 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Swap returns simulated swap amount.
 	Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error)
+	// SwapQuote returns the breakdown of simulated swaps, each one applied to the pool of the next.
+	SwapQuote(ctx context.Context, in *QuerySwapQuoteRequest, opts ...grpc.CallOption) (*QuerySwapQuoteResponse, error)
 	// TerraPoolDelta returns terra_pool_delta amount.
 	TerraPoolDelta(ctx context.Context, in *QueryTerraPoolDeltaRequest, opts ...grpc.CallOption) (*QueryTerraPoolDeltaResponse, error)
+	// DenomPools returns the depth of the denom pools.
+	DenomPools(ctx context.Context, in *QueryDenomPoolsRequest, opts ...grpc.CallOption) (*QueryDenomPoolsResponse, error)
+	// DenomPool returns the depth of the pool of the denom.
+	DenomPool(ctx context.Context, in *QueryDenomPoolRequest, opts ...grpc.CallOption) (*QueryDenomPoolResponse, error)
+	// SwapIndicators returns the swap activity of the market in the recent epochs.
+	SwapIndicators(ctx context.Context, in *QuerySwapIndicatorsRequest, opts ...grpc.CallOption) (*QuerySwapIndicatorsResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -363,6 +864,15 @@ func (c *queryClient) Swap(ctx context.Context, in *QuerySwapRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) SwapQuote(ctx context.Context, in *QuerySwapQuoteRequest, opts ...grpc.CallOption) (*QuerySwapQuoteResponse, error) {
+	out := new(QuerySwapQuoteResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/SwapQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TerraPoolDelta(ctx context.Context, in *QueryTerraPoolDeltaRequest, opts ...grpc.CallOption) (*QueryTerraPoolDeltaResponse, error) {
 	out := new(QueryTerraPoolDeltaResponse)
 	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/TerraPoolDelta", in, out, opts...)
@@ -372,35 +882,82 @@ func (c *queryClient) TerraPoolDelta(ctx context.Context, in *QueryTerraPoolDelt
 	return out, nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/Params", in, out, opts...)
+func (c *queryClient) DenomPools(ctx context.Context, in *QueryDenomPoolsRequest, opts ...grpc.CallOption) (*QueryDenomPoolsResponse, error) {
+	out := new(QueryDenomPoolsResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/DenomPools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Swap returns simulated swap amount.
-	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
-	// TerraPoolDelta returns terra_pool_delta amount.
-	TerraPoolDelta(context.Context, *QueryTerraPoolDeltaRequest) (*QueryTerraPoolDeltaResponse, error)
-	// Params queries all parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (c *queryClient) DenomPool(ctx context.Context, in *QueryDenomPoolRequest, opts ...grpc.CallOption) (*QueryDenomPoolResponse, error) {
+	out := new(QueryDenomPoolResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/DenomPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (c *queryClient) SwapIndicators(ctx context.Context, in *QuerySwapIndicatorsRequest, opts ...grpc.CallOption) (*QuerySwapIndicatorsResponse, error) {
+	out := new(QuerySwapIndicatorsResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/SwapIndicators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Swap returns simulated swap amount.
+	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
+	// SwapQuote returns the breakdown of simulated swaps, each one applied to the pool of the next.
+	SwapQuote(context.Context, *QuerySwapQuoteRequest) (*QuerySwapQuoteResponse, error)
+	// TerraPoolDelta returns terra_pool_delta amount.
+	TerraPoolDelta(context.Context, *QueryTerraPoolDeltaRequest) (*QueryTerraPoolDeltaResponse, error)
+	// DenomPools returns the depth of the denom pools.
+	DenomPools(context.Context, *QueryDenomPoolsRequest) (*QueryDenomPoolsResponse, error)
+	// DenomPool returns the depth of the pool of the denom.
+	DenomPool(context.Context, *QueryDenomPoolRequest) (*QueryDenomPoolResponse, error)
+	// SwapIndicators returns the swap activity of the market in the recent epochs.
+	SwapIndicators(context.Context, *QuerySwapIndicatorsRequest) (*QuerySwapIndicatorsResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
 func (*UnimplementedQueryServer) Swap(ctx context.Context, req *QuerySwapRequest) (*QuerySwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
 }
+func (*UnimplementedQueryServer) SwapQuote(ctx context.Context, req *QuerySwapQuoteRequest) (*QuerySwapQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapQuote not implemented")
+}
 func (*UnimplementedQueryServer) TerraPoolDelta(ctx context.Context, req *QueryTerraPoolDeltaRequest) (*QueryTerraPoolDeltaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerraPoolDelta not implemented")
 }
+func (*UnimplementedQueryServer) DenomPools(ctx context.Context, req *QueryDenomPoolsRequest) (*QueryDenomPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomPools not implemented")
+}
+func (*UnimplementedQueryServer) DenomPool(ctx context.Context, req *QueryDenomPoolRequest) (*QueryDenomPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomPool not implemented")
+}
+func (*UnimplementedQueryServer) SwapIndicators(ctx context.Context, req *QuerySwapIndicatorsRequest) (*QuerySwapIndicatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapIndicators not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -427,6 +984,24 @@ func _Query_Swap_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SwapQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/SwapQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapQuote(ctx, req.(*QuerySwapQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TerraPoolDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTerraPoolDeltaRequest)
 	if err := dec(in); err != nil {
@@ -445,6 +1020,60 @@ func _Query_TerraPoolDelta_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/DenomPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomPools(ctx, req.(*QueryDenomPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/DenomPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomPool(ctx, req.(*QueryDenomPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapIndicators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapIndicatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapIndicators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Query/SwapIndicators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapIndicators(ctx, req.(*QuerySwapIndicatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -471,10 +1100,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Swap",
 			Handler:    _Query_Swap_Handler,
 		},
+		{
+			MethodName: "SwapQuote",
+			Handler:    _Query_SwapQuote_Handler,
+		},
 		{
 			MethodName: "TerraPoolDelta",
 			Handler:    _Query_TerraPoolDelta_Handler,
 		},
+		{
+			MethodName: "DenomPools",
+			Handler:    _Query_DenomPools_Handler,
+		},
+		{
+			MethodName: "DenomPool",
+			Handler:    _Query_DenomPool_Handler,
+		},
+		{
+			MethodName: "SwapIndicators",
+			Handler:    _Query_SwapIndicators_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -554,7 +1199,7 @@ func (m *QuerySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTerraPoolDeltaRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySwapQuoteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -564,20 +1209,75 @@ func (m *QueryTerraPoolDeltaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTerraPoolDeltaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySwapQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTerraPoolDeltaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySwapQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AskDenoms) > 0 {
+		for iNdEx := len(m.AskDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AskDenoms[iNdEx])
+			copy(dAtA[i:], m.AskDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AskDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OfferCoins) > 0 {
+		for iNdEx := len(m.OfferCoins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OfferCoins[iNdEx])
+			copy(dAtA[i:], m.OfferCoins[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.OfferCoins[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Quotes) > 0 {
+		for iNdEx := len(m.Quotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTerraPoolDeltaResponse) Marshal() (dAtA []byte, err error) {
+func (m *SwapQuote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -587,22 +1287,159 @@ func (m *QueryTerraPoolDeltaResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTerraPoolDeltaResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapQuote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTerraPoolDeltaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.TerraPoolDelta.Size()
+		size := m.DynamicFee.Size()
 		i -= size
-		if _, err := m.TerraPoolDelta.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DynamicFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.TerraPoolDeltaAfter.Size()
+		i -= size
+		if _, err := m.TerraPoolDeltaAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.TerraPoolDeltaBefore.Size()
+		i -= size
+		if _, err := m.TerraPoolDeltaBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.FeeCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.ReturnCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.Spread.Size()
+		i -= size
+		if _, err := m.Spread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.TobinTax.Size()
+		i -= size
+		if _, err := m.TobinTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MinStabilitySpread.Size()
+		i -= size
+		if _, err := m.MinStabilitySpread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ConstantProductSpread.Size()
+		i -= size
+		if _, err := m.ConstantProductSpread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.BaseAskAmount.Size()
+		i -= size
+		if _, err := m.BaseAskAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BaseOfferAmount.Size()
+		i -= size
+		if _, err := m.BaseOfferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AskRate.Size()
+		i -= size
+		if _, err := m.AskRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.OfferRate.Size()
+		i -= size
+		if _, err := m.OfferRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AskDenom) > 0 {
+		i -= len(m.AskDenom)
+		copy(dAtA[i:], m.AskDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -610,7 +1447,7 @@ func (m *QueryTerraPoolDeltaResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTerraPoolDeltaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -620,12 +1457,12 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTerraPoolDeltaRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTerraPoolDeltaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -633,7 +1470,7 @@ func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTerraPoolDeltaResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -643,22 +1480,22 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTerraPoolDeltaResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTerraPoolDeltaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TerraPoolDelta.Size()
+		i -= size
+		if _, err := m.TerraPoolDelta.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -666,92 +1503,1785 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryDenomPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (m *QuerySwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryDenomPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OfferCoin)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.AskDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ReturnCoin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTerraPoolDeltaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolDepth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDepth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDepth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size := m.LunaPool.Size()
+		i -= size
+		if _, err := m.LunaPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TerraPool.Size()
+		i -= size
+		if _, err := m.TerraPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TerraPoolDelta.Size()
+		i -= size
+		if _, err := m.TerraPoolDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BasePool.Size()
+		i -= size
+		if _, err := m.BasePool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTerraPoolDeltaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySwapIndicatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapIndicatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapIndicatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TerraPoolDelta.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySwapIndicatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapIndicatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapIndicatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indicators) > 0 {
+		for iNdEx := len(m.Indicators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indicators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OfferCoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReturnCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OfferCoins) > 0 {
+		for _, s := range m.OfferCoins {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AskDenoms) > 0 {
+		for _, s := range m.AskDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySwapQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Quotes) > 0 {
+		for _, e := range m.Quotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwapQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.OfferRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AskRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BaseOfferAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BaseAskAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ConstantProductSpread.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinStabilitySpread.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TobinTax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Spread.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReturnCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TerraPoolDeltaBefore.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TerraPoolDeltaAfter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DynamicFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTerraPoolDeltaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTerraPoolDeltaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TerraPoolDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDenomPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PoolDepth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BasePool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TerraPoolDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TerraPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LunaPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapIndicatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *QuerySwapIndicatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indicators) > 0 {
+		for _, e := range m.Indicators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferCoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReturnCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapQuoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapQuoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferCoins = append(m.OfferCoins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenoms = append(m.AskDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapQuoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapQuoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotes = append(m.Quotes, SwapQuote{})
+			if err := m.Quotes[len(m.Quotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapQuote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapQuote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapQuote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferRate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskRate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseOfferAmount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseOfferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAskAmount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAskAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantProductSpread", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConstantProductSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStabilitySpread", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStabilitySpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TobinTax", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TobinTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReturnCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerraPoolDeltaBefore", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TerraPoolDeltaBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerraPoolDeltaAfter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TerraPoolDeltaAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTerraPoolDeltaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTerraPoolDeltaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTerraPoolDeltaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTerraPoolDeltaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTerraPoolDeltaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTerraPoolDeltaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerraPoolDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TerraPoolDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, PoolDepth{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -774,15 +3304,15 @@ func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -810,13 +3340,63 @@ func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OfferCoin = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -826,23 +3406,24 @@ func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -865,7 +3446,7 @@ func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolDepth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -888,17 +3469,17 @@ func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolDepth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolDepth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -908,22 +3489,153 @@ func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReturnCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePool", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerraPoolDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TerraPoolDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerraPool", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TerraPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LunaPool", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LunaPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -948,7 +3660,7 @@ func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTerraPoolDeltaRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapIndicatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -971,12 +3683,31 @@ func (m *QueryTerraPoolDeltaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTerraPoolDeltaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapIndicatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTerraPoolDeltaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapIndicatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -998,7 +3729,7 @@ func (m *QueryTerraPoolDeltaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTerraPoolDeltaResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapIndicatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1021,17 +3752,17 @@ func (m *QueryTerraPoolDeltaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTerraPoolDeltaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapIndicatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTerraPoolDeltaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapIndicatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerraPoolDelta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Indicators", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1041,22 +3772,23 @@ func (m *QueryTerraPoolDeltaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TerraPoolDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Indicators = append(m.Indicators, EpochSwapIndicators{})
+			if err := m.Indicators[len(m.Indicators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
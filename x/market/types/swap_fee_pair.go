@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+)
+
+// NewSwapFeePair returns the dynamic fee of the pair of denoms
+func NewSwapFeePair(denomA string, denomB string, maxFee sdk.Dec, volumeLimit sdk.Dec, volumeWindow uint64) SwapFeePair {
+	return SwapFeePair{
+		DenomA:       denomA,
+		DenomB:       denomB,
+		MaxFee:       maxFee,
+		VolumeLimit:  volumeLimit,
+		VolumeWindow: volumeWindow,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (p SwapFeePair) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Matches returns true if the pair is the one of the denoms, in either order
+func (p SwapFeePair) Matches(denomA string, denomB string) bool {
+	return (p.DenomA == denomA && p.DenomB == denomB) || (p.DenomA == denomB && p.DenomB == denomA)
+}
+
+// DynamicFee returns the fee charged at the rolling swap volume, growing linearly up to the max fee
+func (p SwapFeePair) DynamicFee(volume sdk.Dec) sdk.Dec {
+	if volume.GTE(p.VolumeLimit) {
+		return p.MaxFee
+	}
+
+	return p.MaxFee.Mul(volume).Quo(p.VolumeLimit)
+}
+
+// Validate performs basic validation on the swap fee pair
+func (p SwapFeePair) Validate() error {
+	for _, denom := range []string{p.DenomA, p.DenomB} {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid swap fee pair denom: %w", err)
+		}
+
+		if denom == core.MicroLunaDenom {
+			return fmt.Errorf("swap fee pair denom must not be %s", core.MicroLunaDenom)
+		}
+	}
+
+	if p.DenomA == p.DenomB {
+		return fmt.Errorf("swap fee pair %s must be of two denoms", p.DenomA)
+	}
+
+	if p.MaxFee.IsNil() || p.MaxFee.IsNegative() || p.MaxFee.GT(sdk.OneDec()) {
+		return fmt.Errorf("max fee of swap fee pair %s/%s should be a value between [0,1], is %s", p.DenomA, p.DenomB, p.MaxFee)
+	}
+
+	if p.VolumeLimit.IsNil() || !p.VolumeLimit.IsPositive() {
+		return fmt.Errorf("volume limit of swap fee pair %s/%s should be positive, is %s", p.DenomA, p.DenomB, p.VolumeLimit)
+	}
+
+	if p.VolumeWindow == 0 {
+		return fmt.Errorf("volume window of swap fee pair %s/%s should be positive, is %d", p.DenomA, p.DenomB, p.VolumeWindow)
+	}
+
+	return nil
+}
+
+// SwapFeePairList is array of SwapFeePair
+type SwapFeePairList []SwapFeePair
+
+// String implements fmt.Stringer interface
+func (pl SwapFeePairList) String() (out string) {
+	for _, p := range pl {
+		out += p.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// Get returns the swap fee pair of the denoms, in either order
+func (pl SwapFeePairList) Get(denomA string, denomB string) (SwapFeePair, bool) {
+	for _, p := range pl {
+		if p.Matches(denomA, denomB) {
+			return p, true
+		}
+	}
+
+	return SwapFeePair{}, false
+}
+
+// Validate performs basic validation on the swap fee pairs, which must be unique
+func (pl SwapFeePairList) Validate() error {
+	for i, p := range pl {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := pl[:i].Get(p.DenomA, p.DenomB); found {
+			return fmt.Errorf("duplicated swap fee pair %s/%s", p.DenomA, p.DenomB)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochSwapIndicatorsRetention is the number of epochs the swap indicators are kept for,
+// the epoch starting included; a year of weekly epochs
+const EpochSwapIndicatorsRetention = uint64(52)
+
+// NewSwapVolume returns the swap volume of the pair of denoms, in the alphabetical order of its store key
+func NewSwapVolume(denomA string, denomB string, volume sdk.Dec) SwapVolume {
+	if denomA > denomB {
+		denomA, denomB = denomB, denomA
+	}
+
+	return SwapVolume{DenomA: denomA, DenomB: denomB, Volume: volume}
+}
+
+// Validate performs basic validation on the swap indicators of the epoch
+func (i EpochSwapIndicators) Validate() error {
+	pairs := make(map[string]bool, len(i.SwapVolumes))
+	for _, volume := range i.SwapVolumes {
+		if err := sdk.ValidateDenom(volume.DenomA); err != nil {
+			return fmt.Errorf("invalid swap volume denom of epoch %d: %w", i.Epoch, err)
+		}
+
+		if err := sdk.ValidateDenom(volume.DenomB); err != nil {
+			return fmt.Errorf("invalid swap volume denom of epoch %d: %w", i.Epoch, err)
+		}
+
+		pair := string(GetSwapVolumeKey(volume.DenomA, volume.DenomB))
+		if pairs[pair] {
+			return fmt.Errorf("duplicated swap volume %s/%s of epoch %d", volume.DenomA, volume.DenomB, i.Epoch)
+		}
+
+		pairs[pair] = true
+
+		if volume.Volume.IsNil() || volume.Volume.IsNegative() {
+			return fmt.Errorf("swap volume of %s/%s of epoch %d should not be negative, is %s", volume.DenomA, volume.DenomB, i.Epoch, volume.Volume)
+		}
+	}
+
+	for _, coins := range []sdk.Coins{i.Minted, i.Burned, i.SwapFees} {
+		if err := coins.Validate(); err != nil {
+			return fmt.Errorf("invalid swap indicators of epoch %d: %w", i.Epoch, err)
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -34,6 +35,10 @@ type MsgSwap struct {
 	Trader    string     `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty" yaml:"trader"`
 	OfferCoin types.Coin `protobuf:"bytes,2,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
 	AskDenom  string     `protobuf:"bytes,3,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty" yaml:"ask_denom"`
+	// min_ask_amount is the minimum amount of the ask denom to receive, unlimited if not set
+	MinAskAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_ask_amount,json=minAskAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_ask_amount,omitempty" yaml:"min_ask_amount"`
+	// max_spread is the maximum spread charged by the swap, unlimited if not set
+	MaxSpread *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_spread,json=maxSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread,omitempty" yaml:"max_spread"`
 }
 
 func (m *MsgSwap) Reset()         { *m = MsgSwap{} }
@@ -128,6 +133,10 @@ type MsgSwapSend struct {
 	ToAddress   string     `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty" yaml:"to_address"`
 	OfferCoin   types.Coin `protobuf:"bytes,3,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
 	AskDenom    string     `protobuf:"bytes,4,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty" yaml:"ask_denom"`
+	// min_ask_amount is the minimum amount of the ask denom to receive, unlimited if not set
+	MinAskAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_ask_amount,json=minAskAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_ask_amount,omitempty" yaml:"min_ask_amount"`
+	// max_spread is the maximum spread charged by the swap, unlimited if not set
+	MaxSpread *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_spread,json=maxSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread,omitempty" yaml:"max_spread"`
 }
 
 func (m *MsgSwapSend) Reset()         { *m = MsgSwapSend{} }
@@ -216,49 +225,162 @@ func (m *MsgSwapSendResponse) GetSwapFee() types.Coin {
 	return types.Coin{}
 }
 
+// MsgSwapRoute represents a message to swap coin through the denoms of a route,
+// each hop swapping the coin received from the previous one
+type MsgSwapRoute struct {
+	Trader    string     `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty" yaml:"trader"`
+	OfferCoin types.Coin `protobuf:"bytes,2,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
+	// ask_denoms are the denoms to swap to in order, the last one being the ask denom
+	AskDenoms []string `protobuf:"bytes,3,rep,name=ask_denoms,json=askDenoms,proto3" json:"ask_denoms,omitempty" yaml:"ask_denoms"`
+	// min_ask_amount is the minimum amount of the ask denom to receive, unlimited if not set
+	MinAskAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_ask_amount,json=minAskAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_ask_amount,omitempty" yaml:"min_ask_amount"`
+	// max_spread is the maximum spread charged by each hop, unlimited if not set
+	MaxSpread *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_spread,json=maxSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread,omitempty" yaml:"max_spread"`
+}
+
+func (m *MsgSwapRoute) Reset()         { *m = MsgSwapRoute{} }
+func (m *MsgSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRoute) ProtoMessage()    {}
+func (*MsgSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dcd4b152743bd0f, []int{4}
+}
+func (m *MsgSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRoute.Merge(m, src)
+}
+func (m *MsgSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapRoute proto.InternalMessageInfo
+
+// MsgSwapRouteResponse defines the Msg/SwapRoute response type.
+type MsgSwapRouteResponse struct {
+	SwapCoin types.Coin `protobuf:"bytes,1,opt,name=swap_coin,json=swapCoin,proto3" json:"swap_coin" yaml:"swap_coin"`
+	// swap_fees are the fees charged by the hops of the route, in order
+	SwapFees []types.Coin `protobuf:"bytes,2,rep,name=swap_fees,json=swapFees,proto3" json:"swap_fees" yaml:"swap_fees"`
+}
+
+func (m *MsgSwapRouteResponse) Reset()         { *m = MsgSwapRouteResponse{} }
+func (m *MsgSwapRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRouteResponse) ProtoMessage()    {}
+func (*MsgSwapRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dcd4b152743bd0f, []int{5}
+}
+func (m *MsgSwapRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRouteResponse.Merge(m, src)
+}
+func (m *MsgSwapRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapRouteResponse proto.InternalMessageInfo
+
+func (m *MsgSwapRouteResponse) GetSwapCoin() types.Coin {
+	if m != nil {
+		return m.SwapCoin
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwapRouteResponse) GetSwapFees() []types.Coin {
+	if m != nil {
+		return m.SwapFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSwap)(nil), "terra.market.v1beta1.MsgSwap")
 	proto.RegisterType((*MsgSwapResponse)(nil), "terra.market.v1beta1.MsgSwapResponse")
 	proto.RegisterType((*MsgSwapSend)(nil), "terra.market.v1beta1.MsgSwapSend")
 	proto.RegisterType((*MsgSwapSendResponse)(nil), "terra.market.v1beta1.MsgSwapSendResponse")
+	proto.RegisterType((*MsgSwapRoute)(nil), "terra.market.v1beta1.MsgSwapRoute")
+	proto.RegisterType((*MsgSwapRouteResponse)(nil), "terra.market.v1beta1.MsgSwapRouteResponse")
 }
 
 func init() { proto.RegisterFile("terra/market/v1beta1/tx.proto", fileDescriptor_7dcd4b152743bd0f) }
 
 var fileDescriptor_7dcd4b152743bd0f = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x3f, 0x6f, 0xd3, 0x4c,
-	0x18, 0xf7, 0x25, 0x55, 0x1b, 0x5f, 0xde, 0x57, 0xa5, 0x6e, 0x50, 0xd3, 0x48, 0xb5, 0xcb, 0x49,
-	0x48, 0x2d, 0x12, 0xb6, 0x52, 0x98, 0xb2, 0x11, 0x2a, 0x26, 0x22, 0x21, 0x67, 0x41, 0x2c, 0xd1,
-	0x25, 0x7e, 0x6c, 0xa2, 0x60, 0x9f, 0x75, 0x77, 0xd0, 0xe6, 0x1b, 0x30, 0xc2, 0x37, 0xe8, 0x17,
-	0x60, 0x61, 0xe0, 0x23, 0xa0, 0x8e, 0x1d, 0x99, 0x2c, 0x94, 0x2c, 0xcc, 0xf9, 0x04, 0xc8, 0x77,
-	0x8e, 0x13, 0x24, 0xd4, 0x88, 0x81, 0x81, 0xed, 0x39, 0xff, 0xfe, 0xdc, 0xef, 0xb9, 0xc7, 0x77,
-	0xf8, 0x48, 0x02, 0xe7, 0xd4, 0x8b, 0x29, 0x9f, 0x80, 0xf4, 0xde, 0xb5, 0x87, 0x20, 0x69, 0xdb,
-	0x93, 0x97, 0x6e, 0xca, 0x99, 0x64, 0x56, 0x43, 0xc1, 0xae, 0x86, 0xdd, 0x02, 0x6e, 0x35, 0x22,
-	0x16, 0x31, 0x45, 0xf0, 0xf2, 0x4a, 0x73, 0x5b, 0xf6, 0x88, 0x89, 0x98, 0x09, 0x6f, 0x48, 0x05,
-	0x94, 0x4e, 0x23, 0x36, 0x4e, 0x34, 0x4e, 0xbe, 0x22, 0xbc, 0xd3, 0x13, 0x51, 0xff, 0x82, 0xa6,
-	0xd6, 0x29, 0xde, 0x96, 0x9c, 0x06, 0xc0, 0x9b, 0xe8, 0x18, 0x9d, 0x98, 0xdd, 0xbd, 0x45, 0xe6,
-	0xfc, 0x3f, 0xa5, 0xf1, 0x9b, 0x0e, 0xd1, 0xdf, 0x89, 0x5f, 0x10, 0xac, 0x3e, 0xc6, 0x2c, 0x0c,
-	0x81, 0x0f, 0x72, 0xab, 0x66, 0xe5, 0x18, 0x9d, 0xd4, 0xcf, 0x0e, 0x5d, 0xbd, 0x97, 0x9b, 0xef,
-	0xb5, 0x8c, 0xe5, 0x3e, 0x65, 0xe3, 0xa4, 0x7b, 0x78, 0x9d, 0x39, 0xc6, 0x22, 0x73, 0xf6, 0xb4,
-	0xdb, 0x4a, 0x4a, 0x7c, 0x53, 0x2d, 0x72, 0x96, 0xd5, 0xc6, 0x26, 0x15, 0x93, 0x41, 0x00, 0x09,
-	0x8b, 0x9b, 0x55, 0x15, 0xa1, 0xb1, 0xc8, 0x9c, 0x3b, 0x5a, 0x54, 0x42, 0xc4, 0xaf, 0x51, 0x31,
-	0x39, 0xcf, 0xcb, 0x4e, 0xed, 0xfd, 0x95, 0x63, 0xfc, 0xb8, 0x72, 0x0c, 0xf2, 0x19, 0xe1, 0xdd,
-	0xa2, 0x11, 0x1f, 0x44, 0xca, 0x12, 0x01, 0xd6, 0x0b, 0x6c, 0x8a, 0x0b, 0x9a, 0xea, 0x90, 0x68,
-	0x53, 0xc8, 0x66, 0x11, 0xb2, 0xd8, 0xaf, 0x54, 0x12, 0xbf, 0x96, 0xd7, 0x2a, 0x62, 0x0f, 0xab,
-	0x7a, 0x10, 0x02, 0x6c, 0xee, 0xfa, 0xa0, 0x30, 0xdc, 0x5d, 0x33, 0x0c, 0x01, 0x88, 0xbf, 0x93,
-	0x97, 0xcf, 0x00, 0xc8, 0xc7, 0x0a, 0xae, 0x17, 0xa1, 0xfb, 0x90, 0x04, 0x56, 0x07, 0xff, 0x17,
-	0x72, 0x16, 0x0f, 0x68, 0x10, 0x70, 0x10, 0xa2, 0x98, 0xc3, 0xc1, 0x22, 0x73, 0xf6, 0xb5, 0xc7,
-	0x3a, 0x4a, 0xfc, 0x7a, 0xbe, 0x7c, 0xa2, 0x57, 0xd6, 0x63, 0x8c, 0x25, 0x2b, 0x95, 0x15, 0xa5,
-	0xbc, 0xbb, 0x3a, 0xf3, 0x15, 0x46, 0x7c, 0x53, 0xb2, 0xa5, 0xea, 0xd7, 0x41, 0x56, 0xff, 0xc2,
-	0x20, 0xb7, 0xfe, 0x70, 0x90, 0x5f, 0x10, 0xde, 0x5f, 0x3b, 0x93, 0x7f, 0x66, 0x98, 0x67, 0x9f,
-	0x10, 0xae, 0xf6, 0x44, 0x64, 0x3d, 0xc7, 0x5b, 0xea, 0x3a, 0x1d, 0xb9, 0xbf, 0xbb, 0xa7, 0x6e,
-	0xd1, 0x5b, 0xeb, 0xfe, 0xad, 0x70, 0xd9, 0xf6, 0x4b, 0x5c, 0x2b, 0x7f, 0x8f, 0x7b, 0xb7, 0x4a,
-	0x72, 0x4a, 0xeb, 0x74, 0x23, 0x65, 0xe9, 0xdc, 0x3d, 0xbf, 0x9e, 0xd9, 0xe8, 0x66, 0x66, 0xa3,
-	0xef, 0x33, 0x1b, 0x7d, 0x98, 0xdb, 0xc6, 0xcd, 0xdc, 0x36, 0xbe, 0xcd, 0x6d, 0xe3, 0xd5, 0x83,
-	0x68, 0x2c, 0x5f, 0xbf, 0x1d, 0xba, 0x23, 0x16, 0x7b, 0xca, 0xee, 0x61, 0xcc, 0x12, 0x98, 0x7a,
-	0x23, 0xc6, 0xc1, 0xbb, 0x5c, 0xbe, 0x4b, 0x72, 0x9a, 0x82, 0x18, 0x6e, 0xab, 0x77, 0xe4, 0xd1,
-	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0x1d, 0x9f, 0xd3, 0xb4, 0x04, 0x00, 0x00,
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6b, 0xd4, 0x40,
+	0x18, 0xde, 0x34, 0xfd, 0xd8, 0x4c, 0xab, 0xb5, 0xe9, 0x96, 0xa6, 0x0b, 0x4d, 0xea, 0x80, 0xd2,
+	0x16, 0x9a, 0xd0, 0xda, 0x53, 0x2f, 0xd2, 0x0f, 0x0a, 0x82, 0x05, 0xc9, 0x5e, 0x44, 0xc1, 0x65,
+	0x76, 0xf3, 0xee, 0xba, 0xac, 0xc9, 0x84, 0xcc, 0xd4, 0x6e, 0xff, 0x81, 0x78, 0xd2, 0xa3, 0xb7,
+	0xfe, 0x06, 0x0f, 0x1e, 0xfc, 0x05, 0x3d, 0xf6, 0x28, 0x22, 0x41, 0xda, 0x8b, 0xe7, 0xfd, 0x05,
+	0x32, 0x93, 0xaf, 0x2d, 0x48, 0x57, 0x85, 0x2a, 0x7a, 0xda, 0x77, 0xf6, 0x79, 0x9f, 0xe7, 0x9d,
+	0x79, 0xe7, 0xc9, 0x9b, 0xa0, 0x45, 0x0e, 0x51, 0x44, 0x1c, 0x9f, 0x44, 0x5d, 0xe0, 0xce, 0xcb,
+	0xf5, 0x06, 0x70, 0xb2, 0xee, 0xf0, 0x9e, 0x1d, 0x46, 0x94, 0x53, 0xbd, 0x22, 0x61, 0x3b, 0x81,
+	0xed, 0x14, 0xae, 0x56, 0xda, 0xb4, 0x4d, 0x65, 0x82, 0x23, 0xa2, 0x24, 0xb7, 0x6a, 0x36, 0x29,
+	0xf3, 0x29, 0x73, 0x1a, 0x84, 0x41, 0xae, 0xd4, 0xa4, 0x9d, 0x20, 0xc1, 0xf1, 0x5b, 0x15, 0x4d,
+	0x1c, 0xb0, 0x76, 0xed, 0x88, 0x84, 0xfa, 0x0a, 0x1a, 0xe7, 0x11, 0xf1, 0x20, 0x32, 0x94, 0x25,
+	0x65, 0x59, 0xdb, 0x99, 0xe9, 0xc7, 0xd6, 0x8d, 0x63, 0xe2, 0xbf, 0xd8, 0xc2, 0xc9, 0xff, 0xd8,
+	0x4d, 0x13, 0xf4, 0x1a, 0x42, 0xb4, 0xd5, 0x82, 0xa8, 0x2e, 0xa4, 0x8c, 0x91, 0x25, 0x65, 0x79,
+	0x72, 0x63, 0xc1, 0x4e, 0x6a, 0xd9, 0xa2, 0x56, 0xb6, 0x2d, 0x7b, 0x97, 0x76, 0x82, 0x9d, 0x85,
+	0xd3, 0xd8, 0x2a, 0xf5, 0x63, 0x6b, 0x26, 0x51, 0x2b, 0xa8, 0xd8, 0xd5, 0xe4, 0x42, 0x64, 0xe9,
+	0xeb, 0x48, 0x23, 0xac, 0x5b, 0xf7, 0x20, 0xa0, 0xbe, 0xa1, 0xca, 0x2d, 0x54, 0xfa, 0xb1, 0x75,
+	0x2b, 0x21, 0xe5, 0x10, 0x76, 0xcb, 0x84, 0x75, 0xf7, 0x44, 0xa8, 0x77, 0xd0, 0x4d, 0xbf, 0x13,
+	0xd4, 0x05, 0x46, 0x7c, 0x7a, 0x18, 0x70, 0x63, 0x54, 0xf2, 0x76, 0x3f, 0xc7, 0xd6, 0xdd, 0x76,
+	0x87, 0x3f, 0x3f, 0x6c, 0xd8, 0x4d, 0xea, 0x3b, 0x69, 0x17, 0x92, 0x9f, 0x35, 0xe6, 0x75, 0x1d,
+	0x7e, 0x1c, 0x02, 0xb3, 0x1f, 0x04, 0xbc, 0x1f, 0x5b, 0x73, 0x49, 0x85, 0xcb, 0x4a, 0xd8, 0x9d,
+	0xf2, 0x3b, 0xc1, 0x36, 0xeb, 0x6e, 0xcb, 0xa5, 0xfe, 0x0c, 0x21, 0x9f, 0xf4, 0xea, 0x2c, 0x8c,
+	0x80, 0x78, 0xc6, 0x98, 0x2c, 0x73, 0xff, 0x27, 0xcb, 0xec, 0x41, 0xb3, 0x38, 0x7d, 0xa1, 0x82,
+	0x5d, 0xcd, 0x27, 0xbd, 0x9a, 0x8c, 0xb7, 0xca, 0xaf, 0x4e, 0xac, 0xd2, 0xb7, 0x13, 0xab, 0x84,
+	0xdf, 0x2b, 0x68, 0x3a, 0xbd, 0x13, 0x17, 0x58, 0x48, 0x03, 0x06, 0xfa, 0x23, 0xa4, 0xb1, 0x23,
+	0x12, 0x26, 0xfd, 0x56, 0x86, 0xf5, 0xdb, 0x48, 0xfb, 0x9d, 0xb6, 0x2e, 0x67, 0x62, 0xb7, 0x2c,
+	0x62, 0xd9, 0xed, 0x03, 0x24, 0xe3, 0x7a, 0x0b, 0x60, 0xf8, 0x05, 0xce, 0xa7, 0x82, 0xd3, 0x03,
+	0x82, 0x2d, 0x00, 0xec, 0x4e, 0x88, 0x70, 0x1f, 0x00, 0x7f, 0x51, 0xd1, 0x64, 0xba, 0xe9, 0x1a,
+	0x04, 0x9e, 0xbe, 0x85, 0xa6, 0x5a, 0x11, 0xf5, 0xeb, 0xc4, 0xf3, 0x22, 0x60, 0x2c, 0xb5, 0xd4,
+	0x7c, 0x3f, 0xb6, 0x66, 0x13, 0x8d, 0x41, 0x14, 0xbb, 0x93, 0x62, 0xb9, 0x9d, 0xac, 0xf4, 0x4d,
+	0x84, 0x38, 0xcd, 0x99, 0x23, 0x92, 0x39, 0x57, 0x34, 0xb0, 0xc0, 0xb0, 0xab, 0x71, 0x9a, 0xb1,
+	0x2e, 0x7b, 0x52, 0xbd, 0x06, 0x4f, 0x8e, 0xfe, 0xa6, 0x27, 0xc7, 0xfe, 0x8c, 0x27, 0xc7, 0xaf,
+	0xd1, 0x93, 0x1f, 0x14, 0x34, 0x3b, 0x70, 0xbd, 0xff, 0x8e, 0x2f, 0xdf, 0xa9, 0x68, 0x2a, 0x7b,
+	0x98, 0xe8, 0x21, 0x87, 0xbf, 0x3e, 0xe5, 0x36, 0x11, 0xca, 0x6d, 0xc3, 0x0c, 0x75, 0x49, 0xbd,
+	0x6c, 0xee, 0x02, 0xc3, 0xae, 0x96, 0x79, 0x8a, 0xfd, 0x9f, 0x83, 0xee, 0xa3, 0x82, 0x2a, 0x83,
+	0x77, 0x73, 0x8d, 0xae, 0xca, 0x14, 0x5b, 0x00, 0x62, 0xa2, 0xa8, 0xbf, 0xae, 0x28, 0x98, 0xa9,
+	0xe2, 0x3e, 0x00, 0xdb, 0x78, 0x3d, 0x82, 0xd4, 0x03, 0xd6, 0xd6, 0x1f, 0xa2, 0x51, 0xf9, 0xf6,
+	0x5c, 0xb4, 0x7f, 0xf4, 0x5a, 0xb6, 0xd3, 0xf3, 0x55, 0xef, 0x5c, 0x09, 0xe7, 0x27, 0x7f, 0x8c,
+	0xca, 0xf9, 0x08, 0xbd, 0x7d, 0x25, 0x45, 0xa4, 0x54, 0x57, 0x86, 0xa6, 0xe4, 0xca, 0x4f, 0x91,
+	0x56, 0x3c, 0x04, 0xf8, 0xea, 0xdd, 0x88, 0x9c, 0xea, 0xea, 0xf0, 0x9c, 0x4c, 0x7c, 0x67, 0xef,
+	0xf4, 0xdc, 0x54, 0xce, 0xce, 0x4d, 0xe5, 0xeb, 0xb9, 0xa9, 0xbc, 0xb9, 0x30, 0x4b, 0x67, 0x17,
+	0x66, 0xe9, 0xd3, 0x85, 0x59, 0x7a, 0xb2, 0x3a, 0xe0, 0x1a, 0xa9, 0xb7, 0xe6, 0xd3, 0x00, 0x8e,
+	0x9d, 0x26, 0x8d, 0xc0, 0xe9, 0x65, 0xdf, 0x38, 0xd2, 0x3d, 0x8d, 0x71, 0xf9, 0x4d, 0x72, 0xef,
+	0xfb, 0x00, 0x21, 0xaa, 0x44, 0x6a, 0x00, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +401,9 @@ type MsgClient interface {
 	// SwapSend defines a method for swapping and sending coin from a account to other
 	// account.
 	SwapSend(ctx context.Context, in *MsgSwapSend, opts ...grpc.CallOption) (*MsgSwapSendResponse, error)
+	// SwapRoute defines a method for swapping coin through the denoms of a route,
+	// atomically
+	SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error)
 }
 
 type msgClient struct {
@@ -307,6 +432,15 @@ func (c *msgClient) SwapSend(ctx context.Context, in *MsgSwapSend, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error) {
+	out := new(MsgSwapRouteResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Msg/SwapRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Swap defines a method for swapping coin from one denom to another
@@ -315,6 +449,9 @@ type MsgServer interface {
 	// SwapSend defines a method for swapping and sending coin from a account to other
 	// account.
 	SwapSend(context.Context, *MsgSwapSend) (*MsgSwapSendResponse, error)
+	// SwapRoute defines a method for swapping coin through the denoms of a route,
+	// atomically
+	SwapRoute(context.Context, *MsgSwapRoute) (*MsgSwapRouteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -327,6 +464,9 @@ func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwap) (*MsgSwap
 func (*UnimplementedMsgServer) SwapSend(ctx context.Context, req *MsgSwapSend) (*MsgSwapSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapSend not implemented")
 }
+func (*UnimplementedMsgServer) SwapRoute(ctx context.Context, req *MsgSwapRoute) (*MsgSwapRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRoute not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -368,6 +508,24 @@ func _Msg_SwapSend_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SwapRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Msg/SwapRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapRoute(ctx, req.(*MsgSwapRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.market.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -380,6 +538,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapSend",
 			Handler:    _Msg_SwapSend_Handler,
 		},
+		{
+			MethodName: "SwapRoute",
+			Handler:    _Msg_SwapRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/market/v1beta1/tx.proto",
@@ -405,6 +567,30 @@ func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSpread != nil {
+		{
+			size := m.MaxSpread.Size()
+			i -= size
+			if _, err := m.MaxSpread.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinAskAmount != nil {
+		{
+			size := m.MinAskAmount.Size()
+			i -= size
+			if _, err := m.MinAskAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.AskDenom) > 0 {
 		i -= len(m.AskDenom)
 		copy(dAtA[i:], m.AskDenom)
@@ -495,6 +681,30 @@ func (m *MsgSwapSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSpread != nil {
+		{
+			size := m.MaxSpread.Size()
+			i -= size
+			if _, err := m.MaxSpread.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinAskAmount != nil {
+		{
+			size := m.MinAskAmount.Size()
+			i -= size
+			if _, err := m.MinAskAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.AskDenom) > 0 {
 		i -= len(m.AskDenom)
 		copy(dAtA[i:], m.AskDenom)
@@ -572,6 +782,126 @@ func (m *MsgSwapSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSpread != nil {
+		{
+			size := m.MaxSpread.Size()
+			i -= size
+			if _, err := m.MaxSpread.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinAskAmount != nil {
+		{
+			size := m.MinAskAmount.Size()
+			i -= size
+			if _, err := m.MinAskAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AskDenoms) > 0 {
+		for iNdEx := len(m.AskDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AskDenoms[iNdEx])
+			copy(dAtA[i:], m.AskDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AskDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SwapCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -599,6 +929,14 @@ func (m *MsgSwap) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MinAskAmount != nil {
+		l = m.MinAskAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSpread != nil {
+		l = m.MaxSpread.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -635,6 +973,14 @@ func (m *MsgSwapSend) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MinAskAmount != nil {
+		l = m.MinAskAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSpread != nil {
+		l = m.MaxSpread.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -651,6 +997,52 @@ func (m *MsgSwapSendResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.AskDenoms) > 0 {
+		for _, s := range m.AskDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MinAskAmount != nil {
+		l = m.MinAskAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSpread != nil {
+		l = m.MaxSpread.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -783,20 +1175,92 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			}
 			m.AskDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAskAmount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinAskAmount = &v
+			if err := m.MinAskAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxSpread = &v
+			if err := m.MaxSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -1078,6 +1542,78 @@ func (m *MsgSwapSend) Unmarshal(dAtA []byte) error {
 			}
 			m.AskDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAskAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinAskAmount = &v
+			if err := m.MinAskAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxSpread = &v
+			if err := m.MaxSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1215,6 +1751,342 @@ func (m *MsgSwapSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenoms = append(m.AskDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAskAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinAskAmount = &v
+			if err := m.MinAskAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxSpread = &v
+			if err := m.MaxSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFees = append(m.SwapFees, types.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -31,8 +31,9 @@ func (WasmMsgParser) Parse(_ sdk.AccAddress, _ wasmvmtypes.CosmosMsg) (sdk.Msg,
 
 // CosmosMsg only contains swap msg
 type CosmosMsg struct {
-	Swap     *types.MsgSwap     `json:"swap,omitempty"`
-	SwapSend *types.MsgSwapSend `json:"swap_send,omitempty"`
+	Swap      *types.MsgSwap      `json:"swap,omitempty"`
+	SwapSend  *types.MsgSwapSend  `json:"swap_send,omitempty"`
+	SwapRoute *types.MsgSwapRoute `json:"swap_route,omitempty"`
 }
 
 // ParseCustom implements custom parser
@@ -49,6 +50,9 @@ func (WasmMsgParser) ParseCustom(contractAddr sdk.AccAddress, data json.RawMessa
 	} else if sdkMsg.SwapSend != nil {
 		sdkMsg.SwapSend.FromAddress = contractAddr.String()
 		return sdkMsg.SwapSend, sdkMsg.SwapSend.ValidateBasic()
+	} else if sdkMsg.SwapRoute != nil {
+		sdkMsg.SwapRoute.Trader = contractAddr.String()
+		return sdkMsg.SwapRoute, sdkMsg.SwapRoute.ValidateBasic()
 	}
 
 	return nil, sdkerrors.Wrap(wasm.ErrInvalidMsg, "Unknown variant of Market")
@@ -33,6 +33,8 @@ func TestEncoding(t *testing.T) {
 	}
 
 	invalidAddr := "xrnd1d02kd90n38qvr3qb9qof83fn2d2"
+	minAskAmount := sdk.NewInt(1000)
+	maxSpread := sdk.NewDecWithPrec(2, 2)
 
 	cases := map[string]struct {
 		sender sdk.AccAddress
@@ -75,6 +77,36 @@ func TestEncoding(t *testing.T) {
 				AskDenom:    core.MicroSDRDenom,
 			},
 		},
+		"swap route with limits": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(
+					fmt.Sprintf(
+						`{"swap_route": {"offer_coin": {"amount": "1234", "denom": "%s"}, "ask_denoms": ["%s", "%s"], "min_ask_amount": "1000", "max_spread": "0.02"}}`,
+						core.MicroKRWDenom, core.MicroLunaDenom, core.MicroSDRDenom,
+					),
+				),
+			},
+			output: &types.MsgSwapRoute{
+				Trader:       addrs[0].String(),
+				OfferCoin:    sdk.NewInt64Coin(core.MicroKRWDenom, 1234),
+				AskDenoms:    []string{core.MicroLunaDenom, core.MicroSDRDenom},
+				MinAskAmount: &minAskAmount,
+				MaxSpread:    &maxSpread,
+			},
+		},
+		"invalid max spread": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				Custom: []byte(
+					fmt.Sprintf(
+						`{"swap": {"offer_coin": {"amount": "1234", "denom": "%s"}, "ask_denom": "%s", "max_spread": "1.5"}}`,
+						core.MicroLunaDenom, core.MicroSDRDenom,
+					),
+				),
+			},
+			isError: true,
+		},
 		"invalid swap amount": {
 			sender: addrs[0],
 			input: wasmvmtypes.CosmosMsg{
@@ -19,25 +19,29 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if core.IsPeriodLastBlock(ctx, params.VotePeriod) {
 
 		// Build claim map over all validators in active set
-		validatorClaimMap := make(map[string]types.Claim)
-
-		maxValidators := k.StakingKeeper.MaxValidators(ctx)
-		iterator := k.StakingKeeper.ValidatorsPowerStoreIterator(ctx)
-		defer iterator.Close()
-
-		powerReduction := k.StakingKeeper.PowerReduction(ctx)
-
-		i := 0
-		for ; iterator.Valid() && i < int(maxValidators); iterator.Next() {
-			validator := k.StakingKeeper.Validator(ctx, iterator.Value())
+		validatorClaimMap := k.GetValidatorClaimMap(ctx)
+
+		// Load the performances of the active validators for the slash window
+		window := k.GetSlashWindowIndex(ctx)
+		performanceMap := make(map[string]*types.ValidatorPerformance)
+		for key, claim := range validatorClaimMap {
+			performance := k.GetValidatorPerformance(ctx, window, claim.Recipient)
+			performance.VotePeriods++
+			performanceMap[key] = &performance
+		}
 
-			// Exclude not bonded validator
-			if validator.IsBonded() {
-				valAddr := validator.GetOperator()
-				validatorClaimMap[valAddr.String()] = types.NewClaim(validator.GetConsensusPower(powerReduction), 0, 0, valAddr)
-				i++
+		k.IterateAggregateExchangeRateVotes(ctx, func(_ sdk.ValAddress, vote types.AggregateExchangeRateVote) (stop bool) {
+			if performance, ok := performanceMap[vote.Voter]; ok {
+				performance.Votes++
+				for _, tuple := range vote.ExchangeRateTuples {
+					if !tuple.ExchangeRate.IsPositive() {
+						performance.Abstains++
+					}
+				}
 			}
-		}
+
+			return false
+		})
 
 		// Denom-TobinTax map
 		voteTargets := make(map[string]sdk.Dec)
@@ -52,52 +56,24 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 			return false
 		})
 
-		// Organize votes to ballot by denom
-		// NOTE: **Filter out inactive or jailed validators**
-		// NOTE: **Make abstain votes to have zero vote power**
-		voteMap := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
-
-		if referenceTerra := pickReferenceTerra(ctx, k, voteTargets, voteMap); referenceTerra != "" {
-			// make voteMap of Reference Terra to calculate cross exchange rates
-			ballotRT := voteMap[referenceTerra]
-			voteMapRT := ballotRT.ToMap()
-
-			var exchangeRateRT sdk.Dec
-
-			// softfork
-			if (ctx.ChainID() == core.ColumbusChainID && ctx.BlockHeight() < int64(5_701_000)) ||
-				(ctx.ChainID() == core.BombayChainID && ctx.BlockHeight() < int64(7_000_000)) {
-				exchangeRateRT = ballotRT.WeightedMedian()
-			} else {
-				exchangeRateRT = ballotRT.WeightedMedianWithAssertion()
-			}
-
-			// Iterate through ballots and update exchange rates; drop if not enough votes have been achieved.
-			for denom, ballot := range voteMap {
-
-				// Convert ballot to cross exchange rates
-				if denom != referenceTerra {
-
-					// softfork
-					if (ctx.ChainID() == core.ColumbusChainID && ctx.BlockHeight() < int64(5_701_000)) ||
-						(ctx.ChainID() == core.BombayChainID && ctx.BlockHeight() < int64(7_000_000)) {
-						ballot = ballot.ToCrossRate(voteMapRT)
-					} else {
-						ballot = ballot.ToCrossRateWithSort(voteMapRT)
-					}
-				}
+		// Tally the ballots of the vote targets, recording the votes in the performances of their voters
+		summary := k.TallyBallots(ctx, params, voteTargets, validatorClaimMap,
+			func(denom string, ballot types.ExchangeRateBallot, exchangeRate sdk.Dec, winCounts map[string]int64) {
+				recordBallotPerformance(denom, ballot, exchangeRate, winCounts, validatorClaimMap, performanceMap)
+			})
 
-				// Get weighted median of cross exchange rates
-				exchangeRate := Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+		// Set the exchange rates of the passed ballots within the max rate change, emit ABCI event
+		k.ApplyBallots(ctx, params.MaxRateChange, summary.Ballots)
+		k.SetLastVotePeriodSummaryWithEvent(ctx, summary)
 
-				// Transform into the original form uluna/stablecoin
-				if denom != referenceTerra {
-					exchangeRate = exchangeRateRT.Quo(exchangeRate)
-				}
+		// Clear all prices and set the prices of the price feeds passing their threshold
+		k.IteratePrices(ctx, func(price types.FeedPrice) (stop bool) {
+			k.DeletePrice(ctx, price.Name)
+			return false
+		})
 
-				// Set the exchange rate, emit ABCI event
-				k.SetLunaExchangeRateWithEvent(ctx, denom, exchangeRate)
-			}
+		for _, price := range k.TallyPriceFeeds(ctx, params, validatorClaimMap) {
+			k.SetPriceWithEvent(ctx, price)
 		}
 
 		//---------------------------
@@ -110,7 +86,12 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 			}
 
 			// Increase miss counter
-			k.SetMissCounter(ctx, claim.Recipient, k.GetMissCounter(ctx, claim.Recipient)+1)
+			k.IncreaseMissCounterWithEvent(ctx, params, claim.Recipient)
+			performanceMap[claim.Recipient.String()].Misses++
+		}
+
+		for _, claim := range validatorClaimMap {
+			k.SetValidatorPerformance(ctx, claim.Recipient, *performanceMap[claim.Recipient.String()])
 		}
 
 		// Distribute rewards to ballot winners
@@ -133,7 +114,36 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// reset miss counters of all validators at the last block of slash window
 	if core.IsPeriodLastBlock(ctx, params.SlashWindow) {
 		k.SlashAndResetMissCounters(ctx)
+
+		// Keep the performances of the ending window as the previous one
+		k.PrunePreviousValidatorPerformances(ctx, k.GetSlashWindowIndex(ctx))
 	}
 
 	return
 }
+
+// recordBallotPerformance records the tallied exchange rates of the ballot in the performances
+// of their voters; a voter won when Tally increased its win count, abstains are not recorded
+func recordBallotPerformance(
+	denom string,
+	ballot types.ExchangeRateBallot,
+	weightedMedian sdk.Dec,
+	winCounts map[string]int64,
+	validatorClaimMap map[string]types.Claim,
+	performanceMap map[string]*types.ValidatorPerformance,
+) {
+	for _, vote := range ballot {
+		key := vote.Voter.String()
+		performance, ok := performanceMap[key]
+		if !ok || !vote.ExchangeRate.IsPositive() {
+			continue
+		}
+
+		deviation := sdk.ZeroDec()
+		if weightedMedian.IsPositive() {
+			deviation = vote.ExchangeRate.Sub(weightedMedian).Abs().Quo(weightedMedian)
+		}
+
+		performance.AddDenomVote(denom, validatorClaimMap[key].WinCount > winCounts[key], deviation)
+	}
+}
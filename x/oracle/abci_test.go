@@ -200,7 +200,7 @@ func TestOracleTally(t *testing.T) {
 		}
 	}
 
-	tallyMedian := oracle.Tally(input.Ctx, ballot, input.OracleKeeper.RewardBand(input.Ctx), validatorClaimMap)
+	tallyMedian := keeper.Tally(input.Ctx, ballot, types.WeightedMedianAggregator{}, input.OracleKeeper.RewardBand(input.Ctx), validatorClaimMap)
 
 	require.Equal(t, validatorClaimMap, expectedValidatorClaimMap)
 	require.Equal(t, tallyMedian.MulInt64(100).TruncateInt(), weightedMedian.MulInt64(100).TruncateInt())
@@ -683,3 +683,289 @@ func makeAggregatePrevoteAndVote(t *testing.T, input keeper.TestInput, h sdk.Han
 	_, err = h(input.Ctx.WithBlockHeight(height+1), voteMsg)
 	require.NoError(t, err)
 }
+
+func TestValidatorPerformance(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+
+	// Account 1 and 3 vote, Account 2 abstains
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: sdk.ZeroDec()}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 2)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// Account 1 and 2 vote, Account 3 misses
+	input.Ctx = input.Ctx.WithBlockHeight(2)
+	makeAggregatePrevoteAndVote(t, input, h, 1, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 1, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	performances := input.OracleKeeper.GetValidatorPerformances(input.Ctx, keeper.ValAddrs[0])
+	require.Len(t, performances, 1)
+	require.Equal(t, uint64(0), performances[0].Window)
+	require.Equal(t, uint64(2), performances[0].VotePeriods)
+	require.Equal(t, uint64(2), performances[0].Votes)
+	require.Equal(t, uint64(0), performances[0].Misses)
+	require.Equal(t, []types.DenomPerformance{{
+		Denom:            core.MicroKRWDenom,
+		Votes:            2,
+		Wins:             2,
+		TotalDeviation:   sdk.ZeroDec(),
+		AverageDeviation: sdk.ZeroDec(),
+	}}, performances[0].Denoms)
+
+	performance := input.OracleKeeper.GetValidatorPerformances(input.Ctx, keeper.ValAddrs[1])[0]
+	require.Equal(t, uint64(2), performance.Votes)
+	require.Equal(t, uint64(1), performance.Abstains)
+	require.Equal(t, uint64(1), performance.Denoms[0].Votes)
+
+	performance = input.OracleKeeper.GetValidatorPerformances(input.Ctx, keeper.ValAddrs[2])[0]
+	require.Equal(t, uint64(1), performance.Votes)
+	require.Equal(t, uint64(1), performance.Misses)
+
+	// the ending window is kept as the previous one, older windows are pruned
+	slashWindow := int64(input.OracleKeeper.SlashWindow(input.Ctx))
+	input.Ctx = input.Ctx.WithBlockHeight(2*slashWindow - 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	input.Ctx = input.Ctx.WithBlockHeight(2 * slashWindow)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	performances = input.OracleKeeper.GetValidatorPerformances(input.Ctx, keeper.ValAddrs[0])
+	require.Len(t, performances, 2)
+	require.Equal(t, uint64(2), performances[0].Window)
+	require.Equal(t, 2*slashWindow, performances[0].StartHeight)
+	require.Equal(t, uint64(1), performances[0].Misses)
+	require.Equal(t, uint64(1), performances[1].Window)
+	input.OracleKeeper.IterateValidatorPerformances(input.Ctx, 0, func(sdk.ValAddress, types.ValidatorPerformance) bool {
+		require.Fail(t, "window 0 not pruned")
+		return true
+	})
+}
+
+func TestLastVotePeriodSummary(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+
+	// Account 1 and 2 vote, Account 3 abstains
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: sdk.ZeroDec()}}, 2)
+
+	input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	power := sdk.TokensToConsensusPower(stakingAmt, sdk.DefaultPowerReduction)
+	winners := []string{keeper.ValAddrs[0].String(), keeper.ValAddrs[1].String()}
+	sort.Strings(winners)
+
+	summary := input.OracleKeeper.GetLastVotePeriodSummary(input.Ctx)
+	require.Equal(t, int64(1), summary.Height)
+	require.Equal(t, core.MicroKRWDenom, summary.ReferenceDenom)
+	require.Len(t, summary.Ballots, 1)
+	require.Equal(t, 2*power, summary.Ballots[0].BallotPower)
+	require.True(t, summary.Ballots[0].Passed)
+	require.Equal(t, randomExchangeRate, summary.Ballots[0].WeightedMedian)
+	require.Equal(t, randomExchangeRate, summary.Ballots[0].ExchangeRate)
+	require.Equal(t, winners, summary.Ballots[0].Winners)
+	require.Empty(t, summary.Ballots[0].Missed)
+	require.Equal(t, []string{keeper.ValAddrs[2].String()}, summary.Ballots[0].Abstained)
+
+	var ballotEvents int
+	for _, event := range input.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBallot {
+			ballotEvents++
+		}
+	}
+	require.Equal(t, 1, ballotEvents)
+
+	// Account 1 votes only, the ballot does not pass
+	input.Ctx = input.Ctx.WithBlockHeight(2)
+	makeAggregatePrevoteAndVote(t, input, h, 1, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 0)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	summary = input.OracleKeeper.GetLastVotePeriodSummary(input.Ctx)
+	require.Equal(t, int64(2), summary.Height)
+	require.Empty(t, summary.ReferenceDenom)
+	require.Equal(t, power, summary.Ballots[0].BallotPower)
+	require.False(t, summary.Ballots[0].Passed)
+	require.Empty(t, summary.Ballots[0].Winners)
+	require.Equal(t, sdk.ZeroDec(), summary.Ballots[0].ExchangeRate)
+}
+
+func TestPriceFeeds(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax}}
+	params.PriceFeeds = types.PriceFeedList{{Name: "ubtc", Quote: core.MicroKRWDenom, VoteThreshold: sdk.ZeroDec()}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+
+	btcPrice := sdk.NewDec(40000000)
+	krwRate := sdk.DecCoin{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}
+
+	// all accounts vote for the price feed, account 3 misses the vote target
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: "ubtc", Amount: btcPrice}, krwRate}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: "ubtc", Amount: btcPrice}, krwRate}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: "ubtc", Amount: btcPrice}}, 2)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	price, err := input.OracleKeeper.GetPrice(input.Ctx, "ubtc")
+	require.NoError(t, err)
+	require.Equal(t, types.FeedPrice{Name: "ubtc", Quote: core.MicroKRWDenom, Price: btcPrice}, price)
+
+	// the price feed is not a vote target, nor counted in the misses
+	_, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, "ubtc")
+	require.Error(t, err)
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, uint64(1), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[2]))
+
+	rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, "ubtc", core.MicroLunaDenom)
+	require.NoError(t, err)
+	require.Equal(t, btcPrice.Quo(randomExchangeRate), rate)
+
+	// the price is cleared when the ballot fails
+	input.Ctx = input.Ctx.WithBlockHeight(2)
+	makeAggregatePrevoteAndVote(t, input, h, 1, sdk.DecCoins{{Denom: "ubtc", Amount: btcPrice}}, 0)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	_, err = input.OracleKeeper.GetPrice(input.Ctx, "ubtc")
+	require.Error(t, err)
+}
+
+func TestMaxRateChange(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax}}
+	params.MaxRateChange = sdk.NewDecWithPrec(1, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+
+	vote := func(height int64, rate sdk.Dec) {
+		input.Ctx = input.Ctx.WithBlockHeight(height)
+		for i := 0; i < 3; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: rate}}, i)
+		}
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+
+	lastBallot := func() types.BallotSummary {
+		ballots := input.OracleKeeper.GetLastVotePeriodSummary(input.Ctx).Ballots
+		require.Len(t, ballots, 1)
+		return ballots[0]
+	}
+
+	vote(1, sdk.NewDec(1000))
+	rate, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1000), rate)
+	require.False(t, lastBallot().Rejected)
+	require.Equal(t, sdk.NewDec(1000), lastBallot().AppliedExchangeRate)
+
+	// the move beyond the max rate change is rejected, the last exchange rate is kept
+	vote(2, sdk.NewDec(1200))
+	rate, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1000), rate)
+
+	status, err := input.OracleKeeper.GetExchangeRateStatus(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), status.LastUpdateHeight)
+	require.Equal(t, int64(2), status.RejectedHeight)
+
+	// the summary records the rejected exchange rate and the one kept
+	require.True(t, lastBallot().Passed)
+	require.True(t, lastBallot().Rejected)
+	require.Equal(t, sdk.NewDec(1200), lastBallot().ExchangeRate)
+	require.Equal(t, sdk.NewDec(1000), lastBallot().AppliedExchangeRate)
+
+	// and accepted once confirmed by the next vote period
+	vote(3, sdk.NewDec(1200))
+	rate, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1200), rate)
+	require.False(t, lastBallot().Rejected)
+	require.Equal(t, sdk.NewDec(1200), lastBallot().AppliedExchangeRate)
+
+	// the exchange rate of a failing ballot is absent and the reason recorded
+	input.Ctx = input.Ctx.WithBlockHeight(4)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	_, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.Error(t, err)
+
+	status, err = input.OracleKeeper.GetExchangeRateStatus(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), status.LastUpdateHeight)
+	require.Equal(t, int64(4), status.RejectedHeight)
+	require.Contains(t, status.RejectionReason, "ballot power 0")
+}
+
+func TestSimulateTally(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{
+		{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax},
+		{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroSDRDenom, types.DefaultTobinTax)
+
+	// All accounts vote for KRW, only Account 1 votes for SDR
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+		{Denom: core.MicroKRWDenom, Amount: randomExchangeRate},
+		{Denom: core.MicroSDRDenom, Amount: randomExchangeRate},
+	}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 2)
+
+	querier := keeper.NewQuerier(input.OracleKeeper)
+	res, err := querier.SimulateTally(sdk.WrapSDKContext(input.Ctx), &types.QuerySimulateTallyRequest{})
+	require.NoError(t, err)
+
+	summary := res.Summary
+	require.Equal(t, core.MicroKRWDenom, summary.ReferenceDenom)
+	require.Len(t, summary.Ballots, 2)
+	require.Equal(t, core.MicroKRWDenom, summary.Ballots[0].Denom)
+	require.True(t, summary.Ballots[0].Passed)
+	require.Equal(t, randomExchangeRate, summary.Ballots[0].ExchangeRate)
+	require.Len(t, summary.Ballots[0].Winners, 3)
+	require.Equal(t, core.MicroSDRDenom, summary.Ballots[1].Denom)
+	require.False(t, summary.Ballots[1].Passed)
+
+	// Nothing is committed by the simulation
+	_, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.Error(t, err)
+	require.Equal(t, types.VotePeriodSummary{}, input.OracleKeeper.GetLastVotePeriodSummary(input.Ctx))
+	_, err = input.OracleKeeper.GetTobinTax(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+
+	// The tally of the vote period agrees with the simulation
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	require.Equal(t, summary, input.OracleKeeper.GetLastVotePeriodSummary(input.Ctx))
+}
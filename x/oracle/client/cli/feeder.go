@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/oracle/client/feeder"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+const (
+	flagValidator    = "validator"
+	flagProvider     = "provider"
+	flagPollInterval = "poll-interval"
+	flagMaxRetries   = "max-retries"
+)
+
+// GetCmdFeeder implements the oracle feeder command.
+func GetCmdFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeder",
+		Args:  cobra.NoArgs,
+		Short: "Feed the exchange rates of a price provider to the oracle",
+		Long: strings.TrimSpace(`
+Run the oracle prevote/vote cycle with the exchange rates of a price provider: every vote
+period, submit a prevote of the current exchange rates together with the vote revealing
+the prevote of the previous vote period. Salts are generated and kept by the feeder.
+
+The provider is one of
+  - a JSON file of denom to exchange rate, e.g. {"ukrw": "8888.0", "uusd": "1.243"}, read every vote period
+  - an http(s) URL of an endpoint returning the same JSON
+  - "mock:<exchange-rates>" with fixed exchange rates, for local testnets
+
+Only the exchange rates of the vote targets are submitted.
+
+$ terrad oracle feeder --from feeder --validator terravaloper1... --provider ./rates.json
+
+If the from key is not the validator operator, it must be the feeder delegated with set-feeder.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feederAddr := clientCtx.GetFromAddress()
+			if feederAddr.Empty() {
+				return fmt.Errorf("--%s is required", flags.FlagFrom)
+			}
+
+			// By default the feeder is voting on behalf of itself
+			validator := sdk.ValAddress(feederAddr)
+			if valStr, _ := cmd.Flags().GetString(flagValidator); valStr != "" {
+				validator, err = sdk.ValAddressFromBech32(valStr)
+				if err != nil {
+					return errors.Wrap(err, "validator address is invalid")
+				}
+			}
+
+			source, _ := cmd.Flags().GetString(flagProvider)
+			provider, err := feeder.NewProvider(source)
+			if err != nil {
+				return err
+			}
+
+			pollInterval, _ := cmd.Flags().GetDuration(flagPollInterval)
+			maxRetries, _ := cmd.Flags().GetInt(flagMaxRetries)
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "oracle-feeder")
+			broadcaster := feeder.NewTxBroadcaster(clientCtx, tx.NewFactoryCLI(clientCtx, cmd.Flags()), maxRetries, logger)
+			f := feeder.NewFeeder(validator, feederAddr, provider, types.NewQueryClient(clientCtx), broadcaster, logger)
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			if err := f.CheckDelegation(ctx); err != nil {
+				return err
+			}
+
+			latestHeight := func(ctx context.Context) (int64, error) {
+				status, err := clientCtx.Client.Status(ctx)
+				if err != nil {
+					return 0, err
+				}
+
+				return status.SyncInfo.LatestBlockHeight, nil
+			}
+
+			return f.Run(ctx, latestHeight, pollInterval)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagValidator, "", "The validator to vote on behalf of, the from address by default")
+	cmd.Flags().String(flagProvider, "", "The price provider: a JSON file, an http(s) URL or mock:<exchange-rates>")
+	cmd.Flags().Duration(flagPollInterval, time.Second, "The interval the latest height is polled at")
+	cmd.Flags().Int(flagMaxRetries, 3, "The number of retries of a tx rejected for its account sequence")
+	_ = cmd.MarkFlagRequired(flagProvider)
+
+	return cmd
+}
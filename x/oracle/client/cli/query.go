@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -25,13 +26,21 @@ func GetQueryCmd() *cobra.Command {
 
 	oracleQueryCmd.AddCommand(
 		GetCmdQueryExchangeRates(),
+		GetCmdQueryPrices(),
+		GetCmdQueryExchangeRateHistory(),
+		GetCmdQueryExchangeRateTWAP(),
+		GetCmdQueryExchangeRateStatus(),
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
 		GetCmdQueryMissCounter(),
+		GetCmdQueryValidatorPerformance(),
+		GetCmdQueryValidatorStanding(),
 		GetCmdQueryAggregatePrevote(),
 		GetCmdQueryAggregateVote(),
 		GetCmdQueryVoteTargets(),
+		GetCmdQueryLastVotePeriod(),
+		GetCmdQuerySimulateTally(),
 		GetCmdQueryTobinTaxes(),
 	)
 
@@ -89,6 +98,158 @@ $ terrad query oracle exchange-rates ukrw
 	return cmd
 }
 
+// GetCmdQueryPrices implements the query price command.
+func GetCmdQueryPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prices [name]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the current prices of the price feeds",
+		Long: strings.TrimSpace(`
+Query the current prices of the price feeds in their quote assets.
+
+$ terrad query oracle prices
+
+Or, can filter with the name of the price feed
+
+$ terrad query oracle prices ubtc
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.Prices(context.Background(), &types.QueryPricesRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.Price(context.Background(), &types.QueryPriceRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryExchangeRateHistory implements the query exchange rate history command.
+func GetCmdQueryExchangeRateHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-history [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the Luna exchange rates w.r.t an asset kept for the past vote periods",
+		Long: strings.TrimSpace(`
+Query the exchange rates of Luna with an asset kept for the past vote periods, oldest first.
+
+$ terrad query oracle exchange-rate-history uusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExchangeRateHistory(
+				context.Background(),
+				&types.QueryExchangeRateHistoryRequest{Denom: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryExchangeRateTWAP implements the query exchange rate twap command.
+func GetCmdQueryExchangeRateTWAP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-twap [denom] [window]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the time weighted average Luna exchange rate w.r.t an asset",
+		Long: strings.TrimSpace(`
+Query the time weighted average exchange rate of Luna with an asset over the last window blocks.
+
+$ terrad query oracle exchange-rate-twap uusd 600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			window, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExchangeRateTWAP(
+				context.Background(),
+				&types.QueryExchangeRateTWAPRequest{Denom: args[0], Window: window},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryExchangeRateStatus implements the query exchange rate status command.
+func GetCmdQueryExchangeRateStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-status [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the freshness of the exchange rate of a denom",
+		Long: strings.TrimSpace(`
+Query the last exchange rate of Luna accepted for a denom with its update height,
+and the reason the exchange rate of the denom was last rejected.
+
+$ terrad query oracle exchange-rate-status ukrw
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExchangeRateStatus(
+				context.Background(),
+				&types.QueryExchangeRateStatusRequest{Denom: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -227,6 +388,100 @@ $ terrad query oracle miss terravaloper...
 	return cmd
 }
 
+// GetCmdQueryValidatorPerformance implements the query oracle performance of the validator command
+func GetCmdQueryValidatorPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "performance [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the oracle voting performance of a validator",
+		Long: strings.TrimSpace(`
+Query the votes, abstains, misses and per denom wins and deviations from the
+weighted median of a validator in the current and the previous oracle slash window.
+
+$ terrad query oracle performance terravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorPerformance(
+				context.Background(),
+				&types.QueryValidatorPerformanceRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorStanding implements the query validator standing command
+func GetCmdQueryValidatorStanding() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "standing [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the oracle penalty validators face at the end of the slash window",
+		Long: strings.TrimSpace(`
+Query the penalty the bonded validators face at the end of the current oracle slash window
+if they miss no more vote period: good, warning, slash or jail, with the valid vote rate
+and the slash fraction growing with the consecutive slashed windows.
+
+$ terrad query oracle standing
+
+Or, can filter with validator address
+
+$ terrad query oracle standing terravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.ValidatorStandings(context.Background(), &types.QueryValidatorStandingsRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorStanding(
+				context.Background(),
+				&types.QueryValidatorStandingRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryAggregatePrevote implements the query aggregate prevote of the validator command
 func GetCmdQueryAggregatePrevote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -368,6 +623,79 @@ func GetCmdQueryVoteTargets() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryLastVotePeriod implements the query last vote period command.
+func GetCmdQueryLastVotePeriod() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-vote-period",
+		Args:  cobra.NoArgs,
+		Short: "Query the outcome of the ballots of the last vote period",
+		Long: strings.TrimSpace(`
+Query the reference denom and, for each vote target, the ballot power, the threshold,
+the weighted median, the reward spread and the validators which won, missed or abstained
+in the last vote period.
+
+$ terrad query oracle last-vote-period
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastVotePeriod(
+				context.Background(),
+				&types.QueryLastVotePeriodRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySimulateTally implements the query simulate tally command.
+func GetCmdQuerySimulateTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-tally",
+		Args:  cobra.NoArgs,
+		Short: "Query the outcome of the ballots of the current vote period tallied with the votes so far",
+		Long: strings.TrimSpace(`
+Tally the aggregate votes submitted so far in the current vote period without committing,
+and query the reference denom and, for each vote target, whether the ballot passes the
+vote threshold, the exchange rate which would be set and the validators which would win,
+miss or abstain.
+
+$ terrad query oracle simulate-tally
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateTally(
+				context.Background(),
+				&types.QuerySimulateTallyRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryTobinTaxes implements the query params command.
 func GetCmdQueryTobinTaxes() *cobra.Command {
 	cmd := &cobra.Command{
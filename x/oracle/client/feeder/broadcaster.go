@@ -0,0 +1,119 @@
+package feeder
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TxBroadcaster signs the txs with the from key of the client context and broadcasts them,
+// tracking the account sequence locally and refreshing it on a sequence mismatch
+type TxBroadcaster struct {
+	clientCtx  client.Context
+	txf        tx.Factory
+	maxRetries int
+	logger     log.Logger
+}
+
+var _ Broadcaster = &TxBroadcaster{}
+
+// NewTxBroadcaster returns a TxBroadcaster retrying maxRetries times on a sequence mismatch
+func NewTxBroadcaster(clientCtx client.Context, txf tx.Factory, maxRetries int, logger log.Logger) *TxBroadcaster {
+	return &TxBroadcaster{
+		clientCtx:  clientCtx,
+		txf:        txf.WithAccountNumber(0).WithSequence(0),
+		maxRetries: maxRetries,
+		logger:     logger,
+	}
+}
+
+// Broadcast implements Broadcaster
+func (b *TxBroadcaster) Broadcast(_ context.Context, msgs ...sdk.Msg) error {
+	return retryOnSequenceMismatch(b.maxRetries, b.logger, func() error {
+		err := b.broadcast(msgs...)
+		if err != nil {
+			// refresh the account sequence on the next attempt
+			b.txf = b.txf.WithSequence(0)
+		}
+
+		return err
+	})
+}
+
+func (b *TxBroadcaster) broadcast(msgs ...sdk.Msg) error {
+	from := b.clientCtx.GetFromAddress()
+	if b.txf.AccountNumber() == 0 || b.txf.Sequence() == 0 {
+		num, seq, err := b.txf.AccountRetriever().GetAccountNumberSequence(b.clientCtx, from)
+		if err != nil {
+			return err
+		}
+
+		b.txf = b.txf.WithAccountNumber(num).WithSequence(seq)
+	}
+
+	txf := b.txf
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(b.clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	txBuilder, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return err
+	}
+
+	txBuilder.SetFeeGranter(b.clientCtx.GetFeeGranterAddress())
+	if err := tx.Sign(txf, b.clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return err
+	}
+
+	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := b.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	if res.Code != 0 {
+		return sdkerrors.ABCIError(res.Codespace, res.Code, res.RawLog)
+	}
+
+	b.logger.Info("broadcast oracle tx", "txhash", res.TxHash, "sequence", txf.Sequence())
+	b.txf = b.txf.WithSequence(txf.Sequence() + 1)
+
+	return nil
+}
+
+// retryOnSequenceMismatch calls broadcast until it does not fail with a sequence mismatch,
+// at most maxRetries more times
+func retryOnSequenceMismatch(maxRetries int, logger log.Logger, broadcast func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := broadcast()
+		if err == nil || !isSequenceMismatch(err) || attempt >= maxRetries {
+			return err
+		}
+
+		logger.Info("account sequence mismatch, retrying", "attempt", attempt+1, "err", err)
+	}
+}
+
+// isSequenceMismatch returns true if the tx was rejected for its account sequence,
+// either by the ante handler or by the simulation of its gas
+func isSequenceMismatch(err error) bool {
+	return errors.Is(err, sdkerrors.ErrWrongSequence) ||
+		strings.Contains(err.Error(), sdkerrors.ErrWrongSequence.Error())
+}
@@ -0,0 +1,196 @@
+package feeder
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+// Querier is the part of the oracle query client used by the feeder
+type Querier interface {
+	Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error)
+	VoteTargets(ctx context.Context, in *types.QueryVoteTargetsRequest, opts ...grpc.CallOption) (*types.QueryVoteTargetsResponse, error)
+	FeederDelegation(ctx context.Context, in *types.QueryFeederDelegationRequest, opts ...grpc.CallOption) (*types.QueryFeederDelegationResponse, error)
+}
+
+// Broadcaster signs and broadcasts the msgs in a tx
+type Broadcaster interface {
+	Broadcast(ctx context.Context, msgs ...sdk.Msg) error
+}
+
+// prevote is the last prevote submitted, revealed by the vote of the next vote period
+type prevote struct {
+	salt          string
+	exchangeRates string
+	period        uint64
+}
+
+// Feeder submits the exchange rates of a provider for a validator: every vote period
+// a prevote of the current rates, together with the vote revealing the prevote of the
+// previous vote period
+type Feeder struct {
+	validator   sdk.ValAddress
+	feeder      sdk.AccAddress
+	provider    Provider
+	querier     Querier
+	broadcaster Broadcaster
+	logger      log.Logger
+
+	// period of the last submission, when submitted
+	submitted  bool
+	lastPeriod uint64
+	prevote    *prevote
+}
+
+// NewFeeder returns a Feeder voting for the validator with the feeder account
+func NewFeeder(validator sdk.ValAddress, feeder sdk.AccAddress, provider Provider, querier Querier, broadcaster Broadcaster, logger log.Logger) *Feeder {
+	return &Feeder{
+		validator:   validator,
+		feeder:      feeder,
+		provider:    provider,
+		querier:     querier,
+		broadcaster: broadcaster,
+		logger:      logger,
+	}
+}
+
+// CheckDelegation returns an error if the feeder account may not vote for the validator
+func (f *Feeder) CheckDelegation(ctx context.Context) error {
+	res, err := f.querier.FeederDelegation(ctx, &types.QueryFeederDelegationRequest{ValidatorAddr: f.validator.String()})
+	if err != nil {
+		return err
+	}
+
+	if res.FeederAddr != f.feeder.String() {
+		return fmt.Errorf("%s is not the feeder of %s, the feeder is %s", f.feeder, f.validator, res.FeederAddr)
+	}
+
+	return nil
+}
+
+// Run polls the latest height and runs the prevote/vote cycle until the context is done
+func (f *Feeder) Run(ctx context.Context, latestHeight func(context.Context) (int64, error), pollInterval time.Duration) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		height, err := latestHeight(ctx)
+		if err != nil {
+			f.logger.Error("failed to get the latest height", "err", err)
+			continue
+		}
+
+		if err := f.Tick(ctx, height); err != nil {
+			f.logger.Error("failed to feed the exchange rates", "height", height, "err", err)
+		}
+	}
+}
+
+// Tick submits the prevote, and the vote of the previous one, once per vote period. The tx is
+// expected in the block after the latest height; when it lands in a later vote period the
+// vote is rejected and the cycle restarts with the prevote of the next vote period.
+// A failed submission is retried on the next tick of the same vote period.
+func (f *Feeder) Tick(ctx context.Context, latestHeight int64) error {
+	params, err := f.querier.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	period := uint64(latestHeight+1) / params.Params.VotePeriod
+	if f.submitted && period <= f.lastPeriod {
+		return nil
+	}
+
+	var msgs []sdk.Msg
+	if f.prevote != nil && f.prevote.period+1 == period {
+		msgs = append(msgs, types.NewMsgAggregateExchangeRateVote(f.prevote.salt, f.prevote.exchangeRates, f.feeder, f.validator))
+	}
+
+	exchangeRates, providerErr := f.exchangeRates(ctx, params.Params.PriceFeeds)
+
+	var next *prevote
+	if providerErr == nil {
+		salt, err := newSalt()
+		if err != nil {
+			return err
+		}
+
+		next = &prevote{salt: salt, exchangeRates: exchangeRates, period: period}
+		hash := types.GetAggregateVoteHash(salt, exchangeRates, f.validator)
+		msgs = append(msgs, types.NewMsgAggregateExchangeRatePrevote(hash, f.feeder, f.validator))
+	}
+
+	if len(msgs) != 0 {
+		if err := f.broadcaster.Broadcast(ctx, msgs...); err != nil {
+			return err
+		}
+
+		f.logger.Info("submitted oracle msgs", "period", period, "msgs", len(msgs), "exchange_rates", exchangeRates)
+	}
+
+	f.submitted = true
+	f.lastPeriod = period
+	f.prevote = next
+
+	return providerErr
+}
+
+// exchangeRates returns the exchange rates of the provider for the vote targets and
+// the prices of the price feeds, formatted as the exchange rates of a vote
+func (f *Feeder) exchangeRates(ctx context.Context, priceFeeds types.PriceFeedList) (string, error) {
+	rates, err := f.provider.ExchangeRates(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := f.querier.VoteTargets(ctx, &types.QueryVoteTargetsRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	denoms := res.VoteTargets
+	for _, feed := range priceFeeds {
+		denoms = append(denoms, feed.Name)
+	}
+
+	voteRates := sdk.DecCoins{}
+	for _, denom := range denoms {
+		if rate := rates.AmountOf(denom); rate.IsPositive() {
+			voteRates = append(voteRates, sdk.NewDecCoinFromDec(denom, rate))
+		} else {
+			f.logger.Info("no exchange rate to vote", "denom", denom)
+		}
+	}
+
+	if voteRates.Empty() {
+		return "", fmt.Errorf("no exchange rate of the vote targets %v", res.VoteTargets)
+	}
+
+	return voteRates.Sort().String(), nil
+}
+
+// newSalt returns a random salt of the maximum length
+func newSalt() (string, error) {
+	bz := make([]byte, 2)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bz), nil
+}
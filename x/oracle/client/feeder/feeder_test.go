@@ -0,0 +1,194 @@
+package feeder
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+var (
+	validator = sdk.ValAddress([]byte("validator___________"))
+	feederAcc = sdk.AccAddress([]byte("feeder______________"))
+)
+
+type mockQuerier struct {
+	votePeriod  uint64
+	voteTargets []string
+	priceFeeds  types.PriceFeedList
+	feeder      sdk.AccAddress
+}
+
+func (q mockQuerier) Params(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	params := types.DefaultParams()
+	params.VotePeriod = q.votePeriod
+	params.PriceFeeds = q.priceFeeds
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (q mockQuerier) VoteTargets(context.Context, *types.QueryVoteTargetsRequest, ...grpc.CallOption) (*types.QueryVoteTargetsResponse, error) {
+	return &types.QueryVoteTargetsResponse{VoteTargets: q.voteTargets}, nil
+}
+
+func (q mockQuerier) FeederDelegation(context.Context, *types.QueryFeederDelegationRequest, ...grpc.CallOption) (*types.QueryFeederDelegationResponse, error) {
+	return &types.QueryFeederDelegationResponse{FeederAddr: q.feeder.String()}, nil
+}
+
+type mockBroadcaster struct {
+	txs [][]sdk.Msg
+	err error
+}
+
+func (b *mockBroadcaster) Broadcast(_ context.Context, msgs ...sdk.Msg) error {
+	if b.err != nil {
+		return b.err
+	}
+
+	b.txs = append(b.txs, msgs)
+	return nil
+}
+
+func newTestFeeder(provider Provider) (*Feeder, *mockBroadcaster) {
+	querier := mockQuerier{
+		votePeriod:  5,
+		voteTargets: []string{core.MicroKRWDenom, core.MicroUSDDenom},
+		feeder:      feederAcc,
+	}
+
+	broadcaster := &mockBroadcaster{}
+	return NewFeeder(validator, feederAcc, provider, querier, broadcaster, log.NewNopLogger()), broadcaster
+}
+
+func TestFeederCycle(t *testing.T) {
+	rates := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(core.MicroKRWDenom, sdk.NewDec(8888)),
+		sdk.NewDecCoinFromDec(core.MicroUSDDenom, sdk.NewDecWithPrec(1243, 3)),
+		sdk.NewDecCoinFromDec(core.MicroMNTDenom, sdk.NewDec(3000)),
+	)
+	f, broadcaster := newTestFeeder(MockProvider{Rates: rates})
+	require.NoError(t, f.CheckDelegation(context.Background()))
+
+	// the tx of height 4 lands in period 1, a prevote only
+	require.NoError(t, f.Tick(context.Background(), 4))
+	require.Len(t, broadcaster.txs, 1)
+	require.Len(t, broadcaster.txs[0], 1)
+	prevote := broadcaster.txs[0][0].(*types.MsgAggregateExchangeRatePrevote)
+	require.Equal(t, validator.String(), prevote.Validator)
+	require.Equal(t, feederAcc.String(), prevote.Feeder)
+
+	// once per period
+	require.NoError(t, f.Tick(context.Background(), 5))
+	require.NoError(t, f.Tick(context.Background(), 8))
+	require.Len(t, broadcaster.txs, 1)
+
+	// the vote reveals the prevote of the previous period, with a new prevote
+	require.NoError(t, f.Tick(context.Background(), 9))
+	require.Len(t, broadcaster.txs, 2)
+	require.Len(t, broadcaster.txs[1], 2)
+	vote := broadcaster.txs[1][0].(*types.MsgAggregateExchangeRateVote)
+	require.Equal(t, "8888.000000000000000000ukrw,1.243000000000000000uusd", vote.ExchangeRates)
+	require.Equal(t, prevote.Hash, types.GetAggregateVoteHash(vote.Salt, vote.ExchangeRates, validator).String())
+	require.NoError(t, vote.ValidateBasic())
+	require.IsType(t, &types.MsgAggregateExchangeRatePrevote{}, broadcaster.txs[1][1])
+
+	// a failed broadcast is retried in the same period
+	broadcaster.err = errors.New("broadcast failed")
+	require.Error(t, f.Tick(context.Background(), 14))
+	broadcaster.err = nil
+	require.NoError(t, f.Tick(context.Background(), 15))
+	require.Len(t, broadcaster.txs, 3)
+	require.Len(t, broadcaster.txs[2], 2)
+
+	// a skipped period restarts the cycle with a prevote only
+	require.NoError(t, f.Tick(context.Background(), 29))
+	require.Len(t, broadcaster.txs, 4)
+	require.Len(t, broadcaster.txs[3], 1)
+}
+
+func TestFeederProviderError(t *testing.T) {
+	provider := &MockProvider{Rates: sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroKRWDenom, sdk.NewDec(8888)))}
+	f, broadcaster := newTestFeeder(provider)
+
+	require.NoError(t, f.Tick(context.Background(), 4))
+
+	// the pending prevote is still revealed, without a new prevote
+	provider.Err = errors.New("provider down")
+	require.Error(t, f.Tick(context.Background(), 9))
+	require.Len(t, broadcaster.txs, 2)
+	require.Len(t, broadcaster.txs[1], 1)
+	require.IsType(t, &types.MsgAggregateExchangeRateVote{}, broadcaster.txs[1][0])
+
+	// nothing to reveal nor to prevote
+	require.Error(t, f.Tick(context.Background(), 14))
+	require.Len(t, broadcaster.txs, 2)
+
+	// no exchange rate of the vote targets
+	provider.Err = nil
+	provider.Rates = sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroMNTDenom, sdk.NewDec(3000)))
+	require.Error(t, f.Tick(context.Background(), 19))
+	require.Len(t, broadcaster.txs, 2)
+}
+
+func TestFeederPriceFeeds(t *testing.T) {
+	rates := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(core.MicroUSDDenom, sdk.NewDecWithPrec(1243, 3)),
+		sdk.NewDecCoinFromDec("ubtc", sdk.NewDec(30000)),
+	)
+	f, _ := newTestFeeder(MockProvider{Rates: rates})
+
+	// the prices of the price feeds are voted along the vote targets
+	exchangeRates, err := f.exchangeRates(context.Background(), types.PriceFeedList{{Name: "ubtc", Quote: core.MicroUSDDenom}})
+	require.NoError(t, err)
+	require.Equal(t, "30000.000000000000000000ubtc,1.243000000000000000uusd", exchangeRates)
+
+	exchangeRates, err = f.exchangeRates(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "1.243000000000000000uusd", exchangeRates)
+}
+
+func TestFeederCheckDelegation(t *testing.T) {
+	f, _ := newTestFeeder(MockProvider{})
+	f.feeder = sdk.AccAddress([]byte("other_______________"))
+	require.Error(t, f.CheckDelegation(context.Background()))
+}
+
+func TestRetryOnSequenceMismatch(t *testing.T) {
+	calls := 0
+	err := retryOnSequenceMismatch(2, log.NewNopLogger(), func() error {
+		calls++
+		if calls < 3 {
+			return sdkerrors.ABCIError(sdkerrors.RootCodespace, sdkerrors.ErrWrongSequence.ABCICode(), "account sequence mismatch, expected 5, got 4")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	// gives up after the retries
+	calls = 0
+	err = retryOnSequenceMismatch(1, log.NewNopLogger(), func() error {
+		calls++
+		return sdkerrors.ABCIError(sdkerrors.RootCodespace, sdkerrors.ErrWrongSequence.ABCICode(), "account sequence mismatch, expected 5, got 4")
+	})
+	require.Error(t, err)
+	require.Equal(t, 2, calls)
+
+	// other errors are not retried
+	calls = 0
+	err = retryOnSequenceMismatch(3, log.NewNopLogger(), func() error {
+		calls++
+		return errors.New("insufficient fees")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
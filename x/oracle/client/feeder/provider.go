@@ -0,0 +1,134 @@
+package feeder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Provider returns the exchange rates of Luna denominated in the denoms
+type Provider interface {
+	ExchangeRates(ctx context.Context) (sdk.DecCoins, error)
+}
+
+// NewProvider returns the provider of the source: an http(s) URL of a JSON
+// endpoint, "mock:<exchange-rates>" with fixed rates, or else a JSON file path
+func NewProvider(source string) (Provider, error) {
+	switch {
+	case strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://"):
+		return NewHTTPProvider(source, 10*time.Second), nil
+	case strings.HasPrefix(source, "mock:"):
+		rates, err := sdk.ParseDecCoins(strings.TrimPrefix(source, "mock:"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid mock exchange rates: %w", err)
+		}
+
+		return MockProvider{Rates: rates}, nil
+	case source == "":
+		return nil, fmt.Errorf("empty provider source")
+	default:
+		return FileProvider{Path: source}, nil
+	}
+}
+
+// parseExchangeRates parses the exchange rates given as a JSON object of denom to rate,
+// e.g. {"ukrw": "8888.0", "uusd": "1.243"}
+func parseExchangeRates(bz []byte) (sdk.DecCoins, error) {
+	var rates map[string]string
+	if err := json.Unmarshal(bz, &rates); err != nil {
+		return nil, fmt.Errorf("invalid exchange rates: %w", err)
+	}
+
+	exchangeRates := sdk.DecCoins{}
+	for denom, rateStr := range rates {
+		rate, err := sdk.NewDecFromStr(rateStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rate of %s: %w", denom, err)
+		}
+
+		if !rate.IsPositive() {
+			return nil, fmt.Errorf("exchange rate of %s must be positive: %s", denom, rate)
+		}
+
+		exchangeRates = append(exchangeRates, sdk.NewDecCoinFromDec(denom, rate))
+	}
+
+	return exchangeRates.Sort(), nil
+}
+
+// FileProvider reads the exchange rates from a JSON file on every call,
+// so another process can keep it up to date
+type FileProvider struct {
+	Path string
+}
+
+// ExchangeRates implements Provider
+func (p FileProvider) ExchangeRates(_ context.Context) (sdk.DecCoins, error) {
+	bz, err := os.ReadFile(p.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseExchangeRates(bz)
+}
+
+// HTTPProvider fetches the exchange rates from a JSON endpoint
+type HTTPProvider struct {
+	URL    string
+	client *http.Client
+}
+
+// NewHTTPProvider returns a HTTPProvider of the endpoint
+func NewHTTPProvider(url string, timeout time.Duration) HTTPProvider {
+	return HTTPProvider{
+		URL:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// ExchangeRates implements Provider
+func (p HTTPProvider) ExchangeRates(ctx context.Context) (sdk.DecCoins, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status of %s: %s", p.URL, res.Status)
+	}
+
+	bz, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseExchangeRates(bz)
+}
+
+// MockProvider returns fixed exchange rates, or Err when set
+type MockProvider struct {
+	Rates sdk.DecCoins
+	Err   error
+}
+
+// ExchangeRates implements Provider
+func (p MockProvider) ExchangeRates(_ context.Context) (sdk.DecCoins, error) {
+	if p.Err != nil {
+		return nil, p.Err
+	}
+
+	return p.Rates, nil
+}
@@ -0,0 +1,82 @@
+package feeder
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+)
+
+const ratesJSON = `{"uusd": "1.243", "ukrw": "8888.0"}`
+
+var expectedRates = sdk.NewDecCoins(
+	sdk.NewDecCoinFromDec(core.MicroKRWDenom, sdk.NewDec(8888)),
+	sdk.NewDecCoinFromDec(core.MicroUSDDenom, sdk.NewDecWithPrec(1243, 3)),
+)
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	require.NoError(t, os.WriteFile(path, []byte(ratesJSON), 0o600))
+
+	provider, err := NewProvider(path)
+	require.NoError(t, err)
+	require.Equal(t, FileProvider{Path: path}, provider)
+
+	rates, err := provider.ExchangeRates(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expectedRates, rates)
+
+	// read on every call
+	require.NoError(t, os.WriteFile(path, []byte(`{"ukrw": "-1"}`), 0o600))
+	_, err = provider.ExchangeRates(context.Background())
+	require.Error(t, err)
+
+	_, err = FileProvider{Path: filepath.Join(t.TempDir(), "missing.json")}.ExchangeRates(context.Background())
+	require.Error(t, err)
+}
+
+func TestHTTPProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rates" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(ratesJSON))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(server.URL + "/rates")
+	require.NoError(t, err)
+
+	rates, err := provider.ExchangeRates(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expectedRates, rates)
+
+	_, err = NewHTTPProvider(server.URL+"/missing", time.Second).ExchangeRates(context.Background())
+	require.Error(t, err)
+}
+
+func TestMockProvider(t *testing.T) {
+	provider, err := NewProvider("mock:8888ukrw,1.243uusd")
+	require.NoError(t, err)
+
+	rates, err := provider.ExchangeRates(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expectedRates, rates)
+
+	_, err = NewProvider("mock:invalid")
+	require.Error(t, err)
+
+	_, err = NewProvider("")
+	require.Error(t, err)
+}
@@ -30,6 +30,14 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetLunaExchangeRate(ctx, ex.Denom, ex.ExchangeRate)
 	}
 
+	for _, price := range data.Prices {
+		keeper.SetPrice(ctx, price)
+	}
+
+	for _, status := range data.ExchangeRateStatuses {
+		keeper.SetExchangeRateStatus(ctx, status)
+	}
+
 	for _, mc := range data.MissCounters {
 		operator, err := sdk.ValAddressFromBech32(mc.ValidatorAddress)
 		if err != nil {
@@ -39,6 +47,15 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetMissCounter(ctx, operator, mc.MissCounter)
 	}
 
+	for _, bc := range data.BadWindowCounters {
+		operator, err := sdk.ValAddressFromBech32(bc.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetBadWindowCounter(ctx, operator, bc.BadWindowCounter)
+	}
+
 	for _, ap := range data.AggregateExchangeRatePrevotes {
 		valAddr, err := sdk.ValAddressFromBech32(ap.Voter)
 		if err != nil {
@@ -69,6 +86,11 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 
 	keeper.SetParams(ctx, data.Params)
 
+	// the size of the histories follows the vote period of the params
+	for _, history := range data.ExchangeRateHistories {
+		keeper.SetExchangeRateHistory(ctx, history.Denom, history.Count, history.ExchangeRates)
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.GetOracleAccount(ctx)
 	if moduleAcc == nil {
@@ -96,6 +118,18 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	prices := []types.FeedPrice{}
+	keeper.IteratePrices(ctx, func(price types.FeedPrice) (stop bool) {
+		prices = append(prices, price)
+		return false
+	})
+
+	exchangeRateStatuses := []types.ExchangeRateStatus{}
+	keeper.IterateExchangeRateStatuses(ctx, func(status types.ExchangeRateStatus) (stop bool) {
+		exchangeRateStatuses = append(exchangeRateStatuses, status)
+		return false
+	})
+
 	missCounters := []types.MissCounter{}
 	keeper.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) (stop bool) {
 		missCounters = append(missCounters, types.MissCounter{
@@ -123,11 +157,34 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	badWindowCounters := []types.BadWindowCounter{}
+	keeper.IterateBadWindowCounters(ctx, func(operator sdk.ValAddress, badWindowCounter uint64) (stop bool) {
+		badWindowCounters = append(badWindowCounters, types.BadWindowCounter{
+			ValidatorAddress: operator.String(),
+			BadWindowCounter: badWindowCounter,
+		})
+		return false
+	})
+
+	exchangeRateHistories := []types.ExchangeRateHistory{}
+	keeper.IterateExchangeRateHistoryCounters(ctx, func(denom string, counter types.ExchangeRateHistoryCounter) (stop bool) {
+		exchangeRateHistories = append(exchangeRateHistories, types.ExchangeRateHistory{
+			Denom:         denom,
+			Count:         counter.Count,
+			ExchangeRates: keeper.GetHistoricalExchangeRates(ctx, denom),
+		})
+		return false
+	})
+
 	return types.NewGenesisState(params,
 		exchangeRates,
 		feederDelegations,
 		missCounters,
 		aggregateExchangeRatePrevotes,
 		aggregateExchangeRateVotes,
-		tobinTaxes)
+		tobinTaxes,
+		badWindowCounters,
+		prices,
+		exchangeRateStatuses,
+		exchangeRateHistories)
 }
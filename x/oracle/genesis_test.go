@@ -21,11 +21,31 @@ func TestExportInitGenesis(t *testing.T) {
 	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Denom: "foo", ExchangeRate: sdk.NewDec(123)}}, keeper.ValAddrs[0]))
 	input.OracleKeeper.SetTobinTax(input.Ctx, "denom", sdk.NewDecWithPrec(123, 3))
 	input.OracleKeeper.SetTobinTax(input.Ctx, "denom2", sdk.NewDecWithPrec(123, 3))
+	input.OracleKeeper.SetBadWindowCounter(input.Ctx, keeper.ValAddrs[0], 2)
+	input.OracleKeeper.SetPrice(input.Ctx, types.FeedPrice{Name: "ubtc", Quote: "uusd", Price: sdk.NewDec(30000)})
+	input.OracleKeeper.SetExchangeRateStatus(input.Ctx, types.ExchangeRateStatus{Denom: "denom", ExchangeRate: sdk.NewDec(123), LastUpdateHeight: 10, LastMedian: sdk.NewDec(150), RejectedHeight: 20, RejectionReason: "reason"})
+	for i := int64(1); i <= 3; i++ {
+		input.OracleKeeper.AddHistoricalExchangeRate(input.Ctx.WithBlockHeight(i), "denom", sdk.NewDec(i))
+	}
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
+	require.Equal(t, []types.ExchangeRateHistory{{
+		Denom: "denom",
+		Count: 3,
+		ExchangeRates: []types.HistoricalExchangeRate{
+			{Height: 1, ExchangeRate: sdk.NewDec(1)},
+			{Height: 2, ExchangeRate: sdk.NewDec(2)},
+			{Height: 3, ExchangeRate: sdk.NewDec(3)},
+		},
+	}}, genesis.ExchangeRateHistories)
 
 	newInput := keeper.CreateTestInput(t)
 	oracle.InitGenesis(newInput.Ctx, newInput.OracleKeeper, genesis)
 	newGenesis := oracle.ExportGenesis(newInput.Ctx, newInput.OracleKeeper)
 
 	require.Equal(t, genesis, newGenesis)
+
+	// the imported history goes on where the exported one stopped
+	newInput.OracleKeeper.AddHistoricalExchangeRate(newInput.Ctx.WithBlockHeight(4), "denom", sdk.NewDec(4))
+	require.Equal(t, uint64(4), newInput.OracleKeeper.GetExchangeRateHistoryCount(newInput.Ctx, "denom"))
+	require.Len(t, newInput.OracleKeeper.GetHistoricalExchangeRates(newInput.Ctx, "denom"), 4)
 }
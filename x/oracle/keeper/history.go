@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+// ExchangeRateHistorySize returns the number of exchange rates kept per denom,
+// a day of vote periods
+func (k Keeper) ExchangeRateHistorySize(ctx sdk.Context) uint64 {
+	size := uint64(core.BlocksPerDay) / k.VotePeriod(ctx)
+	if size == 0 {
+		return 1
+	}
+
+	return size
+}
+
+// GetExchangeRateHistoryCounter returns the number of exchange rates ever recorded for the denom
+// and the size of the ring buffer they were recorded in
+func (k Keeper) GetExchangeRateHistoryCounter(ctx sdk.Context, denom string) (counter types.ExchangeRateHistoryCounter) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetExchangeRateHistoryCountKey(denom))
+	if bz == nil {
+		return counter
+	}
+
+	k.cdc.MustUnmarshal(bz, &counter)
+	return counter
+}
+
+// GetExchangeRateHistoryCount returns the number of exchange rates ever recorded for the denom
+func (k Keeper) GetExchangeRateHistoryCount(ctx sdk.Context, denom string) uint64 {
+	return k.GetExchangeRateHistoryCounter(ctx, denom).Count
+}
+
+// AddHistoricalExchangeRate records the exchange rate of the denom set at the current height;
+// the history is a ring buffer of ExchangeRateHistorySize rates overwriting the oldest one,
+// laid out again when the vote period changed the size since the last rate was recorded
+func (k Keeper) AddHistoricalExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	counter := k.GetExchangeRateHistoryCounter(ctx, denom)
+	if size := k.ExchangeRateHistorySize(ctx); counter.HistorySize != size {
+		k.SetExchangeRateHistory(ctx, denom, counter.Count, k.GetHistoricalExchangeRates(ctx, denom))
+		counter.HistorySize = size
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.HistoricalExchangeRate{
+		Height:       ctx.BlockHeight(),
+		ExchangeRate: exchangeRate,
+	})
+	store.Set(types.GetHistoricalExchangeRateKey(denom, counter.Count%counter.HistorySize), bz)
+
+	counter.Count++
+	store.Set(types.GetExchangeRateHistoryCountKey(denom), k.cdc.MustMarshal(&counter))
+}
+
+// SetExchangeRateHistory replaces the history of the denom by the last of the rates, oldest first,
+// of count rates ever recorded, kept in a ring buffer of ExchangeRateHistorySize rates
+func (k Keeper) SetExchangeRateHistory(ctx sdk.Context, denom string, count uint64, rates []types.HistoricalExchangeRate) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetHistoricalExchangeRatePrefix(denom))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	counter := types.ExchangeRateHistoryCounter{Count: count, HistorySize: k.ExchangeRateHistorySize(ctx)}
+	if uint64(len(rates)) > counter.HistorySize {
+		rates = rates[uint64(len(rates))-counter.HistorySize:]
+	}
+
+	first := count - uint64(len(rates))
+	for i := range rates {
+		store.Set(types.GetHistoricalExchangeRateKey(denom, (first+uint64(i))%counter.HistorySize), k.cdc.MustMarshal(&rates[i]))
+	}
+
+	store.Set(types.GetExchangeRateHistoryCountKey(denom), k.cdc.MustMarshal(&counter))
+}
+
+// IterateHistoricalExchangeRates iterates over the exchange rates kept for the denom, oldest first
+func (k Keeper) IterateHistoricalExchangeRates(ctx sdk.Context, denom string, handler func(rate types.HistoricalExchangeRate) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	counter := k.GetExchangeRateHistoryCounter(ctx, denom)
+
+	first := uint64(0)
+	if counter.Count > counter.HistorySize {
+		first = counter.Count - counter.HistorySize
+	}
+
+	for i := first; i < counter.Count; i++ {
+		// the slots of the rates dropped when the ring buffer was resized stay empty
+		bz := store.Get(types.GetHistoricalExchangeRateKey(denom, i%counter.HistorySize))
+		if bz == nil {
+			continue
+		}
+
+		var rate types.HistoricalExchangeRate
+		k.cdc.MustUnmarshal(bz, &rate)
+		if handler(rate) {
+			break
+		}
+	}
+}
+
+// GetHistoricalExchangeRates returns the exchange rates kept for the denom, oldest first
+func (k Keeper) GetHistoricalExchangeRates(ctx sdk.Context, denom string) (rates []types.HistoricalExchangeRate) {
+	k.IterateHistoricalExchangeRates(ctx, denom, func(rate types.HistoricalExchangeRate) bool {
+		rates = append(rates, rate)
+		return false
+	})
+
+	return rates
+}
+
+// IterateExchangeRateHistoryCounters iterates over the denoms with a recorded exchange rate
+// and their history counters
+func (k Keeper) IterateExchangeRateHistoryCounters(ctx sdk.Context, handler func(denom string, counter types.ExchangeRateHistoryCounter) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ExchangeRateHistoryCountKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.ExchangeRateHistoryCountKey):])
+
+		var counter types.ExchangeRateHistoryCounter
+		k.cdc.MustUnmarshal(iter.Value(), &counter)
+		if handler(denom, counter) {
+			break
+		}
+	}
+}
+
+// GetExchangeRateTWAP returns the average of the exchange rates of the denom over the
+// last window blocks, each rate weighted by the number of blocks until the next one.
+// Only the part of the window covered by the history is averaged; when the latest
+// rate was set at the current height and the window holds no other, it is returned.
+func (k Keeper) GetExchangeRateTWAP(ctx sdk.Context, denom string, window uint64) (sdk.Dec, error) {
+	rates := k.GetHistoricalExchangeRates(ctx, denom)
+	if len(rates) == 0 {
+		return sdk.ZeroDec(), sdkerrors.Wrap(types.ErrNoExchangeRateHistory, denom)
+	}
+
+	end := ctx.BlockHeight()
+	start := end - int64(window)
+
+	sum := sdk.ZeroDec()
+	weight := int64(0)
+	for i, rate := range rates {
+		from, to := rate.Height, end
+		if i+1 < len(rates) {
+			to = rates[i+1].Height
+		}
+
+		if from < start {
+			from = start
+		}
+
+		if to <= from {
+			continue
+		}
+
+		sum = sum.Add(rate.ExchangeRate.MulInt64(to - from))
+		weight += to - from
+	}
+
+	if weight == 0 {
+		return rates[len(rates)-1].ExchangeRate, nil
+	}
+
+	return sum.QuoInt64(weight), nil
+}
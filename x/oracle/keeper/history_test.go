@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+func TestHistoricalExchangeRates(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// the ring buffer keeps a day of vote periods
+	size := input.OracleKeeper.ExchangeRateHistorySize(input.Ctx)
+	require.Equal(t, uint64(core.BlocksPerDay)/input.OracleKeeper.VotePeriod(input.Ctx), size)
+
+	total := size + 3
+	for i := uint64(1); i <= total; i++ {
+		ctx := input.Ctx.WithBlockHeight(int64(i))
+		input.OracleKeeper.AddHistoricalExchangeRate(ctx, core.MicroSDRDenom, sdk.NewDec(int64(i)))
+	}
+
+	var rates []types.HistoricalExchangeRate
+	input.OracleKeeper.IterateHistoricalExchangeRates(input.Ctx, core.MicroSDRDenom, func(rate types.HistoricalExchangeRate) bool {
+		rates = append(rates, rate)
+		return false
+	})
+
+	require.Equal(t, total, input.OracleKeeper.GetExchangeRateHistoryCount(input.Ctx, core.MicroSDRDenom))
+	require.Len(t, rates, int(size))
+	require.Equal(t, int64(4), rates[0].Height)
+	require.Equal(t, sdk.NewDec(4), rates[0].ExchangeRate)
+	require.Equal(t, int64(total), rates[len(rates)-1].Height)
+
+	// other denoms are kept apart
+	require.Zero(t, input.OracleKeeper.GetExchangeRateHistoryCount(input.Ctx, core.MicroUSDDenom))
+}
+
+func TestHistoricalExchangeRatesVotePeriodChange(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = core.BlocksPerDay / 4
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	for i := int64(1); i <= 6; i++ {
+		input.OracleKeeper.AddHistoricalExchangeRate(input.Ctx.WithBlockHeight(i), core.MicroSDRDenom, sdk.NewDec(i))
+	}
+
+	// a shorter vote period grows the ring buffer, the rates kept stay in order
+	params.VotePeriod = core.BlocksPerDay / 8
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	for i := int64(7); i <= 9; i++ {
+		input.OracleKeeper.AddHistoricalExchangeRate(input.Ctx.WithBlockHeight(i), core.MicroSDRDenom, sdk.NewDec(i))
+	}
+
+	heights := func() (heights []int64) {
+		for _, rate := range input.OracleKeeper.GetHistoricalExchangeRates(input.Ctx, core.MicroSDRDenom) {
+			heights = append(heights, rate.Height)
+		}
+		return heights
+	}
+	require.Equal(t, []int64{3, 4, 5, 6, 7, 8, 9}, heights())
+	require.Equal(t, types.ExchangeRateHistoryCounter{Count: 9, HistorySize: 8}, input.OracleKeeper.GetExchangeRateHistoryCounter(input.Ctx, core.MicroSDRDenom))
+
+	// a longer one drops the oldest rates
+	params.VotePeriod = core.BlocksPerDay / 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.AddHistoricalExchangeRate(input.Ctx.WithBlockHeight(10), core.MicroSDRDenom, sdk.NewDec(10))
+
+	require.Equal(t, []int64{9, 10}, heights())
+	require.Equal(t, uint64(10), input.OracleKeeper.GetExchangeRateHistoryCount(input.Ctx, core.MicroSDRDenom))
+}
+
+func TestExchangeRateTWAP(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, err := input.OracleKeeper.GetExchangeRateTWAP(input.Ctx, core.MicroSDRDenom, 10)
+	require.ErrorIs(t, err, types.ErrNoExchangeRateHistory)
+
+	// 1 at height 10, 3 at height 20 and 6 at height 30
+	for _, rate := range []struct{ height, rate int64 }{{10, 1}, {20, 3}, {30, 6}} {
+		input.OracleKeeper.AddHistoricalExchangeRate(input.Ctx.WithBlockHeight(rate.height), core.MicroSDRDenom, sdk.NewDec(rate.rate))
+	}
+
+	ctx := input.Ctx.WithBlockHeight(40)
+	for _, tc := range []struct {
+		window   uint64
+		expected sdk.Dec
+	}{
+		// 6 for 10 blocks
+		{10, sdk.NewDec(6)},
+		// 3 and 6 for 10 blocks each
+		{20, sdk.NewDecWithPrec(45, 1)},
+		// 1 for 5 blocks, 3 and 6 for 10 blocks each
+		{25, sdk.NewDecWithPrec(95, 0).QuoInt64(25)},
+		// only the 30 blocks covered by the history
+		{100, sdk.NewDec(10).QuoInt64(3)},
+	} {
+		twap, err := input.OracleKeeper.GetExchangeRateTWAP(ctx, core.MicroSDRDenom, tc.window)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, twap, tc.window)
+	}
+
+	// the latest rate is returned when set at the current height
+	twap, err := input.OracleKeeper.GetExchangeRateTWAP(input.Ctx.WithBlockHeight(30), core.MicroSDRDenom, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(6), twap)
+}
@@ -211,6 +211,52 @@ func (k Keeper) IterateMissCounters(ctx sdk.Context,
 	}
 }
 
+// GetBadWindowCounter retrieves the # of consecutive slash windows the validator was slashed in
+func (k Keeper) GetBadWindowCounter(ctx sdk.Context, operator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBadWindowCounterKey(operator))
+	if bz == nil {
+		// By default the counter is zero
+		return 0
+	}
+
+	var badWindowCounter gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &badWindowCounter)
+	return badWindowCounter.Value
+}
+
+// SetBadWindowCounter updates the # of consecutive slash windows the validator was slashed in
+func (k Keeper) SetBadWindowCounter(ctx sdk.Context, operator sdk.ValAddress, badWindowCounter uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: badWindowCounter})
+	store.Set(types.GetBadWindowCounterKey(operator), bz)
+}
+
+// DeleteBadWindowCounter removes bad window counter for the validator
+func (k Keeper) DeleteBadWindowCounter(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBadWindowCounterKey(operator))
+}
+
+// IterateBadWindowCounters iterates over the bad window counters and performs a callback function.
+func (k Keeper) IterateBadWindowCounters(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, badWindowCounter uint64) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.BadWindowCounterKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var badWindowCounter gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(iter.Value(), &badWindowCounter)
+
+		if handler(operator, badWindowCounter.Value) {
+			break
+		}
+	}
+}
+
 //-----------------------------------
 // AggregateExchangeRatePrevote logic
 
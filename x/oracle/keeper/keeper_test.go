@@ -117,6 +117,10 @@ func TestParams(t *testing.T) {
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	warningValidPerWindow := sdk.NewDecWithPrec(1, 3)
+	maxSlashFraction := sdk.NewDecWithPrec(5, 2)
+	jailAfterWindows := uint64(3)
+	maxRateChange := sdk.NewDecWithPrec(2, 1)
 	whitelist := types.DenomList{
 		{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax},
 		{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax},
@@ -132,6 +136,10 @@ func TestParams(t *testing.T) {
 		SlashFraction:            slashFraction,
 		SlashWindow:              slashWindow,
 		MinValidPerWindow:        minValidPerWindow,
+		WarningValidPerWindow:    warningValidPerWindow,
+		MaxSlashFraction:         maxSlashFraction,
+		JailAfterWindows:         jailAfterWindows,
+		MaxRateChange:            maxRateChange,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2 by setting
+// the params added in version 2 to their defaults, raised where needed to
+// stay valid against the params already set
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	paramSpace := m.keeper.paramSpace
+
+	var minValidPerWindow, slashFraction sdk.Dec
+	paramSpace.Get(ctx, types.KeyMinValidPerWindow, &minValidPerWindow)
+	paramSpace.Get(ctx, types.KeySlashFraction, &slashFraction)
+
+	warningValidPerWindow := sdk.MaxDec(types.DefaultWarningValidPerWindow, minValidPerWindow)
+	maxSlashFraction := sdk.MaxDec(types.DefaultMaxSlashFraction, slashFraction)
+
+	for _, param := range []struct {
+		key   []byte
+		value interface{}
+	}{
+		{types.KeyWarningValidPerWindow, warningValidPerWindow},
+		{types.KeyMaxSlashFraction, maxSlashFraction},
+		{types.KeyJailAfterWindows, types.DefaultJailAfterWindows},
+		{types.KeyPriceFeeds, types.PriceFeedList{}},
+		{types.KeyMaxRateChange, types.DefaultMaxRateChange},
+	} {
+		if !paramSpace.Has(ctx, param.key) {
+			paramSpace.Set(ctx, param.key, param.value)
+		}
+	}
+
+	return nil
+}
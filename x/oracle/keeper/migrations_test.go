@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+// deleteParams removes the params from the param store, leaving it as an older version wrote it
+func deleteParams(input TestInput, keys ...[]byte) {
+	store := prefix.NewStore(input.Ctx.KVStore(input.ParamsKey), []byte(types.ModuleName+"/"))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MinValidPerWindow = sdk.NewDecWithPrec(20, 2)
+	params.WarningValidPerWindow = sdk.NewDecWithPrec(20, 2)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	v2Keys := [][]byte{types.KeyWarningValidPerWindow, types.KeyMaxSlashFraction, types.KeyJailAfterWindows, types.KeyPriceFeeds, types.KeyMaxRateChange}
+	deleteParams(input, v2Keys...)
+	require.Panics(t, func() { input.OracleKeeper.GetParams(input.Ctx) })
+
+	require.NoError(t, NewMigrator(input.OracleKeeper).Migrate1to2(input.Ctx))
+
+	// the warning threshold is raised to the minimum the chain requires
+	migrated := input.OracleKeeper.GetParams(input.Ctx)
+	require.NoError(t, migrated.Validate())
+	require.Equal(t, params.MinValidPerWindow, migrated.WarningValidPerWindow)
+	require.Equal(t, types.DefaultMaxSlashFraction, migrated.MaxSlashFraction)
+	require.Equal(t, types.DefaultJailAfterWindows, migrated.JailAfterWindows)
+	require.Empty(t, migrated.PriceFeeds)
+	require.Equal(t, types.DefaultMaxRateChange, migrated.MaxRateChange)
+
+	// the params set since are kept
+	migrated.JailAfterWindows = 3
+	input.OracleKeeper.SetParams(input.Ctx, migrated)
+	require.NoError(t, NewMigrator(input.OracleKeeper).Migrate1to2(input.Ctx))
+	require.Equal(t, uint64(3), input.OracleKeeper.JailAfterWindows(input.Ctx))
+}
@@ -95,9 +95,9 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
 	}
 
-	// check all denoms are in the vote target
+	// check all denoms are in the vote target or price feeds
 	for _, tuple := range exchangeRateTuples {
-		if !ms.IsVoteTarget(ctx, tuple.Denom) {
+		if _, isPriceFeed := params.PriceFeeds.Get(tuple.Denom); !isPriceFeed && !ms.IsVoteTarget(ctx, tuple.Denom) {
 			return nil, sdkerrors.Wrap(types.ErrUnknownDenom, tuple.Denom)
 		}
 	}
@@ -61,6 +61,36 @@ func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// WarningValidPerWindow returns the valid vote rate under which validators are warned
+func (k Keeper) WarningValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyWarningValidPerWindow, &res)
+	return
+}
+
+// MaxSlashFraction returns the cap of the slash fraction growing with consecutive slashed windows
+func (k Keeper) MaxSlashFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyMaxSlashFraction, &res)
+	return
+}
+
+// JailAfterWindows returns # of consecutive slashed windows after which validators are jailed
+func (k Keeper) JailAfterWindows(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyJailAfterWindows, &res)
+	return
+}
+
+// PriceFeeds returns the assets priced by the validators besides the Terra denoms
+func (k Keeper) PriceFeeds(ctx sdk.Context) (res types.PriceFeedList) {
+	k.paramSpace.Get(ctx, types.KeyPriceFeeds, &res)
+	return
+}
+
+// MaxRateChange returns the fraction by which an exchange rate may move from the previous one in a vote period
+func (k Keeper) MaxRateChange(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyMaxRateChange, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
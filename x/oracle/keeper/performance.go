@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+// GetSlashWindowIndex returns the index of the slash window of the current height
+func (k Keeper) GetSlashWindowIndex(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockHeight()) / k.SlashWindow(ctx)
+}
+
+// GetValidatorPerformance returns the performance of the validator over the slash window,
+// an empty one when nothing was recorded yet
+func (k Keeper) GetValidatorPerformance(ctx sdk.Context, window uint64, operator sdk.ValAddress) types.ValidatorPerformance {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorPerformanceKey(window, operator))
+	if bz == nil {
+		return types.NewValidatorPerformance(operator, window, k.SlashWindow(ctx))
+	}
+
+	var performance types.ValidatorPerformance
+	k.cdc.MustUnmarshal(bz, &performance)
+	return performance
+}
+
+// SetValidatorPerformance sets the performance of a validator for its slash window
+func (k Keeper) SetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress, performance types.ValidatorPerformance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&performance)
+	store.Set(types.GetValidatorPerformanceKey(performance.Window, operator), bz)
+}
+
+// IterateValidatorPerformances iterates over the validator performances of the slash window
+func (k Keeper) IterateValidatorPerformances(ctx sdk.Context, window uint64, handler func(operator sdk.ValAddress, performance types.ValidatorPerformance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetValidatorPerformancePrefix(window)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[len(prefix)+1:])
+
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+		if handler(operator, performance) {
+			break
+		}
+	}
+}
+
+// GetValidatorPerformances returns the performances of the validator recorded
+// for the current and the previous slash window, the current one first
+func (k Keeper) GetValidatorPerformances(ctx sdk.Context, operator sdk.ValAddress) []types.ValidatorPerformance {
+	store := ctx.KVStore(k.storeKey)
+	window := k.GetSlashWindowIndex(ctx)
+
+	performances := []types.ValidatorPerformance{}
+	for i := uint64(0); i < 2 && i <= window; i++ {
+		bz := store.Get(types.GetValidatorPerformanceKey(window-i, operator))
+		if bz == nil {
+			continue
+		}
+
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(bz, &performance)
+		performances = append(performances, performance)
+	}
+
+	return performances
+}
+
+// PrunePreviousValidatorPerformances deletes the validator performances of the slash
+// windows before the given one, called at the last block of the slash window
+func (k Keeper) PrunePreviousValidatorPerformances(ctx sdk.Context, window uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.ValidatorPerformanceKey, types.GetValidatorPerformancePrefix(window))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
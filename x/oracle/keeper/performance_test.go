@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+func TestValidatorPerformances(t *testing.T) {
+	input := CreateTestInput(t)
+	slashWindow := input.OracleKeeper.SlashWindow(input.Ctx)
+
+	// nothing recorded
+	performance := input.OracleKeeper.GetValidatorPerformance(input.Ctx, 3, ValAddrs[0])
+	require.Equal(t, types.NewValidatorPerformance(ValAddrs[0], 3, slashWindow), performance)
+	require.Equal(t, int64(3*slashWindow), performance.StartHeight)
+
+	for window := uint64(0); window < 3; window++ {
+		performance := input.OracleKeeper.GetValidatorPerformance(input.Ctx, window, ValAddrs[0])
+		performance.VotePeriods = window + 1
+		input.OracleKeeper.SetValidatorPerformance(input.Ctx, ValAddrs[0], performance)
+	}
+
+	// the current and the previous window, the current first
+	ctx := input.Ctx.WithBlockHeight(int64(2*slashWindow + 1))
+	performances := input.OracleKeeper.GetValidatorPerformances(ctx, ValAddrs[0])
+	require.Len(t, performances, 2)
+	require.Equal(t, uint64(3), performances[0].VotePeriods)
+	require.Equal(t, uint64(2), performances[1].VotePeriods)
+	require.Empty(t, input.OracleKeeper.GetValidatorPerformances(ctx, ValAddrs[1]))
+
+	input.OracleKeeper.PrunePreviousValidatorPerformances(ctx, 2)
+	for window := uint64(0); window < 3; window++ {
+		count := 0
+		input.OracleKeeper.IterateValidatorPerformances(ctx, window, func(operator sdk.ValAddress, _ types.ValidatorPerformance) bool {
+			require.Equal(t, ValAddrs[0], operator)
+			count++
+			return false
+		})
+
+		if window < 2 {
+			require.Zero(t, count)
+		} else {
+			require.Equal(t, 1, count)
+		}
+	}
+}
+
+func TestAddDenomVote(t *testing.T) {
+	performance := types.NewValidatorPerformance(ValAddrs[0], 0, 100)
+	performance.AddDenomVote(core.MicroUSDDenom, true, sdk.NewDecWithPrec(1, 2))
+	performance.AddDenomVote(core.MicroKRWDenom, false, sdk.NewDecWithPrec(5, 2))
+	performance.AddDenomVote(core.MicroUSDDenom, false, sdk.NewDecWithPrec(3, 2))
+
+	require.Equal(t, []types.DenomPerformance{{
+		Denom:            core.MicroKRWDenom,
+		Votes:            1,
+		TotalDeviation:   sdk.NewDecWithPrec(5, 2),
+		AverageDeviation: sdk.NewDecWithPrec(5, 2),
+	}, {
+		Denom:            core.MicroUSDDenom,
+		Votes:            2,
+		Wins:             1,
+		TotalDeviation:   sdk.NewDecWithPrec(4, 2),
+		AverageDeviation: sdk.NewDecWithPrec(2, 2),
+	}}, performance.Denoms)
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+// GetPrice gets the consensus price of the price feed from the store.
+func (k Keeper) GetPrice(ctx sdk.Context, name string) (types.FeedPrice, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPriceKey(name))
+	if bz == nil {
+		return types.FeedPrice{}, sdkerrors.Wrap(types.ErrNoPrice, name)
+	}
+
+	var price types.FeedPrice
+	k.cdc.MustUnmarshal(bz, &price)
+	return price, nil
+}
+
+// SetPrice sets the consensus price of the price feed to the store.
+func (k Keeper) SetPrice(ctx sdk.Context, price types.FeedPrice) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&price)
+	store.Set(types.GetPriceKey(price.Name), bz)
+}
+
+// SetPriceWithEvent sets the consensus price of the price feed to the store with ABCI event
+func (k Keeper) SetPriceWithEvent(ctx sdk.Context, price types.FeedPrice) {
+	k.SetPrice(ctx, price)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePriceUpdate,
+			sdk.NewAttribute(types.AttributeKeyDenom, price.Name),
+			sdk.NewAttribute(types.AttributeKeyQuote, price.Quote),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.Price.String()),
+		),
+	)
+}
+
+// DeletePrice deletes the consensus price of the price feed from the store.
+func (k Keeper) DeletePrice(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPriceKey(name))
+}
+
+// IteratePrices iterates over the prices of the price feeds in the store
+func (k Keeper) IteratePrices(ctx sdk.Context, handler func(price types.FeedPrice) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PriceKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var price types.FeedPrice
+		k.cdc.MustUnmarshal(iter.Value(), &price)
+		if handler(price) {
+			break
+		}
+	}
+}
+
+// TallyPriceFeeds aggregates the ballots of the price feeds passing their vote threshold and returns
+// their prices in the order of the price feeds. Unlike the ballots of the vote targets, the votes
+// for the price feeds are neither rewarded nor counted as misses.
+func (k Keeper) TallyPriceFeeds(ctx sdk.Context, params types.Params, validatorClaimMap map[string]types.Claim) []types.FeedPrice {
+	if len(params.PriceFeeds) == 0 {
+		return nil
+	}
+
+	voteMap := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
+
+	var prices []types.FeedPrice
+	for _, feed := range params.PriceFeeds {
+		voteThreshold := params.VoteThreshold
+		if feed.VoteThreshold.IsPositive() {
+			voteThreshold = feed.VoteThreshold
+		}
+
+		ballot := voteMap[feed.Name]
+		if _, ok := ballotIsPassing(ballot, k.ballotThreshold(ctx, voteThreshold)); !ok {
+			continue
+		}
+
+		aggregator, err := types.GetAggregator(feed.Aggregator)
+		if err != nil {
+			// unreachable, the aggregators of the params are validated
+			aggregator = types.WeightedMedianAggregator{}
+		}
+
+		prices = append(prices, types.FeedPrice{
+			Name:  feed.Name,
+			Quote: feed.Quote,
+			Price: aggregator.Aggregate(ballot),
+		})
+	}
+
+	return prices
+}
+
+// GetExchangeRate returns the amount of the quote denom worth one base denom. Denoms are either
+// priced in Luna, i.e. uluna, the vote targets and the price feeds quoted in them, or in the
+// quote asset of their price feed; only the denoms priced in the same asset can be exchanged.
+func (k Keeper) GetExchangeRate(ctx sdk.Context, base string, quote string) (sdk.Dec, error) {
+	// A price feed against its own quote asset
+	if price, err := k.GetPrice(ctx, base); err == nil && price.Quote == quote {
+		return price.Price, nil
+	}
+
+	if price, err := k.GetPrice(ctx, quote); err == nil && price.Quote == base && price.Price.IsPositive() {
+		return sdk.OneDec().Quo(price.Price), nil
+	}
+
+	baseUnit, baseRate, err := k.GetDenomRate(ctx, base)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	quoteUnit, quoteRate, err := k.GetDenomRate(ctx, quote)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if baseUnit != quoteUnit {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrUnknownDenom, "%s is not priced in %s", quote, baseUnit)
+	}
+
+	// (UNIT / QUOTE) / (UNIT / BASE) = QUOTE / BASE
+	return quoteRate.Quo(baseRate), nil
+}
+
+// GetDenomRate returns the asset the denom is priced in and the amount of the denom worth one unit
+// of it: the exchange rate of Luna for the vote targets, the inverse of the price for the price feeds
+// and one for the quote assets of the price feeds
+func (k Keeper) GetDenomRate(ctx sdk.Context, denom string) (unit string, rate sdk.Dec, err error) {
+	if rate, err := k.GetLunaExchangeRate(ctx, denom); err == nil {
+		return core.MicroLunaDenom, rate, nil
+	}
+
+	if price, err := k.GetPrice(ctx, denom); err == nil {
+		if price.Price.IsZero() {
+			return "", sdk.ZeroDec(), sdkerrors.Wrap(types.ErrNoPrice, denom)
+		}
+
+		// Price feeds quoted in a denom priced in Luna are priced in Luna
+		if quoteRate, err := k.GetLunaExchangeRate(ctx, price.Quote); err == nil {
+			return core.MicroLunaDenom, quoteRate.Quo(price.Price), nil
+		}
+
+		return price.Quote, sdk.OneDec().Quo(price.Price), nil
+	}
+
+	if k.PriceFeeds(ctx).IsQuote(denom) {
+		return denom, sdk.OneDec(), nil
+	}
+
+	return "", sdk.ZeroDec(), sdkerrors.Wrap(types.ErrUnknownDenom, denom)
+}
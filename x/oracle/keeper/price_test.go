@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+func TestPrice(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, err := input.OracleKeeper.GetPrice(input.Ctx, "ubtc")
+	require.Error(t, err)
+
+	btcPrice := types.FeedPrice{Name: "ubtc", Quote: core.MicroUSDDenom, Price: sdk.NewDec(30000)}
+	ethPrice := types.FeedPrice{Name: "ueth", Quote: "ubtc", Price: sdk.NewDecWithPrec(6, 2)}
+	input.OracleKeeper.SetPrice(input.Ctx, btcPrice)
+	input.OracleKeeper.SetPrice(input.Ctx, ethPrice)
+
+	price, err := input.OracleKeeper.GetPrice(input.Ctx, "ubtc")
+	require.NoError(t, err)
+	require.Equal(t, btcPrice, price)
+
+	var prices []types.FeedPrice
+	input.OracleKeeper.IteratePrices(input.Ctx, func(price types.FeedPrice) (stop bool) {
+		prices = append(prices, price)
+		return false
+	})
+	require.Equal(t, []types.FeedPrice{btcPrice, ethPrice}, prices)
+
+	input.OracleKeeper.DeletePrice(input.Ctx, "ubtc")
+	_, err = input.OracleKeeper.GetPrice(input.Ctx, "ubtc")
+	require.Error(t, err)
+}
+
+func TestTallyPriceFeeds(t *testing.T) {
+	input := CreateTestInput(t)
+
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx
+
+	claims := map[string]types.Claim{}
+	for i := 0; i < 3; i++ {
+		_, err := sh(ctx, NewTestMsgCreateValidator(ValAddrs[i], ValPubKeys[i], amt))
+		require.NoError(t, err)
+		claims[ValAddrs[i].String()] = types.NewClaim(power, 0, 0, ValAddrs[i])
+	}
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	// ubtc is voted by all validators, ueth by two of them
+	btcRates := []int64{29000, 30000, 31000}
+	for i := 0; i < 3; i++ {
+		tuples := types.ExchangeRateTuples{{Denom: "ubtc", ExchangeRate: sdk.NewDec(btcRates[i])}}
+		if i < 2 {
+			tuples = append(tuples, types.ExchangeRateTuple{Denom: "ueth", ExchangeRate: sdk.NewDecWithPrec(6, 2)})
+		}
+
+		input.OracleKeeper.SetAggregateExchangeRateVote(ctx, ValAddrs[i], types.NewAggregateExchangeRateVote(tuples, ValAddrs[i]))
+	}
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.PriceFeeds = types.PriceFeedList{
+		{Name: "ubtc", Quote: core.MicroUSDDenom, VoteThreshold: sdk.ZeroDec()},
+		{Name: "ueth", Quote: "ubtc", VoteThreshold: sdk.NewDecWithPrec(80, 2)},
+	}
+
+	// ueth misses its own vote threshold
+	prices := input.OracleKeeper.TallyPriceFeeds(ctx, params, claims)
+	require.Equal(t, []types.FeedPrice{{Name: "ubtc", Quote: core.MicroUSDDenom, Price: sdk.NewDec(30000)}}, prices)
+
+	// ueth passes the vote threshold of the params
+	params.PriceFeeds[1].VoteThreshold = sdk.ZeroDec()
+	prices = input.OracleKeeper.TallyPriceFeeds(ctx, params, claims)
+	require.Len(t, prices, 2)
+	require.Equal(t, types.FeedPrice{Name: "ueth", Quote: "ubtc", Price: sdk.NewDecWithPrec(6, 2)}, prices[1])
+
+	// the votes for the price feeds are not rewarded
+	for _, claim := range claims {
+		require.Zero(t, claim.WinCount)
+	}
+}
+
+func TestGetExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.PriceFeeds = types.PriceFeedList{
+		{Name: "ubtc", Quote: core.MicroUSDDenom, VoteThreshold: sdk.ZeroDec()},
+		{Name: "ugold", Quote: "xau", VoteThreshold: sdk.ZeroDec()},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroUSDDenom, sdk.NewDec(100))
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.NewDec(120000))
+	input.OracleKeeper.SetPrice(input.Ctx, types.FeedPrice{Name: "ubtc", Quote: core.MicroUSDDenom, Price: sdk.NewDec(25000)})
+	input.OracleKeeper.SetPrice(input.Ctx, types.FeedPrice{Name: "ugold", Quote: "xau", Price: sdk.NewDec(2)})
+
+	// Terra to Terra
+	rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, core.MicroUSDDenom, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1200), rate)
+
+	// price feed to its quote and back
+	rate, err = input.OracleKeeper.GetExchangeRate(input.Ctx, "ubtc", core.MicroUSDDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(25000), rate)
+
+	rate, err = input.OracleKeeper.GetExchangeRate(input.Ctx, core.MicroUSDDenom, "ubtc")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(4, 5), rate)
+
+	// price feed quoted in Terra to Luna and the other Terra
+	rate, err = input.OracleKeeper.GetExchangeRate(input.Ctx, "ubtc", core.MicroLunaDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(250), rate)
+
+	rate, err = input.OracleKeeper.GetExchangeRate(input.Ctx, "ubtc", core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(30000000), rate)
+
+	// price feed quoted in an asset without a Luna exchange rate
+	rate, err = input.OracleKeeper.GetExchangeRate(input.Ctx, "ugold", "xau")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), rate)
+
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, "ugold", core.MicroUSDDenom)
+	require.Error(t, err)
+
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, "unknown", core.MicroUSDDenom)
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -65,6 +66,69 @@ func (q querier) ExchangeRates(c context.Context, req *types.QueryExchangeRatesR
 	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
 }
 
+// ExchangeRateHistory queries the exchange rates kept for a denom
+func (q querier) ExchangeRateHistory(c context.Context, req *types.QueryExchangeRateHistoryRequest) (*types.QueryExchangeRateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var exchangeRates []types.HistoricalExchangeRate
+	q.IterateHistoricalExchangeRates(ctx, req.Denom, func(rate types.HistoricalExchangeRate) (stop bool) {
+		exchangeRates = append(exchangeRates, rate)
+		return false
+	})
+
+	return &types.QueryExchangeRateHistoryResponse{ExchangeRates: exchangeRates}, nil
+}
+
+// ExchangeRateTWAP queries the time weighted average exchange rate of a denom
+func (q querier) ExchangeRateTWAP(c context.Context, req *types.QueryExchangeRateTWAPRequest) (*types.QueryExchangeRateTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	if req.Window == 0 {
+		return nil, status.Error(codes.InvalidArgument, "window must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	exchangeRate, err := q.GetExchangeRateTWAP(ctx, req.Denom, req.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExchangeRateTWAPResponse{ExchangeRate: exchangeRate}, nil
+}
+
+// ExchangeRateStatus queries the status of the exchange rate of a denom
+func (q querier) ExchangeRateStatus(c context.Context, req *types.QueryExchangeRateStatusRequest) (*types.QueryExchangeRateStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rateStatus, err := q.GetExchangeRateStatus(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExchangeRateStatusResponse{Status: rateStatus}, nil
+}
+
 // TobinTax queries tobin tax of a denom
 func (q querier) TobinTax(c context.Context, req *types.QueryTobinTaxRequest) (*types.QueryTobinTaxResponse, error) {
 	if req == nil {
@@ -84,6 +148,38 @@ func (q querier) TobinTax(c context.Context, req *types.QueryTobinTaxRequest) (*
 	return &types.QueryTobinTaxResponse{TobinTax: tobinTax}, nil
 }
 
+// Price queries the price of a price feed
+func (q querier) Price(c context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Name) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty name")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	price, err := q.GetPrice(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPriceResponse{Price: price}, nil
+}
+
+// Prices queries the prices of all price feeds
+func (q querier) Prices(c context.Context, req *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var prices []types.FeedPrice
+	q.IteratePrices(ctx, func(price types.FeedPrice) (stop bool) {
+		prices = append(prices, price)
+		return false
+	})
+
+	return &types.QueryPricesResponse{Prices: prices}, nil
+}
+
 // TobinTaxes queries tobin taxes of all denoms
 func (q querier) TobinTaxes(c context.Context, req *types.QueryTobinTaxesRequest) (*types.QueryTobinTaxesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -153,6 +249,92 @@ func (q querier) MissCounter(c context.Context, req *types.QueryMissCounterReque
 	}, nil
 }
 
+// ValidatorPerformance queries the performances of a validator for the current and the previous slash window
+func (q querier) ValidatorPerformance(c context.Context, req *types.QueryValidatorPerformanceRequest) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorPerformanceResponse{
+		Performances: q.GetValidatorPerformances(ctx, valAddr),
+	}, nil
+}
+
+// LastVotePeriod queries the outcome of the ballots of the last vote period
+func (q querier) LastVotePeriod(c context.Context, req *types.QueryLastVotePeriodRequest) (*types.QueryLastVotePeriodResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryLastVotePeriodResponse{Summary: q.GetLastVotePeriodSummary(ctx)}, nil
+}
+
+// SimulateTally queries the outcome of the ballots of the current vote period if it was tallied
+// with the aggregate votes submitted so far, the max rate change included; the tally runs on a
+// cache context which is discarded
+func (q querier) SimulateTally(c context.Context, req *types.QuerySimulateTallyRequest) (*types.QuerySimulateTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+
+	voteTargets := make(map[string]sdk.Dec)
+	q.IterateTobinTaxes(ctx, func(denom string, tobinTax sdk.Dec) bool {
+		voteTargets[denom] = tobinTax
+		return false
+	})
+
+	params := q.GetParams(ctx)
+	summary := q.TallyBallots(ctx, params, voteTargets, q.GetValidatorClaimMap(ctx), nil)
+	q.ApplyBallots(ctx, params.MaxRateChange, summary.Ballots)
+
+	return &types.QuerySimulateTallyResponse{Summary: summary}, nil
+}
+
+// ValidatorStanding queries the penalty a validator faces at the end of the slash window
+func (q querier) ValidatorStanding(c context.Context, req *types.QueryValidatorStandingRequest) (*types.QueryValidatorStandingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorStandingResponse{
+		Standing: q.GetValidatorStanding(ctx, valAddr),
+	}, nil
+}
+
+// ValidatorStandings queries the penalties the bonded validators face at the end of the slash window
+func (q querier) ValidatorStandings(c context.Context, req *types.QueryValidatorStandingsRequest) (*types.QueryValidatorStandingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validatorClaimMap := q.GetValidatorClaimMap(ctx)
+	operators := make([]string, 0, len(validatorClaimMap))
+	for operator := range validatorClaimMap {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+
+	standings := make([]types.ValidatorStanding, 0, len(operators))
+	for _, operator := range operators {
+		standings = append(standings, q.GetValidatorStanding(ctx, validatorClaimMap[operator].Recipient))
+	}
+
+	return &types.QueryValidatorStandingsResponse{Standings: standings}, nil
+}
+
 // AggregatePrevote queries an aggregate prevote of a validator
 func (q querier) AggregatePrevote(c context.Context, req *types.QueryAggregatePrevoteRequest) (*types.QueryAggregatePrevoteResponse, error) {
 	if req == nil {
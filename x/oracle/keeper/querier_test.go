@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 
 	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/oracle/types"
@@ -250,3 +251,219 @@ func TestQueryTobinTax(t *testing.T) {
 
 	require.Equal(t, denom.TobinTax, res.TobinTax)
 }
+
+func TestQueryExchangeRateHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.ExchangeRateHistory(ctx, &types.QueryExchangeRateHistoryRequest{})
+	require.Error(t, err)
+
+	rate := sdk.NewDec(1700)
+	input.OracleKeeper.AddHistoricalExchangeRate(input.Ctx, core.MicroSDRDenom, rate)
+
+	res, err := querier.ExchangeRateHistory(ctx, &types.QueryExchangeRateHistoryRequest{Denom: core.MicroSDRDenom})
+	require.NoError(t, err)
+	require.Equal(t, []types.HistoricalExchangeRate{{Height: input.Ctx.BlockHeight(), ExchangeRate: rate}}, res.ExchangeRates)
+}
+
+func TestQueryExchangeRateTWAP(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.ExchangeRateTWAP(ctx, &types.QueryExchangeRateTWAPRequest{Denom: core.MicroSDRDenom})
+	require.Error(t, err)
+
+	_, err = querier.ExchangeRateTWAP(ctx, &types.QueryExchangeRateTWAPRequest{Denom: core.MicroSDRDenom, Window: 10})
+	require.Error(t, err)
+
+	rate := sdk.NewDec(1700)
+	input.OracleKeeper.AddHistoricalExchangeRate(input.Ctx, core.MicroSDRDenom, rate)
+
+	res, err := querier.ExchangeRateTWAP(ctx, &types.QueryExchangeRateTWAPRequest{Denom: core.MicroSDRDenom, Window: 10})
+	require.NoError(t, err)
+	require.Equal(t, rate, res.ExchangeRate)
+}
+
+func TestQueryValidatorPerformance(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.ValidatorPerformance(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformanceRequest{ValidatorAddr: "invalid"})
+	require.Error(t, err)
+
+	performance := input.OracleKeeper.GetValidatorPerformance(input.Ctx, 0, ValAddrs[0])
+	performance.Votes = 3
+	input.OracleKeeper.SetValidatorPerformance(input.Ctx, ValAddrs[0], performance)
+
+	res, err := querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorPerformance{performance}, res.Performances)
+}
+
+func TestQueryLastVotePeriod(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	res, err := querier.LastVotePeriod(ctx, &types.QueryLastVotePeriodRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.VotePeriodSummary{}, res.Summary)
+
+	summary := types.VotePeriodSummary{
+		Height:         10,
+		ReferenceDenom: core.MicroSDRDenom,
+		Ballots: []types.BallotSummary{{
+			Denom:          core.MicroSDRDenom,
+			BallotPower:    10,
+			ThresholdPower: 5,
+			Passed:         true,
+			WeightedMedian: sdk.OneDec(),
+			RewardSpread:   sdk.NewDecWithPrec(1, 2),
+			ExchangeRate:   sdk.OneDec(),
+			Winners:        []string{ValAddrs[0].String()},
+			Missed:         []string{ValAddrs[1].String()},
+		}},
+	}
+	input.OracleKeeper.SetLastVotePeriodSummaryWithEvent(input.Ctx, summary)
+
+	res, err = querier.LastVotePeriod(ctx, &types.QueryLastVotePeriodRequest{})
+	require.NoError(t, err)
+	require.Equal(t, summary, res.Summary)
+}
+
+func TestQuerySimulateTally(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.SimulateTally(ctx, nil)
+	require.Error(t, err)
+
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err = sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[0], types.NewAggregateExchangeRateVote(
+		types.ExchangeRateTuples{{Denom: core.MicroSDRDenom, ExchangeRate: sdk.NewDecWithPrec(15, 1)}}, ValAddrs[0]))
+
+	res, err := querier.SimulateTally(ctx, &types.QuerySimulateTallyRequest{})
+	require.NoError(t, err)
+	for _, ballot := range res.Summary.Ballots {
+		require.Equal(t, ballot.Denom == core.MicroSDRDenom, ballot.Passed, ballot.Denom)
+		if ballot.Passed {
+			require.Equal(t, sdk.NewDecWithPrec(15, 1), ballot.ExchangeRate)
+		}
+	}
+
+	// the tally is discarded
+	_, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroSDRDenom)
+	require.Error(t, err)
+
+	// the max rate change applies to the simulated tally
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxRateChange = sdk.NewDecWithPrec(1, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.ApplyExchangeRate(input.Ctx, params.MaxRateChange, core.MicroSDRDenom, sdk.OneDec())
+
+	res, err = querier.SimulateTally(ctx, &types.QuerySimulateTallyRequest{})
+	require.NoError(t, err)
+	for _, ballot := range res.Summary.Ballots {
+		if ballot.Denom == core.MicroSDRDenom {
+			require.True(t, ballot.Rejected)
+			require.Equal(t, sdk.OneDec(), ballot.AppliedExchangeRate)
+		}
+	}
+}
+
+func TestQueryValidatorStanding(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.ValidatorStanding(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.ValidatorStanding(ctx, &types.QueryValidatorStandingRequest{ValidatorAddr: "invalid"})
+	require.Error(t, err)
+
+	input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[0], 2)
+	input.OracleKeeper.SetBadWindowCounter(input.Ctx, ValAddrs[0], 1)
+
+	res, err := querier.ValidatorStanding(ctx, &types.QueryValidatorStandingRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, input.OracleKeeper.GetValidatorStanding(input.Ctx, ValAddrs[0]), res.Standing)
+	require.Equal(t, uint64(2), res.Standing.MissCounter)
+	require.Equal(t, uint64(1), res.Standing.BadWindows)
+}
+
+func TestQueryValidatorStandings(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.ValidatorStandings(ctx, nil)
+	require.Error(t, err)
+
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err = sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	res, err := querier.ValidatorStandings(ctx, &types.QueryValidatorStandingsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Standings, 1)
+	require.Equal(t, ValAddrs[0].String(), res.Standings[0].Validator)
+	require.Equal(t, types.StandingGood, res.Standings[0].Status)
+}
+
+func TestQueryPrices(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.Price(ctx, &types.QueryPriceRequest{Name: "ubtc"})
+	require.Error(t, err)
+
+	_, err = querier.Price(ctx, &types.QueryPriceRequest{})
+	require.Error(t, err)
+
+	price := types.FeedPrice{Name: "ubtc", Quote: core.MicroUSDDenom, Price: sdk.NewDec(30000)}
+	input.OracleKeeper.SetPrice(input.Ctx, price)
+
+	res, err := querier.Price(ctx, &types.QueryPriceRequest{Name: "ubtc"})
+	require.NoError(t, err)
+	require.Equal(t, price, res.Price)
+
+	resPrices, err := querier.Prices(ctx, &types.QueryPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.FeedPrice{price}, resPrices.Prices)
+}
+
+func TestQueryExchangeRateStatus(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.ExchangeRateStatus(ctx, &types.QueryExchangeRateStatusRequest{Denom: core.MicroKRWDenom})
+	require.Error(t, err)
+
+	_, err = querier.ExchangeRateStatus(ctx, &types.QueryExchangeRateStatusRequest{})
+	require.Error(t, err)
+
+	input.OracleKeeper.RejectFailedBallot(input.Ctx, types.BallotSummary{Denom: core.MicroKRWDenom, BallotPower: 10, ThresholdPower: 50})
+
+	res, err := querier.ExchangeRateStatus(ctx, &types.QueryExchangeRateStatusRequest{Denom: core.MicroKRWDenom})
+	require.NoError(t, err)
+	require.Equal(t, core.MicroKRWDenom, res.Status.Denom)
+	require.Equal(t, "ballot power 10 below the threshold 50", res.Status.RejectionReason)
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+// GetExchangeRateStatus gets the status of the exchange rate of the denom from the store.
+func (k Keeper) GetExchangeRateStatus(ctx sdk.Context, denom string) (types.ExchangeRateStatus, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetExchangeRateStatusKey(denom))
+	if bz == nil {
+		return types.ExchangeRateStatus{}, sdkerrors.Wrap(types.ErrUnknownDenom, denom)
+	}
+
+	var status types.ExchangeRateStatus
+	k.cdc.MustUnmarshal(bz, &status)
+	return status, nil
+}
+
+// SetExchangeRateStatus sets the status of the exchange rate of the denom to the store.
+func (k Keeper) SetExchangeRateStatus(ctx sdk.Context, status types.ExchangeRateStatus) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&status)
+	store.Set(types.GetExchangeRateStatusKey(status.Denom), bz)
+}
+
+// IterateExchangeRateStatuses iterates over the statuses of the exchange rates in the store
+func (k Keeper) IterateExchangeRateStatuses(ctx sdk.Context, handler func(status types.ExchangeRateStatus) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ExchangeRateStatusKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var status types.ExchangeRateStatus
+		k.cdc.MustUnmarshal(iter.Value(), &status)
+		if handler(status) {
+			break
+		}
+	}
+}
+
+// getExchangeRateStatus returns the status of the exchange rate of the denom, empty if never tallied
+func (k Keeper) getExchangeRateStatus(ctx sdk.Context, denom string) types.ExchangeRateStatus {
+	status, err := k.GetExchangeRateStatus(ctx, denom)
+	if err != nil {
+		return types.ExchangeRateStatus{
+			Denom:        denom,
+			ExchangeRate: sdk.ZeroDec(),
+			LastMedian:   sdk.ZeroDec(),
+		}
+	}
+
+	return status
+}
+
+// ApplyExchangeRate sets the tallied exchange rate of the denom unless it moved from the last median
+// by more than the max rate change; a rejected exchange rate leaves the last accepted one in place,
+// aging until the next tallied exchange rate close enough to the rejected one is accepted.
+// Returns whether the exchange rate is accepted.
+func (k Keeper) ApplyExchangeRate(ctx sdk.Context, maxRateChange sdk.Dec, denom string, exchangeRate sdk.Dec) bool {
+	status := k.getExchangeRateStatus(ctx, denom)
+	lastMedian := status.LastMedian
+	status.LastMedian = exchangeRate
+
+	if maxRateChange.IsPositive() && lastMedian.IsPositive() {
+		rateChange := exchangeRate.Sub(lastMedian).Abs().Quo(lastMedian)
+		if rateChange.GT(maxRateChange) {
+			reason := fmt.Sprintf("moved by %s from the last median %s, more than the max rate change %s", rateChange, lastMedian, maxRateChange)
+			k.rejectExchangeRate(ctx, status, exchangeRate, reason)
+
+			if status.ExchangeRate.IsPositive() {
+				k.SetLunaExchangeRate(ctx, denom, status.ExchangeRate)
+			}
+
+			return false
+		}
+	}
+
+	status.ExchangeRate = exchangeRate
+	status.LastUpdateHeight = ctx.BlockHeight()
+	k.SetExchangeRateStatus(ctx, status)
+
+	k.SetLunaExchangeRateWithEvent(ctx, denom, exchangeRate)
+	k.AddHistoricalExchangeRate(ctx, denom, exchangeRate)
+	return true
+}
+
+// ApplyBallots sets the exchange rates of the passed ballots within the max rate change and
+// records the rejection of the others, filling in which exchange rate each ballot applied
+func (k Keeper) ApplyBallots(ctx sdk.Context, maxRateChange sdk.Dec, ballots []types.BallotSummary) {
+	for i := range ballots {
+		ballot := &ballots[i]
+		if !ballot.Passed {
+			k.RejectFailedBallot(ctx, *ballot)
+			continue
+		}
+
+		if k.ApplyExchangeRate(ctx, maxRateChange, ballot.Denom, ballot.ExchangeRate) {
+			ballot.AppliedExchangeRate = ballot.ExchangeRate
+			continue
+		}
+
+		ballot.Rejected = true
+		if exchangeRate, err := k.GetLunaExchangeRate(ctx, ballot.Denom); err == nil {
+			ballot.AppliedExchangeRate = exchangeRate
+		}
+	}
+}
+
+// RejectFailedBallot records the rejection of the exchange rate of the denom whose ballot did not
+// pass the threshold; unlike the exchange rates rejected by the max rate change, none is kept
+func (k Keeper) RejectFailedBallot(ctx sdk.Context, ballot types.BallotSummary) {
+	reason := fmt.Sprintf("ballot power %d below the threshold %d", ballot.BallotPower, ballot.ThresholdPower)
+	k.rejectExchangeRate(ctx, k.getExchangeRateStatus(ctx, ballot.Denom), sdk.ZeroDec(), reason)
+}
+
+// rejectExchangeRate records the reason the exchange rate is rejected in the status with ABCI event
+func (k Keeper) rejectExchangeRate(ctx sdk.Context, status types.ExchangeRateStatus, exchangeRate sdk.Dec, reason string) {
+	status.RejectedHeight = ctx.BlockHeight()
+	status.RejectionReason = reason
+	k.SetExchangeRateStatus(ctx, status)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeExchangeRateReject,
+			sdk.NewAttribute(types.AttributeKeyDenom, status.Denom),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, exchangeRate.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
+
+// GetFreshLunaExchangeRate returns the exchange rate of the denom if it was accepted within
+// the last maxAge blocks, otherwise an error
+func (k Keeper) GetFreshLunaExchangeRate(ctx sdk.Context, denom string, maxAge uint64) (sdk.Dec, error) {
+	exchangeRate, err := k.GetLunaExchangeRate(ctx, denom)
+	if err != nil || denom == core.MicroLunaDenom {
+		return exchangeRate, err
+	}
+
+	status, err := k.GetExchangeRateStatus(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrStaleExchangeRate, "%s has no update height", denom)
+	}
+
+	if age := ctx.BlockHeight() - status.LastUpdateHeight; age > int64(maxAge) {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrStaleExchangeRate, "%s updated %d blocks ago, more than %d", denom, age, maxAge)
+	}
+
+	return exchangeRate, nil
+}
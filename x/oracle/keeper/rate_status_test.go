@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+func TestApplyExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	maxRateChange := sdk.NewDecWithPrec(1, 1) // 10%
+
+	_, err := input.OracleKeeper.GetExchangeRateStatus(input.Ctx, core.MicroKRWDenom)
+	require.Error(t, err)
+
+	// the first exchange rate is accepted
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	require.True(t, input.OracleKeeper.ApplyExchangeRate(input.Ctx, maxRateChange, core.MicroKRWDenom, sdk.NewDec(1000)))
+
+	status, err := input.OracleKeeper.GetExchangeRateStatus(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1000), status.ExchangeRate)
+	require.Equal(t, int64(10), status.LastUpdateHeight)
+	require.Empty(t, status.RejectionReason)
+
+	// within the max rate change
+	input.Ctx = input.Ctx.WithBlockHeight(20)
+	require.True(t, input.OracleKeeper.ApplyExchangeRate(input.Ctx, maxRateChange, core.MicroKRWDenom, sdk.NewDec(1100)))
+
+	// beyond the max rate change, the last accepted exchange rate is kept
+	input.Ctx = input.Ctx.WithBlockHeight(30)
+	input.OracleKeeper.DeleteLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.False(t, input.OracleKeeper.ApplyExchangeRate(input.Ctx, maxRateChange, core.MicroKRWDenom, sdk.NewDec(1500)))
+
+	rate, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1100), rate)
+
+	status, err = input.OracleKeeper.GetExchangeRateStatus(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1100), status.ExchangeRate)
+	require.Equal(t, int64(20), status.LastUpdateHeight)
+	require.Equal(t, sdk.NewDec(1500), status.LastMedian)
+	require.Equal(t, int64(30), status.RejectedHeight)
+	require.NotEmpty(t, status.RejectionReason)
+
+	// confirmed by the next exchange rate
+	input.Ctx = input.Ctx.WithBlockHeight(40)
+	require.True(t, input.OracleKeeper.ApplyExchangeRate(input.Ctx, maxRateChange, core.MicroKRWDenom, sdk.NewDec(1510)))
+
+	status, err = input.OracleKeeper.GetExchangeRateStatus(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1510), status.ExchangeRate)
+	require.Equal(t, int64(40), status.LastUpdateHeight)
+
+	// unlimited
+	require.True(t, input.OracleKeeper.ApplyExchangeRate(input.Ctx, sdk.ZeroDec(), core.MicroKRWDenom, sdk.NewDec(3000)))
+}
+
+func TestRejectFailedBallot(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	input.OracleKeeper.ApplyExchangeRate(input.Ctx, sdk.ZeroDec(), core.MicroKRWDenom, sdk.NewDec(1000))
+
+	input.Ctx = input.Ctx.WithBlockHeight(20)
+	input.OracleKeeper.RejectFailedBallot(input.Ctx, types.BallotSummary{Denom: core.MicroKRWDenom, BallotPower: 10, ThresholdPower: 50})
+
+	status, err := input.OracleKeeper.GetExchangeRateStatus(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), status.LastUpdateHeight)
+	require.Equal(t, int64(20), status.RejectedHeight)
+	require.Equal(t, "ballot power 10 below the threshold 50", status.RejectionReason)
+}
+
+func TestGetFreshLunaExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	input.OracleKeeper.ApplyExchangeRate(input.Ctx, sdk.ZeroDec(), core.MicroKRWDenom, sdk.NewDec(1000))
+
+	input.Ctx = input.Ctx.WithBlockHeight(15)
+	rate, err := input.OracleKeeper.GetFreshLunaExchangeRate(input.Ctx, core.MicroKRWDenom, 5)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1000), rate)
+
+	_, err = input.OracleKeeper.GetFreshLunaExchangeRate(input.Ctx, core.MicroKRWDenom, 4)
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+
+	// Luna is always fresh
+	rate, err = input.OracleKeeper.GetFreshLunaExchangeRate(input.Ctx, core.MicroLunaDenom, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), rate)
+
+	// no exchange rate
+	_, err = input.OracleKeeper.GetFreshLunaExchangeRate(input.Ctx, core.MicroUSDDenom, 5)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+}
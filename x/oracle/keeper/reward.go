@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/types/rules"
 	"github.com/terra-money/core/x/oracle/types"
 )
 
@@ -20,8 +21,7 @@ func (k Keeper) RewardBallotWinners(
 	ballotWinners map[string]types.Claim,
 ) {
 	// softfork for reward distribution
-	if (ctx.ChainID() == core.ColumbusChainID && ctx.BlockHeight() < int64(5_100_000)) ||
-		(ctx.ChainID() == core.BombayChainID && ctx.BlockHeight() < int64(6_200_000)) {
+	if !rules.IsActive(ctx, rules.OracleRewardAllDenoms) {
 		k.RewardBallotWinnersLegacy(ctx, votePeriod, rewardDistributionWindow, ballotWinners)
 		return
 	}
@@ -1,33 +1,64 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/oracle/types"
 )
 
-// SlashAndResetMissCounters do slash any operator who over criteria & clear all operators miss counter to zero
+// GetValidatorStanding returns the penalty the validator faces at the end of the current
+// slash window if it misses no more vote period
+func (k Keeper) GetValidatorStanding(ctx sdk.Context, operator sdk.ValAddress) types.ValidatorStanding {
+	return k.getValidatorStanding(ctx, k.GetParams(ctx), operator, k.GetMissCounter(ctx, operator))
+}
+
+func (k Keeper) getValidatorStanding(ctx sdk.Context, params types.Params, operator sdk.ValAddress, missCounter uint64) types.ValidatorStanding {
+	windowEndHeight := int64((uint64(ctx.BlockHeight())/params.SlashWindow+1)*params.SlashWindow) - 1
+	return types.NewValidatorStanding(params, operator, missCounter, k.GetBadWindowCounter(ctx, operator), windowEndHeight)
+}
+
+// IncreaseMissCounterWithEvent increases the miss counter of the validator
+// and emits an ABCI event when it worsens the standing of the validator
+func (k Keeper) IncreaseMissCounterWithEvent(ctx sdk.Context, params types.Params, operator sdk.ValAddress) {
+	missCounter := k.GetMissCounter(ctx, operator)
+	k.SetMissCounter(ctx, operator, missCounter+1)
+
+	previous := k.getValidatorStanding(ctx, params, operator, missCounter)
+	standing := k.getValidatorStanding(ctx, params, operator, missCounter+1)
+	if previous.Status == standing.Status {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeStanding,
+			sdk.NewAttribute(types.AttributeKeyOperator, standing.Validator),
+			sdk.NewAttribute(types.AttributeKeyStatus, standing.Status),
+			sdk.NewAttribute(types.AttributeKeyMissCounter, strconv.FormatUint(standing.MissCounter, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidVoteRate, standing.ValidVoteRate.String()),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, standing.SlashFraction.String()),
+			sdk.NewAttribute(types.AttributeKeyWindowEnd, strconv.FormatInt(standing.WindowEndHeight, 10)),
+		),
+	)
+}
+
+// SlashAndResetMissCounters do slash any operator who over criteria & clear all operators miss counter to zero.
+// The slash fraction grows with the consecutive slash windows an operator is slashed in, and the operator
+// is jailed once they reach JailAfterWindows.
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	height := ctx.BlockHeight()
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-	// slash_window / vote_period
-	votePeriodsPerWindow := uint64(
-		sdk.NewDec(int64(k.SlashWindow(ctx))).
-			QuoInt64(int64(k.VotePeriod(ctx))).
-			TruncateInt64(),
-	)
-	minValidPerWindow := k.MinValidPerWindow(ctx)
-	slashFraction := k.SlashFraction(ctx)
+	params := k.GetParams(ctx)
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
 
+	slashed := make(map[string]bool)
 	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
-
-		// Calculate valid vote rate; (SlashWindow - MissCounter)/SlashWindow
-		validVoteRate := sdk.NewDecFromInt(
-			sdk.NewInt(int64(votePeriodsPerWindow - missCounter))).
-			QuoInt64(int64(votePeriodsPerWindow))
+		standing := k.getValidatorStanding(ctx, params, operator, missCounter)
 
 		// Penalize the validator whose the valid vote rate is smaller than min threshold
-		if validVoteRate.LT(minValidPerWindow) {
+		if standing.IsSlashed() {
 			validator := k.StakingKeeper.Validator(ctx, operator)
 			if validator.IsBonded() && !validator.IsJailed() {
 				consAddr, err := validator.GetConsAddr()
@@ -37,13 +68,28 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 
 				k.StakingKeeper.Slash(
 					ctx, consAddr,
-					distributionHeight, validator.GetConsensusPower(powerReduction), slashFraction,
+					distributionHeight, validator.GetConsensusPower(powerReduction), standing.SlashFraction,
 				)
-				k.StakingKeeper.Jail(ctx, consAddr)
+
+				if standing.Status == types.StandingJail {
+					k.StakingKeeper.Jail(ctx, consAddr)
+				}
+
+				k.SetBadWindowCounter(ctx, operator, standing.BadWindows+1)
+				slashed[operator.String()] = true
 			}
 		}
 
 		k.DeleteMissCounter(ctx, operator)
 		return false
 	})
+
+	// Reset the bad window counters of the operators not slashed in this window
+	k.IterateBadWindowCounters(ctx, func(operator sdk.ValAddress, _ uint64) bool {
+		if !slashed[operator.String()] {
+			k.DeleteBadWindowCounter(ctx, operator)
+		}
+
+		return false
+	})
 }
@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/terra-money/core/x/oracle/types"
 )
 
 func TestSlashAndResetMissCounters(t *testing.T) {
@@ -80,3 +82,81 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 	validator, _ = input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
 	require.Equal(t, amt, validator.Tokens)
 }
+
+func TestGraduatedSlashing(t *testing.T) {
+	input := CreateTestInput(t)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx
+
+	_, err := sh(ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.SlashFraction = sdk.NewDecWithPrec(1, 2)
+	params.MaxSlashFraction = sdk.NewDecWithPrec(1, 1)
+	params.JailAfterWindows = 2
+	input.OracleKeeper.SetParams(ctx, params)
+
+	votePeriodsPerWindow := params.SlashWindow / params.VotePeriod
+
+	// Window 1, slashed by the slash fraction without jail
+	input.OracleKeeper.SetMissCounter(ctx, ValAddrs[0], votePeriodsPerWindow)
+	input.OracleKeeper.SlashAndResetMissCounters(ctx)
+	validator, _ := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.Equal(t, sdk.NewInt(99_000_000), validator.GetBondedTokens())
+	require.False(t, validator.IsJailed())
+	require.Equal(t, uint64(1), input.OracleKeeper.GetBadWindowCounter(ctx, ValAddrs[0]))
+
+	// Window 2, slashed by twice the slash fraction and jailed
+	input.OracleKeeper.SetMissCounter(ctx, ValAddrs[0], votePeriodsPerWindow)
+	input.OracleKeeper.SlashAndResetMissCounters(ctx)
+	validator, _ = input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.Equal(t, sdk.NewInt(97_020_000), validator.GetBondedTokens())
+	require.True(t, validator.IsJailed())
+	require.Equal(t, uint64(2), input.OracleKeeper.GetBadWindowCounter(ctx, ValAddrs[0]))
+
+	// Window 3, no slash resets the bad window counter
+	input.OracleKeeper.SlashAndResetMissCounters(ctx)
+	require.Equal(t, uint64(0), input.OracleKeeper.GetBadWindowCounter(ctx, ValAddrs[0]))
+}
+
+func TestIncreaseMissCounterWithEvent(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 10
+	params.SlashWindow = 100 // 10 vote periods
+	params.MinValidPerWindow = sdk.NewDecWithPrec(50, 2)
+	params.WarningValidPerWindow = sdk.NewDecWithPrec(80, 2)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	standingEvents := func(ctx sdk.Context) (statuses []string) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeStanding {
+				continue
+			}
+
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyStatus {
+					statuses = append(statuses, string(attr.Value))
+				}
+			}
+		}
+
+		return
+	}
+
+	ctx := input.Ctx.WithBlockHeight(50).WithEventManager(sdk.NewEventManager())
+	for i := 0; i < 6; i++ {
+		input.OracleKeeper.IncreaseMissCounterWithEvent(ctx, params, ValAddrs[0])
+	}
+
+	// warned at the third miss, slashed from the sixth
+	require.Equal(t, []string{types.StandingWarning, types.StandingJail}, standingEvents(ctx))
+
+	standing := input.OracleKeeper.GetValidatorStanding(ctx, ValAddrs[0])
+	require.Equal(t, types.StandingJail, standing.Status)
+	require.Equal(t, uint64(6), standing.MissCounter)
+	require.Equal(t, int64(99), standing.WindowEndHeight)
+}
@@ -0,0 +1,293 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/types/rules"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+// TallyHandler is called with every tallied ballot, converted to cross exchange rates
+// unless it is the ballot of the reference Terra, its exchange rate before the conversion
+// back to uluna and the win counts of its voters before the tally
+type TallyHandler func(denom string, ballot types.ExchangeRateBallot, exchangeRate sdk.Dec, winCounts map[string]int64)
+
+// GetValidatorClaimMap returns the claims of the bonded validators in the active set
+func (k Keeper) GetValidatorClaimMap(ctx sdk.Context) map[string]types.Claim {
+	validatorClaimMap := make(map[string]types.Claim)
+
+	maxValidators := k.StakingKeeper.MaxValidators(ctx)
+	iterator := k.StakingKeeper.ValidatorsPowerStoreIterator(ctx)
+	defer iterator.Close()
+
+	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+
+	i := 0
+	for ; iterator.Valid() && i < int(maxValidators); iterator.Next() {
+		validator := k.StakingKeeper.Validator(ctx, iterator.Value())
+
+		// Exclude not bonded validator
+		if validator.IsBonded() {
+			valAddr := validator.GetOperator()
+			validatorClaimMap[valAddr.String()] = types.NewClaim(validator.GetConsensusPower(powerReduction), 0, 0, valAddr)
+			i++
+		}
+	}
+
+	return validatorClaimMap
+}
+
+// TallyBallots organizes the aggregate votes in store to ballots, picks the reference Terra and
+// tallies the ballots of the vote targets passing the threshold. The failing vote targets are
+// removed from voteTargets and the claims of the winners are updated. Nothing is written to the
+// store, the exchange rates to set are the ones of the passed ballots of the returned summary.
+func (k Keeper) TallyBallots(
+	ctx sdk.Context,
+	params types.Params,
+	voteTargets map[string]sdk.Dec,
+	validatorClaimMap map[string]types.Claim,
+	handler TallyHandler,
+) types.VotePeriodSummary {
+	// Organize votes to ballot by denom
+	// NOTE: **Filter out inactive or jailed validators**
+	// NOTE: **Make abstain votes to have zero vote power**
+	voteMap := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
+
+	// Summarize the ballots of all vote targets before the failing ones are dropped
+	ballotSummaries := summarizeBallots(k.BallotThreshold(ctx), voteTargets, voteMap)
+
+	referenceTerra := k.pickReferenceTerra(ctx, voteTargets, voteMap)
+	if referenceTerra != "" {
+		// make voteMap of Reference Terra to calculate cross exchange rates
+		ballotRT := voteMap[referenceTerra]
+		voteMapRT := ballotRT.ToMap()
+
+		var exchangeRateRT sdk.Dec
+
+		// softfork
+		if !rules.IsActive(ctx, rules.OracleSortedBallot) {
+			exchangeRateRT = ballotRT.WeightedMedian()
+		} else {
+			exchangeRateRT = params.Whitelist.Aggregator(referenceTerra).Aggregate(ballotRT)
+		}
+
+		// Iterate through ballots and update exchange rates; drop if not enough votes have been achieved.
+		for denom, ballot := range voteMap {
+
+			// Convert ballot to cross exchange rates
+			if denom != referenceTerra {
+
+				// softfork
+				if !rules.IsActive(ctx, rules.OracleSortedBallot) {
+					ballot = ballot.ToCrossRate(voteMapRT)
+				} else {
+					ballot = ballot.ToCrossRateWithSort(voteMapRT)
+				}
+			}
+
+			// Aggregate cross exchange rates
+			winCounts := make(map[string]int64, len(ballot))
+			for _, vote := range ballot {
+				winCounts[vote.Voter.String()] = validatorClaimMap[vote.Voter.String()].WinCount
+			}
+
+			exchangeRate := Tally(ctx, ballot, params.Whitelist.Aggregator(denom), params.RewardBand, validatorClaimMap)
+			summarizeTally(ballotSummaries[denom], ballot, exchangeRate, ballotRewardSpread(ballot, exchangeRate, params.RewardBand), winCounts, validatorClaimMap)
+			if handler != nil {
+				handler(denom, ballot, exchangeRate, winCounts)
+			}
+
+			// Transform into the original form uluna/stablecoin
+			if denom != referenceTerra {
+				exchangeRate = exchangeRateRT.Quo(exchangeRate)
+			}
+
+			ballotSummaries[denom].ExchangeRate = exchangeRate
+		}
+	}
+
+	return newVotePeriodSummary(ctx.BlockHeight(), referenceTerra, ballotSummaries, validatorClaimMap)
+}
+
+// Tally aggregates the ballot with the aggregator of the denom and returns the exchange rate. Sets the set
+// of voters to be rewarded, i.e. voted within a reasonable spread from the exchange rate to the store
+// CONTRACT: pb must be sorted
+func Tally(ctx sdk.Context, pb types.ExchangeRateBallot, aggregator types.Aggregator, rewardBand sdk.Dec, validatorClaimMap map[string]types.Claim) (exchangeRate sdk.Dec) {
+	// softfork
+	if !rules.IsActive(ctx, rules.OracleSortedBallot) {
+		exchangeRate = pb.WeightedMedian()
+	} else {
+		exchangeRate = aggregator.Aggregate(pb)
+	}
+
+	rewardSpread := ballotRewardSpread(pb, exchangeRate, rewardBand)
+
+	for _, vote := range pb {
+		// Filter ballot winners & abstain voters
+		if (vote.ExchangeRate.GTE(exchangeRate.Sub(rewardSpread)) &&
+			vote.ExchangeRate.LTE(exchangeRate.Add(rewardSpread))) ||
+			!vote.ExchangeRate.IsPositive() {
+
+			key := vote.Voter.String()
+			claim := validatorClaimMap[key]
+			claim.Weight += vote.Power
+			claim.WinCount++
+			validatorClaimMap[key] = claim
+		}
+	}
+
+	return
+}
+
+// ballotRewardSpread returns the distance from the exchange rate within which votes win,
+// half of the reward band or the standard deviation of the ballot when larger
+func ballotRewardSpread(pb types.ExchangeRateBallot, exchangeRate sdk.Dec, rewardBand sdk.Dec) sdk.Dec {
+	standardDeviation := pb.StandardDeviation(exchangeRate)
+	rewardSpread := exchangeRate.Mul(rewardBand.QuoInt64(2))
+
+	if standardDeviation.GT(rewardSpread) {
+		rewardSpread = standardDeviation
+	}
+
+	return rewardSpread
+}
+
+// BallotThreshold returns the voting power a ballot needs to pass
+func (k Keeper) BallotThreshold(ctx sdk.Context) sdk.Int {
+	return k.ballotThreshold(ctx, k.VoteThreshold(ctx))
+}
+
+// ballotThreshold returns the voting power a ballot needs to pass the vote threshold
+func (k Keeper) ballotThreshold(ctx sdk.Context, voteThreshold sdk.Dec) sdk.Int {
+	totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
+	return voteThreshold.MulInt64(totalBondedPower).RoundInt()
+}
+
+// ballot for the asset is passing the threshold amount of voting power
+func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes sdk.Int) (sdk.Int, bool) {
+	ballotPower := sdk.NewInt(ballot.Power())
+	return ballotPower, !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes)
+}
+
+// choose Reference Terra with the highest voter turnout
+// If the voting power of the two denominations is the same,
+// select reference Terra in alphabetical order.
+func (k Keeper) pickReferenceTerra(ctx sdk.Context, voteTargets map[string]sdk.Dec, voteMap map[string]types.ExchangeRateBallot) string {
+	largestBallotPower := int64(0)
+	referenceTerra := ""
+
+	thresholdVotes := k.BallotThreshold(ctx)
+
+	for denom, ballot := range voteMap {
+		// If denom is not in the voteTargets, or the ballot for it has failed, then skip
+		// and remove it from voteMap for iteration efficiency
+		if _, exists := voteTargets[denom]; !exists {
+			delete(voteMap, denom)
+			continue
+		}
+
+		ballotPower := int64(0)
+
+		// If the ballot is not passed, remove it from the voteTargets array
+		// to prevent slashing validators who did valid vote.
+		if power, ok := ballotIsPassing(ballot, thresholdVotes); ok {
+			ballotPower = power.Int64()
+		} else {
+			delete(voteTargets, denom)
+			delete(voteMap, denom)
+			continue
+		}
+
+		if ballotPower > largestBallotPower || largestBallotPower == 0 {
+			referenceTerra = denom
+			largestBallotPower = ballotPower
+		} else if largestBallotPower == ballotPower && referenceTerra > denom {
+			referenceTerra = denom
+		}
+	}
+
+	return referenceTerra
+}
+
+// summarizeBallots returns the summaries of the ballots of the vote targets
+// with their power and whether they pass the threshold
+func summarizeBallots(thresholdVotes sdk.Int, voteTargets map[string]sdk.Dec, voteMap map[string]types.ExchangeRateBallot) map[string]*types.BallotSummary {
+	ballotSummaries := make(map[string]*types.BallotSummary, len(voteTargets))
+	for denom := range voteTargets {
+		ballotPower, passed := ballotIsPassing(voteMap[denom], thresholdVotes)
+		ballotSummaries[denom] = &types.BallotSummary{
+			Denom:               denom,
+			BallotPower:         ballotPower.Int64(),
+			ThresholdPower:      thresholdVotes.Int64(),
+			Passed:              passed,
+			WeightedMedian:      sdk.ZeroDec(),
+			RewardSpread:        sdk.ZeroDec(),
+			ExchangeRate:        sdk.ZeroDec(),
+			AppliedExchangeRate: sdk.ZeroDec(),
+		}
+	}
+
+	return ballotSummaries
+}
+
+// summarizeTally records the tally of the ballot in its summary; a voter won
+// when Tally increased its win count and abstained with a non-positive rate
+func summarizeTally(
+	summary *types.BallotSummary,
+	ballot types.ExchangeRateBallot,
+	weightedMedian sdk.Dec,
+	rewardSpread sdk.Dec,
+	winCounts map[string]int64,
+	validatorClaimMap map[string]types.Claim,
+) {
+	summary.WeightedMedian = weightedMedian
+	summary.RewardSpread = rewardSpread
+
+	for _, vote := range ballot {
+		key := vote.Voter.String()
+		switch {
+		case !vote.ExchangeRate.IsPositive():
+			summary.Abstained = append(summary.Abstained, key)
+		case validatorClaimMap[key].WinCount > winCounts[key]:
+			summary.Winners = append(summary.Winners, key)
+		}
+	}
+}
+
+// newVotePeriodSummary returns the summary of the vote period with the ballots sorted by denom;
+// the active validators which neither won nor abstained a tallied ballot missed it
+func newVotePeriodSummary(height int64, referenceTerra string, ballotSummaries map[string]*types.BallotSummary, validatorClaimMap map[string]types.Claim) types.VotePeriodSummary {
+	summary := types.VotePeriodSummary{
+		Height:         height,
+		ReferenceDenom: referenceTerra,
+		Ballots:        make([]types.BallotSummary, 0, len(ballotSummaries)),
+	}
+
+	for _, ballot := range ballotSummaries {
+		if ballot.Passed {
+			voted := make(map[string]bool, len(ballot.Winners)+len(ballot.Abstained))
+			for _, voter := range append(append([]string{}, ballot.Winners...), ballot.Abstained...) {
+				voted[voter] = true
+			}
+
+			for key := range validatorClaimMap {
+				if !voted[key] {
+					ballot.Missed = append(ballot.Missed, key)
+				}
+			}
+		}
+
+		sort.Strings(ballot.Winners)
+		sort.Strings(ballot.Missed)
+		sort.Strings(ballot.Abstained)
+		summary.Ballots = append(summary.Ballots, *ballot)
+	}
+
+	sort.Slice(summary.Ballots, func(i, j int) bool {
+		return summary.Ballots[i].Denom < summary.Ballots[j].Denom
+	})
+
+	return summary
+}
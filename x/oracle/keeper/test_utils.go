@@ -124,6 +124,8 @@ type TestInput struct {
 	OracleKeeper  Keeper
 	StakingKeeper stakingkeeper.Keeper
 	DistrKeeper   distrkeeper.Keeper
+	ParamsKeeper  paramskeeper.Keeper
+	ParamsKey     sdk.StoreKey
 }
 
 // CreateTestInput nolint
@@ -240,7 +242,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		keeper.SetTobinTax(ctx, denom.Name, denom.TobinTax)
 	}
 
-	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, keeper, stakingKeeper, distrKeeper}
+	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, keeper, stakingKeeper, distrKeeper, paramsKeeper, keyParams}
 }
 
 // NewTestMsgCreateValidator test msg creator
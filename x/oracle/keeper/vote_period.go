@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+// GetLastVotePeriodSummary returns the outcome of the ballots of the last vote period,
+// an empty summary before the first vote period ended
+func (k Keeper) GetLastVotePeriodSummary(ctx sdk.Context) (summary types.VotePeriodSummary) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastVotePeriodSummaryKey)
+	if bz == nil {
+		return types.VotePeriodSummary{}
+	}
+
+	k.cdc.MustUnmarshal(bz, &summary)
+	return
+}
+
+// SetLastVotePeriodSummaryWithEvent sets the outcome of the ballots of the vote period
+// to the store with an ABCI event per ballot
+func (k Keeper) SetLastVotePeriodSummaryWithEvent(ctx sdk.Context, summary types.VotePeriodSummary) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&summary)
+	store.Set(types.LastVotePeriodSummaryKey, bz)
+
+	for _, ballot := range summary.Ballots {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeBallot,
+				sdk.NewAttribute(types.AttributeKeyDenom, ballot.Denom),
+				sdk.NewAttribute(types.AttributeKeyReferenceDenom, summary.ReferenceDenom),
+				sdk.NewAttribute(types.AttributeKeyBallotPower, strconv.FormatInt(ballot.BallotPower, 10)),
+				sdk.NewAttribute(types.AttributeKeyThresholdPower, strconv.FormatInt(ballot.ThresholdPower, 10)),
+				sdk.NewAttribute(types.AttributeKeyPassed, strconv.FormatBool(ballot.Passed)),
+				sdk.NewAttribute(types.AttributeKeyWeightedMedian, ballot.WeightedMedian.String()),
+				sdk.NewAttribute(types.AttributeKeyRewardSpread, ballot.RewardSpread.String()),
+				sdk.NewAttribute(types.AttributeKeyExchangeRate, ballot.ExchangeRate.String()),
+				sdk.NewAttribute(types.AttributeKeyWinners, strings.Join(ballot.Winners, ",")),
+				sdk.NewAttribute(types.AttributeKeyMissed, strings.Join(ballot.Missed, ",")),
+				sdk.NewAttribute(types.AttributeKeyAbstained, strings.Join(ballot.Abstained, ",")),
+				sdk.NewAttribute(types.AttributeKeyRejected, strconv.FormatBool(ballot.Rejected)),
+				sdk.NewAttribute(types.AttributeKeyAppliedRate, ballot.AppliedExchangeRate.String()),
+			),
+		)
+	}
+}
@@ -3,6 +3,8 @@ package v05
 import (
 	"sort"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	v04oracle "github.com/terra-money/core/x/oracle/legacy/v04"
 	v05oracle "github.com/terra-money/core/x/oracle/types"
 )
@@ -116,7 +118,16 @@ func Migrate(
 			SlashFraction:            oracleGenState.Params.SlashFraction,
 			SlashWindow:              uint64(oracleGenState.Params.SlashWindow),
 			MinValidPerWindow:        oracleGenState.Params.MinValidPerWindow,
+			WarningValidPerWindow:    sdk.MaxDec(v05oracle.DefaultWarningValidPerWindow, oracleGenState.Params.MinValidPerWindow),
+			MaxSlashFraction:         sdk.MaxDec(v05oracle.DefaultMaxSlashFraction, oracleGenState.Params.SlashFraction),
+			JailAfterWindows:         v05oracle.DefaultJailAfterWindows,
 			Whitelist:                whitelist,
+			PriceFeeds:               v05oracle.PriceFeedList{},
+			MaxRateChange:            v05oracle.DefaultMaxRateChange,
 		},
+		BadWindowCounters:     []v05oracle.BadWindowCounter{},
+		Prices:                []v05oracle.FeedPrice{},
+		ExchangeRateStatuses:  []v05oracle.ExchangeRateStatus{},
+		ExchangeRateHistories: []v05oracle.ExchangeRateHistory{},
 	}
 }
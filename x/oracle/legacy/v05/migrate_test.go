@@ -176,6 +176,9 @@ func TestMigrate(t *testing.T) {
 			"voter": "terravaloper1mx72uukvzqtzhc6gde7shrjqfu5srk22v3yx7a"
 		}
 	],
+	"bad_window_counters": [],
+	"exchange_rate_histories": [],
+	"exchange_rate_statuses": [],
 	"exchange_rates": [
 		{
 			"denom": "usdr",
@@ -207,24 +210,32 @@ func TestMigrate(t *testing.T) {
 		}
 	],
 	"params": {
+		"jail_after_windows": "1",
+		"max_rate_change": "0.000000000000000000",
+		"max_slash_fraction": "0.001000000000000000",
 		"min_valid_per_window": "0.050000000000000000",
+		"price_feeds": [],
 		"reward_band": "0.070000000000000000",
 		"reward_distribution_window": "100",
 		"slash_fraction": "0.001000000000000000",
 		"slash_window": "100",
 		"vote_period": "100",
 		"vote_threshold": "0.500000000000000000",
+		"warning_valid_per_window": "0.100000000000000000",
 		"whitelist": [
 			{
+				"aggregator": "",
 				"name": "usdr",
 				"tobin_tax": "0.010000000000000000"
 			},
 			{
+				"aggregator": "",
 				"name": "uusd",
 				"tobin_tax": "0.020000000000000000"
 			}
 		]
 	},
+	"prices": [],
 	"tobin_taxes": [
 		{
 			"denom": "usdr",
@@ -0,0 +1,43 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/terra-money/core/x/oracle/keeper"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+// NewParamChangeProposalHandler wraps the param change proposal handler to validate
+// the oracle params as a whole once a proposal changing them is applied; the param
+// subspace only validates each changed param on its own, so it accepts a whitelist
+// and price feeds sharing a name. The gov module discards the changes on error.
+func NewParamChangeProposalHandler(k keeper.Keeper, next govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := next(ctx, content); err != nil {
+			return err
+		}
+
+		c, ok := content.(*proposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+
+		for _, change := range c.Changes {
+			if change.Subspace != types.ModuleName {
+				continue
+			}
+
+			params := k.GetParams(ctx)
+			if err := params.Validate(); err != nil {
+				return sdkerrors.Wrap(proposal.ErrSettingParameter, err.Error())
+			}
+
+			break
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,42 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/oracle"
+	"github.com/terra-money/core/x/oracle/types"
+)
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	input, _ := setup(t)
+	h := oracle.NewParamChangeProposalHandler(input.OracleKeeper, params.NewParamChangeProposalHandler(input.ParamsKeeper))
+
+	priceFeeds := func(name string) *proposal.ParameterChangeProposal {
+		return proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{{
+			Subspace: types.ModuleName,
+			Key:      string(types.KeyPriceFeeds),
+			Value:    `[{"name":"` + name + `","quote":"uusd","vote_threshold":"0.000000000000000000"}]`,
+		}})
+	}
+
+	require.NoError(t, h(input.Ctx, priceFeeds("ubtc")))
+	require.Len(t, input.OracleKeeper.PriceFeeds(input.Ctx), 1)
+
+	// a price feed may not be named after a whitelisted denom
+	ctx, _ := input.Ctx.CacheContext()
+	require.Error(t, h(ctx, priceFeeds(core.MicroKRWDenom)))
+
+	// nor a whitelisted denom after a price feed
+	ctx, _ = input.Ctx.CacheContext()
+	require.Error(t, h(ctx, proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{{
+		Subspace: types.ModuleName,
+		Key:      string(types.KeyWhitelist),
+		Value:    `[{"name":"ubtc","tobin_tax":"0.002500000000000000"}]`,
+	}})))
+}
@@ -25,7 +25,7 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			return fmt.Sprintf("%v\n%v", exchangeRateA, exchangeRateB)
 		case bytes.Equal(kvA.Key[:1], types.FeederDelegationKey):
 			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
-		case bytes.Equal(kvA.Key[:1], types.MissCounterKey):
+		case bytes.Equal(kvA.Key[:1], types.MissCounterKey), bytes.Equal(kvA.Key[:1], types.BadWindowCounterKey):
 			var counterA, counterB gogotypes.UInt64Value
 			cdc.MustUnmarshal(kvA.Value, &counterA)
 			cdc.MustUnmarshal(kvB.Value, &counterB)
@@ -45,6 +45,36 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &tobinTaxA)
 			cdc.MustUnmarshal(kvB.Value, &tobinTaxB)
 			return fmt.Sprintf("%v\n%v", tobinTaxA, tobinTaxB)
+		case bytes.Equal(kvA.Key[:1], types.ExchangeRateHistoryCountKey):
+			var counterA, counterB types.ExchangeRateHistoryCounter
+			cdc.MustUnmarshal(kvA.Value, &counterA)
+			cdc.MustUnmarshal(kvB.Value, &counterB)
+			return fmt.Sprintf("%v\n%v", counterA, counterB)
+		case bytes.Equal(kvA.Key[:1], types.HistoricalExchangeRateKey):
+			var rateA, rateB types.HistoricalExchangeRate
+			cdc.MustUnmarshal(kvA.Value, &rateA)
+			cdc.MustUnmarshal(kvB.Value, &rateB)
+			return fmt.Sprintf("%v\n%v", rateA, rateB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorPerformanceKey):
+			var performanceA, performanceB types.ValidatorPerformance
+			cdc.MustUnmarshal(kvA.Value, &performanceA)
+			cdc.MustUnmarshal(kvB.Value, &performanceB)
+			return fmt.Sprintf("%v\n%v", performanceA, performanceB)
+		case bytes.Equal(kvA.Key[:1], types.LastVotePeriodSummaryKey):
+			var summaryA, summaryB types.VotePeriodSummary
+			cdc.MustUnmarshal(kvA.Value, &summaryA)
+			cdc.MustUnmarshal(kvB.Value, &summaryB)
+			return fmt.Sprintf("%v\n%v", summaryA, summaryB)
+		case bytes.Equal(kvA.Key[:1], types.PriceKey):
+			var priceA, priceB types.FeedPrice
+			cdc.MustUnmarshal(kvA.Value, &priceA)
+			cdc.MustUnmarshal(kvB.Value, &priceB)
+			return fmt.Sprintf("%v\n%v", priceA, priceB)
+		case bytes.Equal(kvA.Key[:1], types.ExchangeRateStatusKey):
+			var statusA, statusB types.ExchangeRateStatus
+			cdc.MustUnmarshal(kvA.Value, &statusA)
+			cdc.MustUnmarshal(kvB.Value, &statusB)
+			return fmt.Sprintf("%v\n%v", statusA, statusB)
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
@@ -38,6 +38,14 @@ func TestDecodeDistributionStore(t *testing.T) {
 	}, valAddr)
 
 	tobinTax := sdk.NewDecWithPrec(2, 2)
+	historyCounter := types.ExchangeRateHistoryCounter{Count: 7, HistorySize: 5}
+	historicalRate := types.HistoricalExchangeRate{Height: 123, ExchangeRate: exchangeRate}
+	performance := types.NewValidatorPerformance(valAddr, 2, 100)
+	performance.AddDenomVote(core.MicroKRWDenom, true, sdk.NewDecWithPrec(1, 2))
+	summary := types.VotePeriodSummary{Height: 123, ReferenceDenom: core.MicroKRWDenom}
+	badWindowCounter := uint64(2)
+	rateStatus := types.ExchangeRateStatus{Denom: core.MicroKRWDenom, ExchangeRate: exchangeRate, LastUpdateHeight: 123, LastMedian: exchangeRate}
+	price := types.FeedPrice{Name: "ubtc", Quote: core.MicroUSDDenom, Price: sdk.NewDec(30000)}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -47,6 +55,13 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.AggregateExchangeRatePrevoteKey, Value: cdc.MustMarshal(&aggregatePrevote)},
 			{Key: types.AggregateExchangeRateVoteKey, Value: cdc.MustMarshal(&aggregateVote)},
 			{Key: types.TobinTaxKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: tobinTax})},
+			{Key: types.ExchangeRateHistoryCountKey, Value: cdc.MustMarshal(&historyCounter)},
+			{Key: types.HistoricalExchangeRateKey, Value: cdc.MustMarshal(&historicalRate)},
+			{Key: types.ValidatorPerformanceKey, Value: cdc.MustMarshal(&performance)},
+			{Key: types.LastVotePeriodSummaryKey, Value: cdc.MustMarshal(&summary)},
+			{Key: types.BadWindowCounterKey, Value: cdc.MustMarshal(&gogotypes.UInt64Value{Value: badWindowCounter})},
+			{Key: types.PriceKey, Value: cdc.MustMarshal(&price)},
+			{Key: types.ExchangeRateStatusKey, Value: cdc.MustMarshal(&rateStatus)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -61,6 +76,13 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"AggregatePrevote", fmt.Sprintf("%v\n%v", aggregatePrevote, aggregatePrevote)},
 		{"AggregateVote", fmt.Sprintf("%v\n%v", aggregateVote, aggregateVote)},
 		{"TobinTax", fmt.Sprintf("%v\n%v", tobinTax, tobinTax)},
+		{"ExchangeRateHistoryCount", fmt.Sprintf("%v\n%v", historyCounter, historyCounter)},
+		{"HistoricalExchangeRate", fmt.Sprintf("%v\n%v", historicalRate, historicalRate)},
+		{"ValidatorPerformance", fmt.Sprintf("%v\n%v", performance, performance)},
+		{"LastVotePeriodSummary", fmt.Sprintf("%v\n%v", summary, summary)},
+		{"BadWindowCounter", fmt.Sprintf("%v\n%v", badWindowCounter, badWindowCounter)},
+		{"Price", fmt.Sprintf("%v\n%v", price, price)},
+		{"ExchangeRateStatus", fmt.Sprintf("%v\n%v", rateStatus, rateStatus)},
 		{"other", ""},
 	}
 
@@ -23,6 +23,10 @@ const (
 	slashFractionKey            = "slash_fraction"
 	slashWindowKey              = "slash_window"
 	minValidPerWindowKey        = "min_valid_per_window"
+	warningValidPerWindowKey    = "warning_valid_per_window"
+	maxSlashFractionKey         = "max_slash_fraction"
+	jailAfterWindowsKey         = "jail_after_windows"
+	maxRateChangeKey            = "max_rate_change"
 )
 
 // GenVotePeriod randomized VotePeriod
@@ -60,6 +64,26 @@ func GenMinValidPerWindow(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3))
 }
 
+// GenWarningValidPerWindow randomized WarningValidPerWindow
+func GenWarningValidPerWindow(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(500, 3).Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3))
+}
+
+// GenMaxSlashFraction randomized MaxSlashFraction
+func GenMaxSlashFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(100, 3).Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
+}
+
+// GenJailAfterWindows randomized JailAfterWindows
+func GenJailAfterWindows(r *rand.Rand) uint64 {
+	return uint64(r.Intn(4))
+}
+
+// GenMaxRateChange randomized MaxRateChange
+func GenMaxRateChange(r *rand.Rand) sdk.Dec {
+	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3))
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 
@@ -105,6 +129,30 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { minValidPerWindow = GenMinValidPerWindow(r) },
 	)
 
+	var warningValidPerWindow sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, warningValidPerWindowKey, &warningValidPerWindow, simState.Rand,
+		func(r *rand.Rand) { warningValidPerWindow = GenWarningValidPerWindow(r) },
+	)
+
+	var maxSlashFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxSlashFractionKey, &maxSlashFraction, simState.Rand,
+		func(r *rand.Rand) { maxSlashFraction = GenMaxSlashFraction(r) },
+	)
+
+	var jailAfterWindows uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, jailAfterWindowsKey, &jailAfterWindows, simState.Rand,
+		func(r *rand.Rand) { jailAfterWindows = GenJailAfterWindows(r) },
+	)
+
+	var maxRateChange sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxRateChangeKey, &maxRateChange, simState.Rand,
+		func(r *rand.Rand) { maxRateChange = GenMaxRateChange(r) },
+	)
+
 	oracleGenesis := types.NewGenesisState(
 		types.Params{
 			VotePeriod:               votePeriod,
@@ -116,9 +164,14 @@ func RandomizedGenState(simState *module.SimulationState) {
 				{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax},
 				{Name: core.MicroUSDDenom, TobinTax: types.DefaultTobinTax},
 				{Name: core.MicroMNTDenom, TobinTax: sdk.NewDecWithPrec(2, 2)}},
-			SlashFraction:     slashFraction,
-			SlashWindow:       slashWindow,
-			MinValidPerWindow: minValidPerWindow,
+			SlashFraction:         slashFraction,
+			SlashWindow:           slashWindow,
+			MinValidPerWindow:     minValidPerWindow,
+			WarningValidPerWindow: warningValidPerWindow,
+			MaxSlashFraction:      maxSlashFraction,
+			JailAfterWindows:      jailAfterWindows,
+			PriceFeeds:            types.PriceFeedList{},
+			MaxRateChange:         maxRateChange,
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
@@ -126,6 +179,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		[]types.AggregateExchangeRatePrevote{},
 		[]types.AggregateExchangeRateVote{},
 		[]types.TobinTax{},
+		[]types.BadWindowCounter{},
+		[]types.FeedPrice{},
+		[]types.ExchangeRateStatus{},
+		[]types.ExchangeRateHistory{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Aggregators selectable for a denom in the whitelist
+const (
+	AggregatorWeightedMedian          = "weighted_median"
+	AggregatorTrimmedMean             = "trimmed_mean"
+	AggregatorMedianAbsoluteDeviation = "median_absolute_deviation"
+)
+
+// Aggregation settings
+var (
+	// TrimmedMeanFraction is the fraction of the ballot power trimmed from each end by the trimmed mean
+	TrimmedMeanFraction = sdk.NewDecWithPrec(25, 2)
+
+	// MADOutlierThreshold is the number of median absolute deviations from the weighted median
+	// beyond which a vote is rejected as an outlier
+	MADOutlierThreshold = sdk.NewDec(3)
+)
+
+// Aggregator computes the exchange rate of a ballot; abstain votes have no power
+// and are ignored by the aggregation.
+// CONTRACT: ballot must be sorted
+type Aggregator interface {
+	Aggregate(pb ExchangeRateBallot) sdk.Dec
+}
+
+// GetAggregator returns the aggregator of the name, the weighted median when empty
+func GetAggregator(name string) (Aggregator, error) {
+	switch name {
+	case "", AggregatorWeightedMedian:
+		return WeightedMedianAggregator{}, nil
+	case AggregatorTrimmedMean:
+		return TrimmedMeanAggregator{Fraction: TrimmedMeanFraction}, nil
+	case AggregatorMedianAbsoluteDeviation:
+		return MADAggregator{Threshold: MADOutlierThreshold}, nil
+	default:
+		return nil, fmt.Errorf("unknown aggregator: %s", name)
+	}
+}
+
+// WeightedMedianAggregator aggregates a ballot into its weighted median
+type WeightedMedianAggregator struct{}
+
+// Aggregate implements Aggregator
+func (WeightedMedianAggregator) Aggregate(pb ExchangeRateBallot) sdk.Dec {
+	return pb.WeightedMedianWithAssertion()
+}
+
+// TrimmedMeanAggregator aggregates a ballot into the mean weighted by power,
+// once Fraction of the ballot power is trimmed from each end
+type TrimmedMeanAggregator struct {
+	Fraction sdk.Dec
+}
+
+// Aggregate implements Aggregator
+func (a TrimmedMeanAggregator) Aggregate(pb ExchangeRateBallot) sdk.Dec {
+	if !sort.IsSorted(pb) {
+		panic("ballot must be sorted")
+	}
+
+	totalPower := sdk.NewDec(pb.Power())
+	lower := totalPower.Mul(a.Fraction)
+	upper := totalPower.Sub(lower)
+
+	sum := sdk.ZeroDec()
+	weight := sdk.ZeroDec()
+	pivot := sdk.ZeroDec()
+	for _, v := range pb {
+		from := sdk.MaxDec(pivot, lower)
+		pivot = pivot.Add(sdk.NewDec(v.Power))
+		to := sdk.MinDec(pivot, upper)
+
+		if to.GT(from) {
+			sum = sum.Add(v.ExchangeRate.Mul(to.Sub(from)))
+			weight = weight.Add(to.Sub(from))
+		}
+	}
+
+	if !weight.IsPositive() {
+		return pb.WeightedMedianWithAssertion()
+	}
+
+	return sum.Quo(weight)
+}
+
+// MADAggregator aggregates a ballot into the mean weighted by power of the votes
+// within Threshold median absolute deviations from the weighted median
+type MADAggregator struct {
+	Threshold sdk.Dec
+}
+
+// Aggregate implements Aggregator
+func (a MADAggregator) Aggregate(pb ExchangeRateBallot) sdk.Dec {
+	median := pb.WeightedMedianWithAssertion()
+
+	var deviations ExchangeRateBallot
+	for _, v := range pb {
+		if v.Power > 0 {
+			deviation := v
+			deviation.ExchangeRate = v.ExchangeRate.Sub(median).Abs()
+			deviations = append(deviations, deviation)
+		}
+	}
+
+	sort.Sort(deviations)
+	maxDeviation := deviations.WeightedMedianWithAssertion().Mul(a.Threshold)
+
+	sum := sdk.ZeroDec()
+	weight := int64(0)
+	for _, v := range pb {
+		if v.Power > 0 && v.ExchangeRate.Sub(median).Abs().LTE(maxDeviation) {
+			sum = sum.Add(v.ExchangeRate.MulInt64(v.Power))
+			weight += v.Power
+		}
+	}
+
+	if weight == 0 {
+		return median
+	}
+
+	return sum.QuoInt64(weight)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+)
+
+func TestAggregators(t *testing.T) {
+	// an abstain vote and an outlier
+	pb := ExchangeRateBallot{}
+	for i, rate := range []int64{0, 1, 2, 3, 4, 100} {
+		power := int64(1)
+		if i == 0 {
+			power = 0
+		}
+
+		valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+		pb = append(pb, NewVoteForTally(sdk.NewDec(rate), core.MicroMNTDenom, valAddr, power))
+	}
+
+	for _, tc := range []struct {
+		name     string
+		expected sdk.Dec
+	}{
+		{"", sdk.NewDec(2)},
+		{AggregatorWeightedMedian, sdk.NewDec(2)},
+		// 2, 3 and 4 weighted 0.75, 1 and 0.75
+		{AggregatorTrimmedMean, sdk.NewDec(3)},
+		// the median absolute deviation is 1, rejecting 100
+		{AggregatorMedianAbsoluteDeviation, sdk.NewDecWithPrec(25, 1)},
+	} {
+		aggregator, err := GetAggregator(tc.name)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, aggregator.Aggregate(pb), tc.name)
+	}
+
+	_, err := GetAggregator("mode")
+	require.Error(t, err)
+
+	// unsorted ballot
+	pb[0], pb[5] = pb[5], pb[0]
+	require.Panics(t, func() { TrimmedMeanAggregator{Fraction: TrimmedMeanFraction}.Aggregate(pb) })
+	require.Panics(t, func() { MADAggregator{Threshold: MADOutlierThreshold}.Aggregate(pb) })
+}
+
+func TestAggregatorsEmptyBallot(t *testing.T) {
+	for _, name := range []string{AggregatorWeightedMedian, AggregatorTrimmedMean, AggregatorMedianAbsoluteDeviation} {
+		aggregator, err := GetAggregator(name)
+		require.NoError(t, err)
+		require.Equal(t, sdk.ZeroDec(), aggregator.Aggregate(ExchangeRateBallot{}), name)
+	}
+}
+
+func TestDenomListAggregator(t *testing.T) {
+	whitelist := DenomList{
+		{Name: core.MicroKRWDenom, TobinTax: DefaultTobinTax},
+		{Name: core.MicroMNTDenom, TobinTax: DefaultTobinTax, Aggregator: AggregatorTrimmedMean},
+	}
+
+	require.Equal(t, WeightedMedianAggregator{}, whitelist.Aggregator(core.MicroKRWDenom))
+	require.Equal(t, TrimmedMeanAggregator{Fraction: TrimmedMeanFraction}, whitelist.Aggregator(core.MicroMNTDenom))
+	require.Equal(t, WeightedMedianAggregator{}, whitelist.Aggregator(core.MicroUSDDenom))
+}
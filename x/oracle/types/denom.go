@@ -14,7 +14,7 @@ func (d Denom) String() string {
 
 // Equal implements equal interface
 func (d Denom) Equal(d1 *Denom) bool {
-	return d.Name == d1.Name && d.TobinTax.Equal(d1.TobinTax)
+	return d.Name == d1.Name && d.TobinTax.Equal(d1.TobinTax) && d.Aggregator == d1.Aggregator
 }
 
 // DenomList is array of Denom
@@ -27,3 +27,17 @@ func (dl DenomList) String() (out string) {
 	}
 	return strings.TrimSpace(out)
 }
+
+// Aggregator returns the aggregator of the denom, the weighted median
+// when the denom is not in the list
+func (dl DenomList) Aggregator(denom string) Aggregator {
+	for _, d := range dl {
+		if d.Name == denom {
+			if aggregator, err := GetAggregator(d.Aggregator); err == nil {
+				return aggregator
+			}
+		}
+	}
+
+	return WeightedMedianAggregator{}
+}
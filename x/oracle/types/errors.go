@@ -23,4 +23,7 @@ var (
 	ErrNoAggregateVote       = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
 	ErrNoTobinTax            = sdkerrors.Register(ModuleName, 13, "no tobin tax")
 	ErrUnknownDenom          = sdkerrors.Register(ModuleName, 14, "unknown denom")
+	ErrNoExchangeRateHistory = sdkerrors.Register(ModuleName, 15, "no exchange rate history")
+	ErrNoPrice               = sdkerrors.Register(ModuleName, 16, "no price")
+	ErrStaleExchangeRate     = sdkerrors.Register(ModuleName, 17, "stale exchange rate")
 )
@@ -8,13 +8,37 @@ const (
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeBallot             = "ballot"
+	EventTypeStanding           = "standing"
+	EventTypePriceUpdate        = "price_update"
+	EventTypeExchangeRateReject = "exchange_rate_reject"
 
-	AttributeKeyDenom         = "denom"
-	AttributeKeyVoter         = "voter"
-	AttributeKeyExchangeRate  = "exchange_rate"
-	AttributeKeyExchangeRates = "exchange_rates"
-	AttributeKeyOperator      = "operator"
-	AttributeKeyFeeder        = "feeder"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyVoter          = "voter"
+	AttributeKeyExchangeRate   = "exchange_rate"
+	AttributeKeyExchangeRates  = "exchange_rates"
+	AttributeKeyOperator       = "operator"
+	AttributeKeyFeeder         = "feeder"
+	AttributeKeyReferenceDenom = "reference_denom"
+	AttributeKeyBallotPower    = "ballot_power"
+	AttributeKeyThresholdPower = "threshold_power"
+	AttributeKeyPassed         = "passed"
+	AttributeKeyWeightedMedian = "weighted_median"
+	AttributeKeyRewardSpread   = "reward_spread"
+	AttributeKeyWinners        = "winners"
+	AttributeKeyMissed         = "missed"
+	AttributeKeyAbstained      = "abstained"
+	AttributeKeyStatus         = "status"
+	AttributeKeyMissCounter    = "miss_counter"
+	AttributeKeyValidVoteRate  = "valid_vote_rate"
+	AttributeKeySlashFraction  = "slash_fraction"
+	AttributeKeyWindowEnd      = "window_end_height"
+	AttributeKeyQuote          = "quote"
+	AttributeKeyPrice          = "price"
+	AttributeKeyLastMedian     = "last_median"
+	AttributeKeyReason         = "reason"
+	AttributeKeyRejected       = "rejected"
+	AttributeKeyAppliedRate    = "applied_exchange_rate"
 
 	AttributeValueCategory = ModuleName
 )
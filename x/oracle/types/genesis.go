@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -13,6 +14,10 @@ func NewGenesisState(
 	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
 	TobinTaxes []TobinTax,
+	badWindowCounters []BadWindowCounter,
+	prices []FeedPrice,
+	exchangeRateStatuses []ExchangeRateStatus,
+	exchangeRateHistories []ExchangeRateHistory,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -23,6 +28,10 @@ func NewGenesisState(
 		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
 		TobinTaxes:                    TobinTaxes,
+		BadWindowCounters:             badWindowCounters,
+		Prices:                        prices,
+		ExchangeRateStatuses:          exchangeRateStatuses,
+		ExchangeRateHistories:         exchangeRateHistories,
 	}
 }
 
@@ -36,11 +45,21 @@ func DefaultGenesisState() *GenesisState {
 		AggregateExchangeRatePrevotes: []AggregateExchangeRatePrevote{},
 		AggregateExchangeRateVotes:    []AggregateExchangeRateVote{},
 		TobinTaxes:                    []TobinTax{},
+		BadWindowCounters:             []BadWindowCounter{},
+		Prices:                        []FeedPrice{},
+		ExchangeRateStatuses:          []ExchangeRateStatus{},
+		ExchangeRateHistories:         []ExchangeRateHistory{},
 	}
 }
 
 // ValidateGenesis validates the oracle genesis state
 func ValidateGenesis(data *GenesisState) error {
+	for _, history := range data.ExchangeRateHistories {
+		if uint64(len(history.ExchangeRates)) > history.Count {
+			return fmt.Errorf("exchange rate history of %s keeps %d rates of %d recorded", history.Denom, len(history.ExchangeRates), history.Count)
+		}
+	}
+
 	return data.Params.Validate()
 }
 
@@ -34,6 +34,10 @@ type GenesisState struct {
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	TobinTaxes                    []TobinTax                     `protobuf:"bytes,7,rep,name=tobin_taxes,json=tobinTaxes,proto3" json:"tobin_taxes"`
+	BadWindowCounters             []BadWindowCounter             `protobuf:"bytes,8,rep,name=bad_window_counters,json=badWindowCounters,proto3" json:"bad_window_counters"`
+	Prices                        []FeedPrice                    `protobuf:"bytes,9,rep,name=prices,proto3" json:"prices"`
+	ExchangeRateStatuses          []ExchangeRateStatus           `protobuf:"bytes,10,rep,name=exchange_rate_statuses,json=exchangeRateStatuses,proto3" json:"exchange_rate_statuses"`
+	ExchangeRateHistories         []ExchangeRateHistory          `protobuf:"bytes,11,rep,name=exchange_rate_histories,json=exchangeRateHistories,proto3" json:"exchange_rate_histories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -118,6 +122,34 @@ func (m *GenesisState) GetTobinTaxes() []TobinTax {
 	return nil
 }
 
+func (m *GenesisState) GetBadWindowCounters() []BadWindowCounter {
+	if m != nil {
+		return m.BadWindowCounters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrices() []FeedPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeRateStatuses() []ExchangeRateStatus {
+	if m != nil {
+		return m.ExchangeRateStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeRateHistories() []ExchangeRateHistory {
+	if m != nil {
+		return m.ExchangeRateHistories
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -227,6 +259,60 @@ func (m *MissCounter) GetMissCounter() uint64 {
 	return 0
 }
 
+// BadWindowCounter defines the number of consecutive slash windows a validator
+// was slashed in, used in oracle module's genesis state
+type BadWindowCounter struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	BadWindowCounter uint64 `protobuf:"varint,2,opt,name=bad_window_counter,json=badWindowCounter,proto3" json:"bad_window_counter,omitempty"`
+}
+
+func (m *BadWindowCounter) Reset()         { *m = BadWindowCounter{} }
+func (m *BadWindowCounter) String() string { return proto.CompactTextString(m) }
+func (*BadWindowCounter) ProtoMessage()    {}
+func (*BadWindowCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ff46fd82c752f1f, []int{3}
+}
+func (m *BadWindowCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadWindowCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadWindowCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadWindowCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadWindowCounter.Merge(m, src)
+}
+func (m *BadWindowCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadWindowCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadWindowCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadWindowCounter proto.InternalMessageInfo
+
+func (m *BadWindowCounter) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *BadWindowCounter) GetBadWindowCounter() uint64 {
+	if m != nil {
+		return m.BadWindowCounter
+	}
+	return 0
+}
+
 // TobinTax defines an denom and tobin_tax pair used in
 // oracle module's genesis state
 type TobinTax struct {
@@ -238,7 +324,7 @@ func (m *TobinTax) Reset()         { *m = TobinTax{} }
 func (m *TobinTax) String() string { return proto.CompactTextString(m) }
 func (*TobinTax) ProtoMessage()    {}
 func (*TobinTax) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ff46fd82c752f1f, []int{3}
+	return fileDescriptor_7ff46fd82c752f1f, []int{4}
 }
 func (m *TobinTax) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,11 +360,75 @@ func (m *TobinTax) GetDenom() string {
 	return ""
 }
 
+// ExchangeRateHistory defines the number of exchange rates ever recorded for a denom
+// and the rates kept of them, oldest first, used in oracle module's genesis state
+type ExchangeRateHistory struct {
+	Denom         string                   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Count         uint64                   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	ExchangeRates []HistoricalExchangeRate `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates"`
+}
+
+func (m *ExchangeRateHistory) Reset()         { *m = ExchangeRateHistory{} }
+func (m *ExchangeRateHistory) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateHistory) ProtoMessage()    {}
+func (*ExchangeRateHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ff46fd82c752f1f, []int{5}
+}
+func (m *ExchangeRateHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateHistory.Merge(m, src)
+}
+func (m *ExchangeRateHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateHistory proto.InternalMessageInfo
+
+func (m *ExchangeRateHistory) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ExchangeRateHistory) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ExchangeRateHistory) GetExchangeRates() []HistoricalExchangeRate {
+	if m != nil {
+		return m.ExchangeRates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "terra.oracle.v1beta1.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "terra.oracle.v1beta1.FeederDelegation")
 	proto.RegisterType((*MissCounter)(nil), "terra.oracle.v1beta1.MissCounter")
+	proto.RegisterType((*BadWindowCounter)(nil), "terra.oracle.v1beta1.BadWindowCounter")
 	proto.RegisterType((*TobinTax)(nil), "terra.oracle.v1beta1.TobinTax")
+	proto.RegisterType((*ExchangeRateHistory)(nil), "terra.oracle.v1beta1.ExchangeRateHistory")
 }
 
 func init() {
@@ -286,43 +436,53 @@ func init() {
 }
 
 var fileDescriptor_7ff46fd82c752f1f = []byte{
-	// 568 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0xfe, 0xa3, 0xdd, 0xb4, 0x55, 0xbb, 0xea, 0x21, 0x8a, 0xa8, 0x9b, 0x44, 0xa2,
-	0x54, 0xa0, 0xda, 0x6a, 0xb8, 0x71, 0x6b, 0x48, 0xe1, 0x00, 0x48, 0x95, 0x89, 0x38, 0x80, 0x90,
-	0xb5, 0xb1, 0x27, 0xae, 0x21, 0xf6, 0x46, 0x3b, 0x9b, 0x28, 0x3d, 0xf2, 0x06, 0x3c, 0x05, 0x07,
-	0x9e, 0xa4, 0xc7, 0x1e, 0x11, 0x87, 0x82, 0x92, 0x17, 0x41, 0xde, 0xdd, 0x24, 0xa6, 0xb8, 0x48,
-	0x9c, 0x92, 0x9d, 0xfd, 0xcd, 0xf7, 0xcd, 0xec, 0x8e, 0x97, 0x34, 0x24, 0x08, 0xc1, 0x5c, 0x2e,
-	0x58, 0xd0, 0x07, 0x77, 0x74, 0xd2, 0x05, 0xc9, 0x4e, 0xdc, 0x08, 0x52, 0xc0, 0x18, 0x9d, 0x81,
-	0xe0, 0x92, 0xd3, 0x3d, 0xc5, 0x38, 0x9a, 0x71, 0x0c, 0x53, 0xdd, 0x8b, 0x78, 0xc4, 0x15, 0xe0,
-	0x66, 0xff, 0x34, 0x5b, 0xad, 0x17, 0xea, 0x99, 0x54, 0x8d, 0xd8, 0x01, 0xc7, 0x84, 0xa3, 0xdb,
-	0x65, 0xb8, 0x20, 0x02, 0x1e, 0xa7, 0x7a, 0xbf, 0xf1, 0x75, 0x95, 0x6c, 0xbe, 0xd0, 0x05, 0xbc,
-	0x91, 0x4c, 0x02, 0x7d, 0x4a, 0xd6, 0x06, 0x4c, 0xb0, 0x04, 0x2b, 0x56, 0xcd, 0x3a, 0x2a, 0x37,
-	0xef, 0x3b, 0x45, 0x05, 0x39, 0xe7, 0x8a, 0x69, 0xad, 0x5c, 0xdd, 0x1c, 0x94, 0x3c, 0x93, 0x41,
-	0xdf, 0x13, 0xda, 0x03, 0x08, 0x41, 0xf8, 0x21, 0xf4, 0x21, 0x62, 0x32, 0xe6, 0x29, 0x56, 0x96,
-	0x6a, 0xcb, 0x47, 0xe5, 0xe6, 0x61, 0xb1, 0xce, 0x73, 0xc5, 0xb7, 0xe7, 0xb8, 0x51, 0xdc, 0xed,
-	0xdd, 0x8a, 0x23, 0xfd, 0x48, 0xb6, 0x61, 0x1c, 0x5c, 0xb0, 0x34, 0x02, 0x5f, 0x30, 0x09, 0x58,
-	0x59, 0x56, 0xc2, 0x0f, 0x8b, 0x85, 0xcf, 0x0c, 0xeb, 0x31, 0x09, 0x9d, 0xe1, 0xa0, 0x0f, 0xad,
-	0x6a, 0xa6, 0xfc, 0xed, 0xe7, 0x01, 0xfd, 0x6b, 0x0b, 0xbd, 0x2d, 0xc8, 0xc5, 0x90, 0xbe, 0x22,
-	0x5b, 0x49, 0x8c, 0xe8, 0x07, 0x7c, 0x98, 0x4a, 0x10, 0x58, 0x59, 0x51, 0x56, 0xf5, 0x62, 0xab,
-	0xd7, 0x31, 0xe2, 0x33, 0x4d, 0x9a, 0xf2, 0x37, 0x93, 0x45, 0x08, 0xe9, 0x67, 0x8b, 0xd4, 0x58,
-	0x14, 0x89, 0xac, 0x15, 0xf0, 0xff, 0x68, 0xc2, 0x1f, 0x08, 0x18, 0xf1, 0xac, 0x99, 0x55, 0xe5,
-	0xd0, 0x2c, 0x76, 0x38, 0x9d, 0x65, 0xe7, 0x4b, 0x3f, 0xd7, 0xa9, 0xc6, 0x72, 0x9f, 0xfd, 0x83,
-	0x41, 0x3a, 0x26, 0xfb, 0x77, 0x95, 0xa0, 0xfd, 0xd7, 0x94, 0xbf, 0xfb, 0x1f, 0xfe, 0x6f, 0x17,
-	0xe6, 0x55, 0x76, 0x17, 0x80, 0xf4, 0x8c, 0x94, 0x25, 0xef, 0xc6, 0xa9, 0x2f, 0xd9, 0x18, 0xb0,
-	0x72, 0x4f, 0xf9, 0xd8, 0xc5, 0x3e, 0x9d, 0x0c, 0xec, 0xb0, 0xb1, 0x91, 0x25, 0xd2, 0xac, 0x01,
-	0x1b, 0x3d, 0xb2, 0x73, 0x7b, 0x56, 0xe8, 0x03, 0xb2, 0x6d, 0xe6, 0x8d, 0x85, 0xa1, 0x00, 0xd4,
-	0x33, 0xbb, 0xe1, 0x6d, 0xe9, 0xe8, 0xa9, 0x0e, 0xd2, 0xc7, 0x64, 0x77, 0xc4, 0xfa, 0x71, 0xc8,
-	0x24, 0x5f, 0x90, 0x4b, 0x8a, 0xdc, 0x99, 0x6f, 0x18, 0xb8, 0xf1, 0x81, 0x94, 0x73, 0xf7, 0x59,
-	0x9c, 0x6b, 0x15, 0xe7, 0xd2, 0x3a, 0xd9, 0xcc, 0x8f, 0x8d, 0xf2, 0x58, 0xf1, 0xca, 0xb9, 0x61,
-	0x68, 0x24, 0x64, 0x7d, 0xd6, 0x24, 0xdd, 0x23, 0xab, 0x21, 0xa4, 0x3c, 0x31, 0x7a, 0x7a, 0x41,
-	0x5f, 0x92, 0x8d, 0xf9, 0x79, 0xe9, 0x2a, 0x5b, 0x4e, 0x76, 0x1a, 0x3f, 0x6e, 0x0e, 0x0e, 0xa3,
-	0x58, 0x5e, 0x0c, 0xbb, 0x4e, 0xc0, 0x13, 0xd7, 0x7c, 0xd7, 0xfa, 0xe7, 0x18, 0xc3, 0x4f, 0xae,
-	0xbc, 0x1c, 0x00, 0x3a, 0x6d, 0x08, 0xbc, 0xf5, 0xd9, 0xb9, 0xb5, 0xda, 0x57, 0x13, 0xdb, 0xba,
-	0x9e, 0xd8, 0xd6, 0xaf, 0x89, 0x6d, 0x7d, 0x99, 0xda, 0xa5, 0xeb, 0xa9, 0x5d, 0xfa, 0x3e, 0xb5,
-	0x4b, 0xef, 0x1e, 0xe5, 0xb4, 0xd4, 0x5d, 0x1c, 0x27, 0x3c, 0x85, 0x4b, 0x37, 0xe0, 0x02, 0xdc,
-	0xf1, 0xec, 0x4d, 0x51, 0x9a, 0xdd, 0x35, 0xf5, 0x56, 0x3c, 0xf9, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0xbd, 0x00, 0x67, 0x7c, 0xc0, 0x04, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc7, 0x63, 0x3e, 0x72, 0xc3, 0x04, 0x50, 0x18, 0x72, 0xef, 0xb5, 0xa2, 0x8b, 0x03, 0x91,
+	0x2e, 0xa5, 0x2d, 0xd8, 0x82, 0xee, 0x2a, 0x75, 0x41, 0x0a, 0x6d, 0xa5, 0xb6, 0x12, 0x0a, 0xa8,
+	0x55, 0xbf, 0x14, 0x4d, 0xec, 0x83, 0x71, 0x1b, 0x7b, 0x22, 0x9f, 0x09, 0x84, 0x65, 0xdf, 0xa0,
+	0xea, 0xba, 0x4f, 0xd0, 0x27, 0x61, 0xc9, 0xb2, 0xea, 0x82, 0x56, 0xf0, 0x22, 0x55, 0x66, 0x26,
+	0xdf, 0x0e, 0x88, 0x55, 0xe2, 0x33, 0xff, 0x73, 0x7e, 0x67, 0x3c, 0xff, 0x33, 0x26, 0x25, 0x01,
+	0x71, 0xcc, 0x1c, 0x1e, 0x33, 0xb7, 0x0e, 0xce, 0xf1, 0x66, 0x0d, 0x04, 0xdb, 0x74, 0x7c, 0x88,
+	0x00, 0x03, 0xb4, 0x1b, 0x31, 0x17, 0x9c, 0xe6, 0xa5, 0xc6, 0x56, 0x1a, 0x5b, 0x6b, 0x0a, 0x79,
+	0x9f, 0xfb, 0x5c, 0x0a, 0x9c, 0xf6, 0x3f, 0xa5, 0x2d, 0xac, 0x24, 0xd6, 0xd3, 0xa9, 0x4a, 0x62,
+	0xb9, 0x1c, 0x43, 0x8e, 0x4e, 0x8d, 0x61, 0x4f, 0xe1, 0xf2, 0x20, 0x52, 0xeb, 0xa5, 0xaf, 0x19,
+	0x32, 0xfb, 0x54, 0x35, 0xb0, 0x2f, 0x98, 0x00, 0xfa, 0x90, 0xa4, 0x1b, 0x2c, 0x66, 0x21, 0x9a,
+	0xc6, 0xb2, 0xb1, 0x96, 0xdd, 0xfa, 0xcf, 0x4e, 0x6a, 0xc8, 0xde, 0x93, 0x9a, 0xf2, 0xd4, 0xd9,
+	0x45, 0x31, 0x55, 0xd1, 0x19, 0xf4, 0x1d, 0xa1, 0x87, 0x00, 0x1e, 0xc4, 0x55, 0x0f, 0xea, 0xe0,
+	0x33, 0x11, 0xf0, 0x08, 0xcd, 0x89, 0xe5, 0xc9, 0xb5, 0xec, 0xd6, 0x6a, 0x72, 0x9d, 0x27, 0x52,
+	0xbf, 0xd3, 0x95, 0xeb, 0x8a, 0x0b, 0x87, 0x43, 0x71, 0xa4, 0x1f, 0xc9, 0x3c, 0xb4, 0xdc, 0x23,
+	0x16, 0xf9, 0x50, 0x8d, 0x99, 0x00, 0x34, 0x27, 0x65, 0xe1, 0x3b, 0xc9, 0x85, 0x77, 0xb5, 0xb6,
+	0xc2, 0x04, 0x1c, 0x34, 0x1b, 0x75, 0x28, 0x17, 0xda, 0x95, 0xbf, 0xff, 0x2a, 0xd2, 0x91, 0x25,
+	0xac, 0xcc, 0x41, 0x5f, 0x0c, 0xe9, 0x0b, 0x32, 0x17, 0x06, 0x88, 0x55, 0x97, 0x37, 0x23, 0x01,
+	0x31, 0x9a, 0x53, 0x12, 0xb5, 0x92, 0x8c, 0x7a, 0x19, 0x20, 0x3e, 0x56, 0x4a, 0xdd, 0xfe, 0x6c,
+	0xd8, 0x0b, 0x21, 0xfd, 0x6c, 0x90, 0x65, 0xe6, 0xfb, 0x71, 0x7b, 0x2b, 0x50, 0x1d, 0xd8, 0x44,
+	0xb5, 0x11, 0xc3, 0x31, 0x6f, 0x6f, 0x66, 0x5a, 0x12, 0xb6, 0x92, 0x09, 0xdb, 0x9d, 0xec, 0xfe,
+	0xd6, 0xf7, 0x54, 0xaa, 0x46, 0x2e, 0xb1, 0x6b, 0x34, 0x48, 0x5b, 0x64, 0x69, 0x5c, 0x0b, 0x8a,
+	0x9f, 0x96, 0x7c, 0xe7, 0x16, 0xfc, 0x57, 0x3d, 0x78, 0x81, 0x8d, 0x13, 0x20, 0xdd, 0x25, 0x59,
+	0xc1, 0x6b, 0x41, 0x54, 0x15, 0xac, 0x05, 0x68, 0xfe, 0x25, 0x39, 0x56, 0x32, 0xe7, 0xa0, 0x2d,
+	0x3c, 0x60, 0x2d, 0x5d, 0x96, 0x08, 0xfd, 0x0c, 0x48, 0xdf, 0x93, 0xc5, 0x1a, 0xf3, 0xaa, 0x27,
+	0x41, 0xe4, 0xf1, 0x93, 0xde, 0xc1, 0x64, 0xae, 0x33, 0x57, 0x99, 0x79, 0xaf, 0xa5, 0x7e, 0xf0,
+	0x74, 0x16, 0x6a, 0x43, 0x71, 0xa4, 0x8f, 0x48, 0xba, 0x11, 0x07, 0x2e, 0xa0, 0x39, 0x23, 0x0b,
+	0x16, 0xc7, 0xbb, 0x75, 0xaf, 0xad, 0xeb, 0x1a, 0x5f, 0x26, 0x51, 0x8f, 0xfc, 0x33, 0xf8, 0x4e,
+	0x51, 0x30, 0xd1, 0x44, 0x40, 0x93, 0xc8, 0x72, 0x6b, 0x37, 0x7b, 0x74, 0x5f, 0x66, 0xe8, 0xba,
+	0x79, 0x18, 0x59, 0x01, 0xa4, 0x3e, 0xf9, 0x77, 0x90, 0x72, 0x14, 0xa0, 0xe0, 0x71, 0x00, 0x68,
+	0x66, 0x25, 0xe6, 0xee, 0xcd, 0x98, 0x67, 0x32, 0xe5, 0x54, 0x73, 0xfe, 0x86, 0x91, 0xa5, 0x00,
+	0xb0, 0x74, 0x48, 0x72, 0xc3, 0x73, 0x49, 0xff, 0x27, 0xf3, 0x7a, 0xb6, 0x99, 0xe7, 0xc5, 0x80,
+	0xea, 0x7e, 0x98, 0xa9, 0xcc, 0xa9, 0xe8, 0xb6, 0x0a, 0xd2, 0xfb, 0x64, 0xe1, 0x98, 0xd5, 0x03,
+	0x8f, 0x09, 0xde, 0x53, 0x4e, 0x48, 0x65, 0xae, 0xbb, 0xa0, 0xc5, 0xa5, 0x0f, 0x24, 0xdb, 0x37,
+	0x3b, 0xc9, 0xb9, 0x46, 0x72, 0x2e, 0x5d, 0x21, 0xb3, 0xfd, 0x23, 0x2a, 0x19, 0x53, 0x95, 0x6c,
+	0xdf, 0xe0, 0x95, 0x42, 0x92, 0x1b, 0x76, 0xc0, 0xed, 0x18, 0xeb, 0x84, 0x8e, 0x7a, 0x4e, 0x93,
+	0x72, 0xc3, 0x26, 0x2a, 0x85, 0x24, 0xd3, 0xf1, 0x2f, 0xcd, 0x93, 0x69, 0x0f, 0x22, 0x1e, 0xea,
+	0xd2, 0xea, 0x81, 0x3e, 0x27, 0x33, 0xdd, 0x51, 0x50, 0x2f, 0xa5, 0x6c, 0xb7, 0xcf, 0xe1, 0xe7,
+	0x45, 0x71, 0xd5, 0x0f, 0xc4, 0x51, 0xb3, 0x66, 0xbb, 0x3c, 0x74, 0xf4, 0x95, 0xad, 0x7e, 0x36,
+	0xd0, 0xfb, 0xe4, 0x88, 0xd3, 0x06, 0xa0, 0xbd, 0x03, 0x6e, 0x25, 0xd3, 0x19, 0x89, 0xd2, 0x37,
+	0x83, 0x2c, 0x26, 0x9c, 0xec, 0x18, 0x74, 0x9e, 0x4c, 0xcb, 0xfe, 0x75, 0xf7, 0xea, 0x81, 0xbe,
+	0x19, 0x73, 0xa7, 0xae, 0x27, 0x1b, 0x49, 0x3b, 0xc4, 0x65, 0xf5, 0x7e, 0xb0, 0xf6, 0xd2, 0xe0,
+	0x15, 0x5a, 0xde, 0x39, 0xbb, 0xb4, 0x8c, 0xf3, 0x4b, 0xcb, 0xf8, 0x7d, 0x69, 0x19, 0x5f, 0xae,
+	0xac, 0xd4, 0xf9, 0x95, 0x95, 0xfa, 0x71, 0x65, 0xa5, 0xde, 0xde, 0xeb, 0xdb, 0xaa, 0xc4, 0x6c,
+	0x84, 0x3c, 0x82, 0x53, 0xc7, 0xe5, 0x31, 0x38, 0xad, 0xce, 0xd7, 0x4c, 0x6e, 0xb9, 0x96, 0x96,
+	0x5f, 0xa9, 0x07, 0x7f, 0x06, 0x00, 0x7a, 0xc1, 0xae, 0x1f, 0x3a, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -345,6 +505,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExchangeRateHistories) > 0 {
+		for iNdEx := len(m.ExchangeRateHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ExchangeRateStatuses) > 0 {
+		for iNdEx := len(m.ExchangeRateStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BadWindowCounters) > 0 {
+		for iNdEx := len(m.BadWindowCounters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadWindowCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.TobinTaxes) > 0 {
 		for iNdEx := len(m.TobinTaxes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -514,6 +730,41 @@ func (m *MissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BadWindowCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadWindowCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadWindowCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BadWindowCounter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BadWindowCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TobinTax) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -554,6 +805,55 @@ func (m *TobinTax) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExchangeRateHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -609,6 +909,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BadWindowCounters) > 0 {
+		for _, e := range m.BadWindowCounters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeRateStatuses) > 0 {
+		for _, e := range m.ExchangeRateStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeRateHistories) > 0 {
+		for _, e := range m.ExchangeRateHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -645,6 +969,22 @@ func (m *MissCounter) Size() (n int) {
 	return n
 }
 
+func (m *BadWindowCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BadWindowCounter != 0 {
+		n += 1 + sovGenesis(uint64(m.BadWindowCounter))
+	}
+	return n
+}
+
 func (m *TobinTax) Size() (n int) {
 	if m == nil {
 		return 0
@@ -660,6 +1000,28 @@ func (m *TobinTax) Size() (n int) {
 	return n
 }
 
+func (m *ExchangeRateHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovGenesis(uint64(m.Count))
+	}
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -932,7 +1294,143 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadWindowCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadWindowCounters = append(m.BadWindowCounters, BadWindowCounter{})
+			if err := m.BadWindowCounters[len(m.BadWindowCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, FeedPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateStatuses = append(m.ExchangeRateStatuses, ExchangeRateStatus{})
+			if err := m.ExchangeRateStatuses[len(m.ExchangeRateStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateHistories = append(m.ExchangeRateHistories, ExchangeRateHistory{})
+			if err := m.ExchangeRateHistories[len(m.ExchangeRateHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
 			if err != nil {
@@ -1168,6 +1666,107 @@ func (m *MissCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BadWindowCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadWindowCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadWindowCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadWindowCounter", wireType)
+			}
+			m.BadWindowCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BadWindowCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TobinTax) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1284,6 +1883,141 @@ func (m *TobinTax) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExchangeRateHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, HistoricalExchangeRate{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
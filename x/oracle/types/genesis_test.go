@@ -10,6 +10,10 @@ func TestGenesisValidation(t *testing.T) {
 	genState := DefaultGenesisState()
 	require.NoError(t, ValidateGenesis(genState))
 
+	genState.ExchangeRateHistories = []ExchangeRateHistory{{Denom: "denom", Count: 0, ExchangeRates: []HistoricalExchangeRate{{Height: 1}}}}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState = DefaultGenesisState()
 	genState.Params.VotePeriod = 0
 	require.Error(t, ValidateGenesis(genState))
 }
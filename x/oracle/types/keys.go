@@ -33,6 +33,18 @@ const (
 // - 0x05<valAddress_Bytes>: AggregateExchangeRateVote
 //
 // - 0x06<denom_Bytes>: sdk.Dec
+//
+// - 0x07<denom_Bytes>: uint64
+//
+// - 0x08<denom_Bytes><slot_Bytes>: HistoricalExchangeRate
+//
+// - 0x09<window_Bytes><valAddress_Bytes>: ValidatorPerformance
+//
+// - 0x0A: VotePeriodSummary
+//
+// - 0x0B<valAddress_Bytes>: uint64
+//
+// - 0x0C<name_Bytes>: FeedPrice
 var (
 	// Keys for store prefixes
 	ExchangeRateKey                 = []byte{0x01} // prefix for each key to a rate
@@ -41,6 +53,13 @@ var (
 	AggregateExchangeRatePrevoteKey = []byte{0x04} // prefix for each key to a aggregate prevote
 	AggregateExchangeRateVoteKey    = []byte{0x05} // prefix for each key to a aggregate vote
 	TobinTaxKey                     = []byte{0x06} // prefix for each key to a tobin tax
+	ExchangeRateHistoryCountKey     = []byte{0x07} // prefix for each key to the number of historical rates of a denom
+	HistoricalExchangeRateKey       = []byte{0x08} // prefix for each key to a historical rate
+	ValidatorPerformanceKey         = []byte{0x09} // prefix for each key to a validator performance
+	LastVotePeriodSummaryKey        = []byte{0x0A} // key for the summary of the last vote period
+	BadWindowCounterKey             = []byte{0x0B} // prefix for each key to a bad window counter
+	PriceKey                        = []byte{0x0C} // prefix for each key to a price of a price feed
+	ExchangeRateStatusKey           = []byte{0x0D} // prefix for each key to the status of a rate
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -48,6 +67,16 @@ func GetExchangeRateKey(denom string) []byte {
 	return append(ExchangeRateKey, []byte(denom)...)
 }
 
+// GetExchangeRateStatusKey - stored by *denom*
+func GetExchangeRateStatusKey(denom string) []byte {
+	return append(ExchangeRateStatusKey, []byte(denom)...)
+}
+
+// GetPriceKey - stored by price feed *name*
+func GetPriceKey(name string) []byte {
+	return append(PriceKey, []byte(name)...)
+}
+
 // GetFeederDelegationKey - stored by *Validator* address
 func GetFeederDelegationKey(v sdk.ValAddress) []byte {
 	return append(FeederDelegationKey, address.MustLengthPrefix(v)...)
@@ -58,6 +87,11 @@ func GetMissCounterKey(v sdk.ValAddress) []byte {
 	return append(MissCounterKey, address.MustLengthPrefix(v)...)
 }
 
+// GetBadWindowCounterKey - stored by *Validator* address
+func GetBadWindowCounterKey(v sdk.ValAddress) []byte {
+	return append(BadWindowCounterKey, address.MustLengthPrefix(v)...)
+}
+
 // GetAggregateExchangeRatePrevoteKey - stored by *Validator* address
 func GetAggregateExchangeRatePrevoteKey(v sdk.ValAddress) []byte {
 	return append(AggregateExchangeRatePrevoteKey, address.MustLengthPrefix(v)...)
@@ -73,6 +107,31 @@ func GetTobinTaxKey(d string) []byte {
 	return append(TobinTaxKey, []byte(d)...)
 }
 
+// GetExchangeRateHistoryCountKey - stored by *denom*
+func GetExchangeRateHistoryCountKey(denom string) []byte {
+	return append(ExchangeRateHistoryCountKey, []byte(denom)...)
+}
+
+// GetHistoricalExchangeRatePrefix - prefix of the historical rates of the *denom*
+func GetHistoricalExchangeRatePrefix(denom string) []byte {
+	return append(HistoricalExchangeRateKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetHistoricalExchangeRateKey - stored by *denom* and ring buffer *slot*
+func GetHistoricalExchangeRateKey(denom string, slot uint64) []byte {
+	return append(GetHistoricalExchangeRatePrefix(denom), sdk.Uint64ToBigEndian(slot)...)
+}
+
+// GetValidatorPerformancePrefix - prefix of the validator performances of the slash *window*
+func GetValidatorPerformancePrefix(window uint64) []byte {
+	return append(ValidatorPerformanceKey, sdk.Uint64ToBigEndian(window)...)
+}
+
+// GetValidatorPerformanceKey - stored by slash *window* and *Validator* address
+func GetValidatorPerformanceKey(window uint64, v sdk.ValAddress) []byte {
+	return append(GetValidatorPerformancePrefix(window), address.MustLengthPrefix(v)...)
+}
+
 // ExtractDenomFromTobinTaxKey - split denom from the tobin tax key
 func ExtractDenomFromTobinTaxKey(key []byte) (denom string) {
 	denom = string(key[1:])
@@ -35,6 +35,20 @@ type Params struct {
 	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow              uint64                                 `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	// warning_valid_per_window is the valid vote rate under which a validator is warned
+	WarningValidPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=warning_valid_per_window,json=warningValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"warning_valid_per_window" yaml:"warning_valid_per_window"`
+	// max_slash_fraction caps the slash fraction, which grows by slash_fraction
+	// with every consecutive slash window the validator is slashed in
+	MaxSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_slash_fraction,json=maxSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slash_fraction" yaml:"max_slash_fraction"`
+	// jail_after_windows is the number of consecutive slash windows the validator is
+	// slashed in after which it is also jailed, never jailed when zero
+	JailAfterWindows uint64 `protobuf:"varint,11,opt,name=jail_after_windows,json=jailAfterWindows,proto3" json:"jail_after_windows,omitempty" yaml:"jail_after_windows"`
+	// price_feeds are the assets priced by the validators besides the Terra denoms,
+	// each in its own quote asset
+	PriceFeeds PriceFeedList `protobuf:"bytes,12,rep,name=price_feeds,json=priceFeeds,proto3,castrepeated=PriceFeedList" json:"price_feeds" yaml:"price_feeds"`
+	// max_rate_change is the fraction by which the exchange rate of a denom may move from the
+	// previous one in a vote period, beyond which it is rejected; unlimited when zero
+	MaxRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_rate_change,json=maxRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate_change" yaml:"max_rate_change"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -97,10 +111,27 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetJailAfterWindows() uint64 {
+	if m != nil {
+		return m.JailAfterWindows
+	}
+	return 0
+}
+
+func (m *Params) GetPriceFeeds() PriceFeedList {
+	if m != nil {
+		return m.PriceFeeds
+	}
+	return nil
+}
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	Name     string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
 	TobinTax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tobin_tax,json=tobinTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tobin_tax" yaml:"tobin_tax"`
+	// aggregator is the tally aggregation of the ballots of the denom,
+	// the weighted median when empty
+	Aggregator string `protobuf:"bytes,3,opt,name=aggregator,proto3" json:"aggregator,omitempty" yaml:"aggregator"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -135,6 +166,52 @@ func (m *Denom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Denom proto.InternalMessageInfo
 
+// PriceFeed - the object to hold configurations of an asset priced by the validators
+// in a quote asset other than Luna, e.g. an IBC denom, a CW20 address or a symbol such as BTC
+type PriceFeed struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// quote is the asset the price is denominated in, e.g. USD or uusd
+	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty" yaml:"quote"`
+	// vote_threshold is the voting power a ballot of the feed needs to pass,
+	// the vote_threshold of the params when zero
+	VoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold" yaml:"vote_threshold"`
+	// aggregator is the tally aggregation of the ballots of the feed,
+	// the weighted median when empty
+	Aggregator string `protobuf:"bytes,4,opt,name=aggregator,proto3" json:"aggregator,omitempty" yaml:"aggregator"`
+}
+
+func (m *PriceFeed) Reset()      { *m = PriceFeed{} }
+func (*PriceFeed) ProtoMessage() {}
+func (*PriceFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{2}
+}
+func (m *PriceFeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceFeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceFeed.Merge(m, src)
+}
+func (m *PriceFeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceFeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceFeed proto.InternalMessageInfo
+
 // struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
 // which is formatted as hex string in SHA256("{salt}:{exchange rate}{denom},...,{exchange rate}{denom}:{voter}")
@@ -147,7 +224,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2a008582d55f197f, []int{2}
+	return fileDescriptor_2a008582d55f197f, []int{3}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,7 +263,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2a008582d55f197f, []int{3}
+	return fileDescriptor_2a008582d55f197f, []int{4}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +301,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2a008582d55f197f, []int{4}
+	return fileDescriptor_2a008582d55f197f, []int{5}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,66 +330,570 @@ func (m *ExchangeRateTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 
+// HistoricalExchangeRate - exchange rate of Luna denominated in a denom
+// set at the end of a vote period, kept in a bounded history
+type HistoricalExchangeRate struct {
+	Height       int64                                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+}
+
+func (m *HistoricalExchangeRate) Reset()         { *m = HistoricalExchangeRate{} }
+func (m *HistoricalExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*HistoricalExchangeRate) ProtoMessage()    {}
+func (*HistoricalExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{6}
+}
+func (m *HistoricalExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricalExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalExchangeRate.Merge(m, src)
+}
+func (m *HistoricalExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalExchangeRate proto.InternalMessageInfo
+
+// ExchangeRateHistoryCounter - number of exchange rates ever recorded for a denom
+// and the size of the ring buffer the last of them are kept in
+type ExchangeRateHistoryCounter struct {
+	Count       uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty" yaml:"count"`
+	HistorySize uint64 `protobuf:"varint,2,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty" yaml:"history_size"`
+}
+
+func (m *ExchangeRateHistoryCounter) Reset()         { *m = ExchangeRateHistoryCounter{} }
+func (m *ExchangeRateHistoryCounter) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateHistoryCounter) ProtoMessage()    {}
+func (*ExchangeRateHistoryCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{7}
+}
+func (m *ExchangeRateHistoryCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateHistoryCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateHistoryCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateHistoryCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateHistoryCounter.Merge(m, src)
+}
+func (m *ExchangeRateHistoryCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateHistoryCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateHistoryCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateHistoryCounter proto.InternalMessageInfo
+
+// ValidatorPerformance - oracle voting stats of a validator over a slash window,
+// kept for the current and the previous slash window
+type ValidatorPerformance struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	// window is the index of the slash window, starting at window * slash_window
+	Window      uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty" yaml:"window"`
+	StartHeight int64  `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// vote_periods is the number of vote periods tallied while the validator was bonded
+	VotePeriods uint64 `protobuf:"varint,4,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty" yaml:"vote_periods"`
+	// votes is the number of vote periods the validator submitted a vote in
+	Votes uint64 `protobuf:"varint,5,opt,name=votes,proto3" json:"votes,omitempty" yaml:"votes"`
+	// abstains is the number of abstaining exchange rates submitted
+	Abstains uint64 `protobuf:"varint,6,opt,name=abstains,proto3" json:"abstains,omitempty" yaml:"abstains"`
+	// misses is the number of vote periods counted as missed for slashing
+	Misses uint64             `protobuf:"varint,7,opt,name=misses,proto3" json:"misses,omitempty" yaml:"misses"`
+	Denoms []DenomPerformance `protobuf:"bytes,8,rep,name=denoms,proto3" json:"denoms" yaml:"denoms"`
+}
+
+func (m *ValidatorPerformance) Reset()      { *m = ValidatorPerformance{} }
+func (*ValidatorPerformance) ProtoMessage() {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{8}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+// DenomPerformance - oracle voting stats of a validator for a denom over a slash window
+type DenomPerformance struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// votes is the number of exchange rates tallied, abstains excluded
+	Votes uint64 `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty" yaml:"votes"`
+	// wins is the number of exchange rates within the reward band of the weighted median
+	Wins uint64 `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty" yaml:"wins"`
+	// total_deviation is the sum of the relative deviations from the weighted median
+	TotalDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=total_deviation,json=totalDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_deviation" yaml:"total_deviation"`
+	// average_deviation is the average relative deviation from the weighted median
+	AverageDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=average_deviation,json=averageDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_deviation" yaml:"average_deviation"`
+}
+
+func (m *DenomPerformance) Reset()         { *m = DenomPerformance{} }
+func (m *DenomPerformance) String() string { return proto.CompactTextString(m) }
+func (*DenomPerformance) ProtoMessage()    {}
+func (*DenomPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{9}
+}
+func (m *DenomPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPerformance.Merge(m, src)
+}
+func (m *DenomPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPerformance proto.InternalMessageInfo
+
+// VotePeriodSummary - outcome of the ballots tallied at the end of a vote period
+type VotePeriodSummary struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// reference_denom is the denom the cross exchange rates are computed from,
+	// empty when no ballot passed
+	ReferenceDenom string `protobuf:"bytes,2,opt,name=reference_denom,json=referenceDenom,proto3" json:"reference_denom,omitempty" yaml:"reference_denom"`
+	// ballots of the vote targets, sorted by denom
+	Ballots []BallotSummary `protobuf:"bytes,3,rep,name=ballots,proto3" json:"ballots" yaml:"ballots"`
+}
+
+func (m *VotePeriodSummary) Reset()         { *m = VotePeriodSummary{} }
+func (m *VotePeriodSummary) String() string { return proto.CompactTextString(m) }
+func (*VotePeriodSummary) ProtoMessage()    {}
+func (*VotePeriodSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{10}
+}
+func (m *VotePeriodSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotePeriodSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotePeriodSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotePeriodSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotePeriodSummary.Merge(m, src)
+}
+func (m *VotePeriodSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotePeriodSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotePeriodSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotePeriodSummary proto.InternalMessageInfo
+
+// BallotSummary - outcome of the ballot of a denom
+type BallotSummary struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// ballot_power is the voting power of the non-abstain votes
+	BallotPower int64 `protobuf:"varint,2,opt,name=ballot_power,json=ballotPower,proto3" json:"ballot_power,omitempty" yaml:"ballot_power"`
+	// threshold_power is the voting power a ballot needs to pass
+	ThresholdPower int64 `protobuf:"varint,3,opt,name=threshold_power,json=thresholdPower,proto3" json:"threshold_power,omitempty" yaml:"threshold_power"`
+	Passed         bool  `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty" yaml:"passed"`
+	// weighted_median is the tallied rate, a cross rate of the reference denom for the other denoms
+	WeightedMedian github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=weighted_median,json=weightedMedian,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weighted_median" yaml:"weighted_median"`
+	// reward_spread is the distance from the weighted median within which votes win
+	RewardSpread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reward_spread,json=rewardSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_spread" yaml:"reward_spread"`
+	// exchange_rate is the tallied exchange rate of Luna for the denom
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	// winners, missed and abstained are the validator addresses, sorted
+	Winners   []string `protobuf:"bytes,8,rep,name=winners,proto3" json:"winners,omitempty" yaml:"winners"`
+	Missed    []string `protobuf:"bytes,9,rep,name=missed,proto3" json:"missed,omitempty" yaml:"missed"`
+	Abstained []string `protobuf:"bytes,10,rep,name=abstained,proto3" json:"abstained,omitempty" yaml:"abstained"`
+	// rejected is whether the exchange rate of the passed ballot moved by more
+	// than the max rate change from the last median, keeping the last accepted one
+	Rejected bool `protobuf:"varint,11,opt,name=rejected,proto3" json:"rejected,omitempty" yaml:"rejected"`
+	// applied_exchange_rate is the exchange rate of Luna set for the denom, the
+	// last accepted one when rejected, zero when none is set
+	AppliedExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=applied_exchange_rate,json=appliedExchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"applied_exchange_rate" yaml:"applied_exchange_rate"`
+}
+
+func (m *BallotSummary) Reset()         { *m = BallotSummary{} }
+func (m *BallotSummary) String() string { return proto.CompactTextString(m) }
+func (*BallotSummary) ProtoMessage()    {}
+func (*BallotSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{11}
+}
+func (m *BallotSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BallotSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BallotSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BallotSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BallotSummary.Merge(m, src)
+}
+func (m *BallotSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *BallotSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_BallotSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BallotSummary proto.InternalMessageInfo
+
+// ValidatorStanding - penalty a validator faces at the end of the current slash window
+// if it misses no more vote period
+type ValidatorStanding struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	// status is one of good, warning, slash or jail
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty" yaml:"status"`
+	// miss_counter is the number of vote periods missed in the current slash window
+	MissCounter          uint64 `protobuf:"varint,3,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty" yaml:"miss_counter"`
+	VotePeriodsPerWindow uint64 `protobuf:"varint,4,opt,name=vote_periods_per_window,json=votePeriodsPerWindow,proto3" json:"vote_periods_per_window,omitempty" yaml:"vote_periods_per_window"`
+	// valid_vote_rate is the rate of valid votes at the end of the slash window
+	ValidVoteRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=valid_vote_rate,json=validVoteRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_vote_rate" yaml:"valid_vote_rate"`
+	// bad_windows is the number of consecutive slash windows the validator was slashed in
+	// before the current one
+	BadWindows uint64 `protobuf:"varint,6,opt,name=bad_windows,json=badWindows,proto3" json:"bad_windows,omitempty" yaml:"bad_windows"`
+	// slash_fraction is the fraction slashed at the end of the slash window, zero unless slashed
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	// window_end_height is the last block of the slash window
+	WindowEndHeight int64 `protobuf:"varint,8,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty" yaml:"window_end_height"`
+}
+
+func (m *ValidatorStanding) Reset()         { *m = ValidatorStanding{} }
+func (m *ValidatorStanding) String() string { return proto.CompactTextString(m) }
+func (*ValidatorStanding) ProtoMessage()    {}
+func (*ValidatorStanding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{12}
+}
+func (m *ValidatorStanding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorStanding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorStanding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorStanding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorStanding.Merge(m, src)
+}
+func (m *ValidatorStanding) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorStanding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorStanding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorStanding proto.InternalMessageInfo
+
+// FeedPrice - price of a price feed set at the end of a vote period
+type FeedPrice struct {
+	Name  string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	Quote string                                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty" yaml:"quote"`
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+}
+
+func (m *FeedPrice) Reset()         { *m = FeedPrice{} }
+func (m *FeedPrice) String() string { return proto.CompactTextString(m) }
+func (*FeedPrice) ProtoMessage()    {}
+func (*FeedPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{13}
+}
+func (m *FeedPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedPrice.Merge(m, src)
+}
+func (m *FeedPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedPrice proto.InternalMessageInfo
+
+// ExchangeRateStatus - freshness of the exchange rate of a denom and the reason it was last rejected
+type ExchangeRateStatus struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// exchange_rate is the last exchange rate accepted for the denom
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	// last_update_height is the height the exchange rate was last accepted at
+	LastUpdateHeight int64 `protobuf:"varint,3,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty" yaml:"last_update_height"`
+	// last_median is the exchange rate of the last passed ballot, accepted or not,
+	// from which the next one may move by at most max_rate_change
+	LastMedian github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=last_median,json=lastMedian,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_median" yaml:"last_median"`
+	// rejected_height is the height the exchange rate was last rejected at
+	RejectedHeight int64 `protobuf:"varint,5,opt,name=rejected_height,json=rejectedHeight,proto3" json:"rejected_height,omitempty" yaml:"rejected_height"`
+	// rejection_reason is the reason the exchange rate was last rejected
+	RejectionReason string `protobuf:"bytes,6,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty" yaml:"rejection_reason"`
+}
+
+func (m *ExchangeRateStatus) Reset()         { *m = ExchangeRateStatus{} }
+func (m *ExchangeRateStatus) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateStatus) ProtoMessage()    {}
+func (*ExchangeRateStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{14}
+}
+func (m *ExchangeRateStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateStatus.Merge(m, src)
+}
+func (m *ExchangeRateStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateStatus proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "terra.oracle.v1beta1.Params")
 	proto.RegisterType((*Denom)(nil), "terra.oracle.v1beta1.Denom")
+	proto.RegisterType((*PriceFeed)(nil), "terra.oracle.v1beta1.PriceFeed")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "terra.oracle.v1beta1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "terra.oracle.v1beta1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "terra.oracle.v1beta1.ExchangeRateTuple")
+	proto.RegisterType((*HistoricalExchangeRate)(nil), "terra.oracle.v1beta1.HistoricalExchangeRate")
+	proto.RegisterType((*ExchangeRateHistoryCounter)(nil), "terra.oracle.v1beta1.ExchangeRateHistoryCounter")
+	proto.RegisterType((*ValidatorPerformance)(nil), "terra.oracle.v1beta1.ValidatorPerformance")
+	proto.RegisterType((*DenomPerformance)(nil), "terra.oracle.v1beta1.DenomPerformance")
+	proto.RegisterType((*VotePeriodSummary)(nil), "terra.oracle.v1beta1.VotePeriodSummary")
+	proto.RegisterType((*BallotSummary)(nil), "terra.oracle.v1beta1.BallotSummary")
+	proto.RegisterType((*ValidatorStanding)(nil), "terra.oracle.v1beta1.ValidatorStanding")
+	proto.RegisterType((*FeedPrice)(nil), "terra.oracle.v1beta1.FeedPrice")
+	proto.RegisterType((*ExchangeRateStatus)(nil), "terra.oracle.v1beta1.ExchangeRateStatus")
 }
 
 func init() { proto.RegisterFile("terra/oracle/v1beta1/oracle.proto", fileDescriptor_2a008582d55f197f) }
 
 var fileDescriptor_2a008582d55f197f = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0x8e, 0x7f, 0x6d, 0xf3, 0x6b, 0x2e, 0x29, 0xb4, 0x26, 0x80, 0x69, 0x51, 0xdc, 0x1e, 0x6a,
-	0xa9, 0x90, 0x1a, 0xab, 0x30, 0x20, 0xb2, 0x61, 0x85, 0xb2, 0x80, 0x14, 0x59, 0x55, 0x91, 0x58,
-	0xcc, 0xd9, 0x3e, 0x62, 0xab, 0xb6, 0x2f, 0x3a, 0x5f, 0x9a, 0x74, 0x61, 0x66, 0x64, 0x00, 0x09,
-	0x89, 0xa5, 0x33, 0x3b, 0xfc, 0x0d, 0x1d, 0x3b, 0x22, 0x06, 0x83, 0xda, 0xa5, 0x73, 0xfe, 0x02,
-	0x74, 0xe7, 0x4b, 0xeb, 0x34, 0x19, 0x88, 0x98, 0xe2, 0xf7, 0xbe, 0xe7, 0xef, 0xfb, 0xee, 0xbd,
-	0x7b, 0x31, 0x58, 0x63, 0x98, 0x52, 0x64, 0x10, 0x8a, 0xdc, 0x10, 0x1b, 0x07, 0xdb, 0x0e, 0x66,
-	0x68, 0x5b, 0x86, 0xf5, 0x0e, 0x25, 0x8c, 0xa8, 0x55, 0x51, 0x52, 0x97, 0x39, 0x59, 0xb2, 0x5c,
-	0x6d, 0x93, 0x36, 0x11, 0x05, 0x06, 0x7f, 0xca, 0x6a, 0x97, 0x6b, 0x2e, 0x49, 0x22, 0x92, 0x18,
-	0x0e, 0x4a, 0x2e, 0xd9, 0x5c, 0x12, 0xc4, 0x19, 0x0e, 0x3f, 0x15, 0x41, 0xb1, 0x85, 0x28, 0x8a,
-	0x12, 0xf5, 0x31, 0x28, 0x1f, 0x10, 0x86, 0xed, 0x0e, 0xa6, 0x01, 0xf1, 0x34, 0x65, 0x55, 0xd9,
-	0x9c, 0x35, 0x6f, 0x0d, 0x52, 0x5d, 0x3d, 0x44, 0x51, 0xd8, 0x80, 0x39, 0x10, 0x5a, 0x80, 0x47,
-	0x2d, 0x11, 0xa8, 0x31, 0xb8, 0x26, 0x30, 0xe6, 0x53, 0x9c, 0xf8, 0x24, 0xf4, 0xb4, 0xff, 0x56,
-	0x95, 0xcd, 0x92, 0xf9, 0xfc, 0x38, 0xd5, 0x0b, 0x3f, 0x53, 0x7d, 0xa3, 0x1d, 0x30, 0xbf, 0xeb,
-	0xd4, 0x5d, 0x12, 0x19, 0xd2, 0x4e, 0xf6, 0xb3, 0x95, 0x78, 0xfb, 0x06, 0x3b, 0xec, 0xe0, 0xa4,
-	0xde, 0xc4, 0xee, 0x20, 0xd5, 0x6f, 0xe6, 0x94, 0x2e, 0xd8, 0xa0, 0xb5, 0xc0, 0x13, 0xbb, 0xc3,
-	0x58, 0xc5, 0xa0, 0x4c, 0x71, 0x0f, 0x51, 0xcf, 0x76, 0x50, 0xec, 0x69, 0x33, 0x42, 0xac, 0x39,
-	0xb5, 0x98, 0x3c, 0x56, 0x8e, 0x0a, 0x5a, 0x20, 0x8b, 0x4c, 0x14, 0x7b, 0xaa, 0x0b, 0x96, 0x25,
-	0xe6, 0x05, 0x09, 0xa3, 0x81, 0xd3, 0x65, 0x01, 0x89, 0xed, 0x5e, 0x10, 0x7b, 0xa4, 0xa7, 0xcd,
-	0x8a, 0xf6, 0xac, 0x0f, 0x52, 0x7d, 0x6d, 0x84, 0x67, 0x42, 0x2d, 0xb4, 0xb4, 0x0c, 0x6c, 0xe6,
-	0xb0, 0x57, 0x02, 0x52, 0xdf, 0x80, 0x52, 0xcf, 0x0f, 0x18, 0x0e, 0x83, 0x84, 0x69, 0x73, 0xab,
-	0x33, 0x9b, 0xe5, 0x87, 0x2b, 0xf5, 0x49, 0xf3, 0xad, 0x37, 0x71, 0x4c, 0x22, 0x73, 0x9d, 0x1f,
-	0x73, 0x90, 0xea, 0x8b, 0x99, 0xe8, 0xc5, 0xbb, 0xf0, 0xeb, 0x2f, 0xbd, 0x24, 0x4a, 0x5e, 0x04,
-	0x09, 0xb3, 0x2e, 0x49, 0xf9, 0x74, 0x92, 0x10, 0x25, 0xbe, 0xfd, 0x96, 0x22, 0x97, 0x2b, 0x6b,
-	0xc5, 0x7f, 0x9b, 0xce, 0x28, 0x1b, 0xb4, 0x16, 0x44, 0x62, 0x47, 0xc6, 0x6a, 0x03, 0x54, 0xb2,
-	0x0a, 0xd9, 0xa8, 0xff, 0x45, 0xa3, 0x6e, 0x0f, 0x52, 0xfd, 0x46, 0xfe, 0xfd, 0x61, 0x6b, 0xca,
-	0x22, 0x94, 0xdd, 0x78, 0x07, 0xaa, 0x51, 0x10, 0xdb, 0x07, 0x28, 0x0c, 0x3c, 0x7e, 0xd5, 0x86,
-	0x1c, 0xf3, 0xc2, 0xf1, 0xcb, 0xa9, 0x1d, 0xaf, 0x64, 0x8a, 0x93, 0x38, 0xa1, 0xb5, 0x14, 0x05,
-	0xf1, 0x1e, 0xcf, 0xb6, 0x30, 0xcd, 0xf4, 0x1b, 0xf3, 0x9f, 0x8f, 0xf4, 0xc2, 0xf9, 0x91, 0xae,
-	0xc0, 0x2f, 0x0a, 0x98, 0x13, 0xed, 0x54, 0xef, 0x81, 0xd9, 0x18, 0x45, 0x58, 0xec, 0x43, 0xc9,
-	0xbc, 0x3e, 0x48, 0xf5, 0x72, 0xc6, 0xca, 0xb3, 0xd0, 0x12, 0xa0, 0x6a, 0x83, 0x12, 0x23, 0x4e,
-	0x10, 0xdb, 0x0c, 0xf5, 0xe5, 0xed, 0x37, 0xa7, 0x76, 0x2b, 0x67, 0x7a, 0x41, 0x04, 0xad, 0x79,
-	0xf1, 0xbc, 0x8b, 0xfa, 0x8d, 0xca, 0xfb, 0x23, 0xbd, 0x20, 0xdd, 0x15, 0xe0, 0x37, 0x05, 0xdc,
-	0x7d, 0xda, 0x6e, 0x53, 0xdc, 0x46, 0x0c, 0x3f, 0xeb, 0xbb, 0x3e, 0x8a, 0xdb, 0xd8, 0x42, 0x0c,
-	0xb7, 0x28, 0xe6, 0xbb, 0xc2, 0x4d, 0xfb, 0x28, 0xf1, 0xc7, 0x4d, 0xf3, 0x2c, 0xb4, 0x04, 0xa8,
-	0x6e, 0x80, 0x39, 0x5e, 0x4c, 0xa5, 0xe1, 0xc5, 0x41, 0xaa, 0x57, 0x2e, 0x17, 0x90, 0x42, 0x2b,
-	0x83, 0xc5, 0x44, 0xbb, 0x4e, 0x14, 0x30, 0xdb, 0x09, 0x89, 0xbb, 0x2f, 0x16, 0x6e, 0x74, 0xa2,
-	0x39, 0x94, 0x4f, 0x54, 0x84, 0x26, 0x8f, 0xae, 0xf8, 0x3e, 0x57, 0xc0, 0x9d, 0x89, 0xbe, 0xf7,
-	0xb8, 0xe9, 0x8f, 0x0a, 0xa8, 0x62, 0x99, 0xb4, 0x29, 0xe2, 0xff, 0x01, 0xdd, 0x4e, 0x88, 0x13,
-	0x4d, 0x11, 0x7b, 0x71, 0x7f, 0xf2, 0x5e, 0xe4, 0x69, 0x76, 0x79, 0xbd, 0xf9, 0x44, 0xee, 0x88,
-	0x9c, 0xfe, 0x24, 0x4a, 0xbe, 0x2e, 0xea, 0xd8, 0x9b, 0x89, 0xa5, 0xe2, 0xb1, 0xdc, 0xdf, 0xb6,
-	0xe9, 0xca, 0x51, 0xbf, 0x2b, 0x60, 0x69, 0x4c, 0x80, 0x73, 0x79, 0xfc, 0x56, 0xc9, 0xc1, 0xe4,
-	0xb8, 0x44, 0x1a, 0x5a, 0x19, 0xac, 0xee, 0x83, 0x85, 0x11, 0xdb, 0x52, 0x7b, 0x67, 0xea, 0x3b,
-	0x55, 0x9d, 0xd0, 0x03, 0x68, 0x55, 0xf2, 0xc7, 0x1c, 0x35, 0x6e, 0x36, 0x8f, 0x4f, 0x6b, 0xca,
-	0xc9, 0x69, 0x4d, 0xf9, 0x7d, 0x5a, 0x53, 0x3e, 0x9c, 0xd5, 0x0a, 0x27, 0x67, 0xb5, 0xc2, 0x8f,
-	0xb3, 0x5a, 0xe1, 0xf5, 0x83, 0x9c, 0xaa, 0x18, 0xc5, 0x56, 0x44, 0x62, 0x7c, 0x68, 0xb8, 0x84,
-	0x62, 0xa3, 0x3f, 0xfc, 0x64, 0x09, 0x75, 0xa7, 0x28, 0x3e, 0x2f, 0x8f, 0xfe, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xe8, 0x2c, 0xf2, 0x2b, 0xcf, 0x06, 0x00, 0x00,
+	// 1938 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x8f, 0x1b, 0x49,
+	0x15, 0x9f, 0x8e, 0x3d, 0x1e, 0xbb, 0x6c, 0xcf, 0x47, 0x67, 0x26, 0xe9, 0x9d, 0x84, 0xe9, 0x6c,
+	0x45, 0x1b, 0xb2, 0xc0, 0x8e, 0xb5, 0x8b, 0xd0, 0x8a, 0x39, 0x20, 0xad, 0x33, 0x09, 0x11, 0xcb,
+	0xa2, 0xa1, 0x26, 0x09, 0x82, 0x4b, 0x53, 0xee, 0xae, 0xb1, 0x7b, 0xe3, 0xee, 0xf6, 0x76, 0x95,
+	0xc7, 0x33, 0x7b, 0xe0, 0xc0, 0x69, 0xb5, 0x12, 0x12, 0x07, 0x0e, 0x1c, 0x73, 0xe6, 0x0e, 0x42,
+	0xfc, 0x05, 0x2b, 0x71, 0x59, 0x71, 0x40, 0x88, 0x83, 0x41, 0x09, 0x87, 0x3d, 0x5b, 0x1c, 0xb8,
+	0xac, 0x84, 0xea, 0x55, 0x75, 0xbb, 0xdc, 0x36, 0x51, 0x9c, 0x21, 0x88, 0xd3, 0xb8, 0xde, 0x7b,
+	0xfd, 0xea, 0xd5, 0xef, 0x7d, 0x56, 0x0d, 0x7a, 0x5d, 0xb0, 0x34, 0xa5, 0xad, 0x24, 0xa5, 0x7e,
+	0x9f, 0xb5, 0x4e, 0xdf, 0xee, 0x30, 0x41, 0xdf, 0xd6, 0xcb, 0xfd, 0x41, 0x9a, 0x88, 0xc4, 0xde,
+	0x06, 0x91, 0x7d, 0x4d, 0xd3, 0x22, 0xbb, 0xdb, 0xdd, 0xa4, 0x9b, 0x80, 0x40, 0x4b, 0xfe, 0x52,
+	0xb2, 0xbb, 0x7b, 0x7e, 0xc2, 0xa3, 0x84, 0xb7, 0x3a, 0x94, 0x4f, 0xb5, 0xf9, 0x49, 0x18, 0x2b,
+	0x3e, 0xfe, 0x23, 0x42, 0x95, 0x23, 0x9a, 0xd2, 0x88, 0xdb, 0xef, 0xa2, 0xfa, 0x69, 0x22, 0x98,
+	0x37, 0x60, 0x69, 0x98, 0x04, 0x8e, 0x75, 0xc3, 0xba, 0x5d, 0x6e, 0x5f, 0x99, 0x8c, 0x5d, 0xfb,
+	0x9c, 0x46, 0xfd, 0x03, 0x6c, 0x30, 0x31, 0x41, 0x72, 0x75, 0x04, 0x0b, 0x3b, 0x46, 0xeb, 0xc0,
+	0x13, 0xbd, 0x94, 0xf1, 0x5e, 0xd2, 0x0f, 0x9c, 0x4b, 0x37, 0xac, 0xdb, 0xb5, 0xf6, 0x77, 0x3f,
+	0x1b, 0xbb, 0x2b, 0x7f, 0x1d, 0xbb, 0xb7, 0xba, 0xa1, 0xe8, 0x0d, 0x3b, 0xfb, 0x7e, 0x12, 0xb5,
+	0xb4, 0x39, 0xea, 0xcf, 0x5b, 0x3c, 0x78, 0xdc, 0x12, 0xe7, 0x03, 0xc6, 0xf7, 0x0f, 0x99, 0x3f,
+	0x19, 0xbb, 0x3b, 0xc6, 0x4e, 0xb9, 0x36, 0x4c, 0x9a, 0x92, 0xf0, 0x20, 0x5b, 0xdb, 0x0c, 0xd5,
+	0x53, 0x36, 0xa2, 0x69, 0xe0, 0x75, 0x68, 0x1c, 0x38, 0x25, 0xd8, 0xec, 0x70, 0xe9, 0xcd, 0xf4,
+	0xb1, 0x0c, 0x55, 0x98, 0x20, 0xb5, 0x6a, 0xd3, 0x38, 0xb0, 0x7d, 0xb4, 0xab, 0x79, 0x41, 0xc8,
+	0x45, 0x1a, 0x76, 0x86, 0x22, 0x4c, 0x62, 0x6f, 0x14, 0xc6, 0x41, 0x32, 0x72, 0xca, 0x00, 0xcf,
+	0x1b, 0x93, 0xb1, 0xfb, 0xfa, 0x8c, 0x9e, 0x05, 0xb2, 0x98, 0x38, 0x8a, 0x79, 0x68, 0xf0, 0x7e,
+	0x04, 0x2c, 0xfb, 0xa7, 0xa8, 0x36, 0xea, 0x85, 0x82, 0xf5, 0x43, 0x2e, 0x9c, 0xd5, 0x1b, 0xa5,
+	0xdb, 0xf5, 0x77, 0xae, 0xed, 0x2f, 0xf2, 0xef, 0xfe, 0x21, 0x8b, 0x93, 0xa8, 0xfd, 0x86, 0x3c,
+	0xe6, 0x64, 0xec, 0x6e, 0xaa, 0x4d, 0xf3, 0x6f, 0xf1, 0x6f, 0xfe, 0xe6, 0xd6, 0x40, 0xe4, 0xfb,
+	0x21, 0x17, 0x64, 0xaa, 0x54, 0x7a, 0x87, 0xf7, 0x29, 0xef, 0x79, 0x27, 0x29, 0xf5, 0xe5, 0xce,
+	0x4e, 0xe5, 0x62, 0xde, 0x99, 0xd5, 0x86, 0x49, 0x13, 0x08, 0xf7, 0xf4, 0xda, 0x3e, 0x40, 0x0d,
+	0x25, 0xa1, 0x81, 0x5a, 0x03, 0xa0, 0xae, 0x4e, 0xc6, 0xee, 0x65, 0xf3, 0xfb, 0x0c, 0x9a, 0x3a,
+	0x2c, 0x35, 0x1a, 0x3f, 0x43, 0xdb, 0x51, 0x18, 0x7b, 0xa7, 0xb4, 0x1f, 0x06, 0x32, 0xd4, 0x32,
+	0x1d, 0x55, 0xb0, 0xf8, 0x83, 0xa5, 0x2d, 0xbe, 0xa6, 0x76, 0x5c, 0xa4, 0x13, 0x93, 0xad, 0x28,
+	0x8c, 0x1f, 0x49, 0xea, 0x11, 0x4b, 0xf5, 0xfe, 0x9f, 0x5a, 0xc8, 0x19, 0xd1, 0x34, 0x0e, 0xe3,
+	0xee, 0xbc, 0x11, 0x35, 0x30, 0xe2, 0x87, 0x4b, 0x1b, 0xe1, 0x6a, 0x57, 0xfd, 0x07, 0xbd, 0x98,
+	0xec, 0x68, 0x56, 0xc1, 0x98, 0x73, 0x64, 0x47, 0xf4, 0xcc, 0x2b, 0x38, 0x0f, 0x81, 0x15, 0xef,
+	0x2f, 0x6d, 0xc5, 0x6b, 0x1a, 0x8a, 0x39, 0x8d, 0x98, 0x6c, 0x46, 0xf4, 0xec, 0x78, 0xc6, 0x87,
+	0xef, 0x23, 0xfb, 0x43, 0x1a, 0xf6, 0x3d, 0x7a, 0x22, 0x72, 0x3b, 0xb9, 0x53, 0x07, 0x4f, 0x7e,
+	0x65, 0xaa, 0x6c, 0x5e, 0x06, 0x93, 0x4d, 0x49, 0x7c, 0xef, 0x44, 0x64, 0xc7, 0xe0, 0x76, 0x84,
+	0xea, 0x83, 0x34, 0xf4, 0x99, 0x77, 0xc2, 0x58, 0xc0, 0x9d, 0x06, 0x04, 0xb9, 0xbb, 0x38, 0xc8,
+	0x8f, 0xa4, 0xe0, 0x3d, 0xc6, 0x82, 0xf6, 0xd7, 0x75, 0xa0, 0xeb, 0x2c, 0x35, 0x34, 0xc8, 0x50,
+	0x6f, 0xe6, 0x82, 0x10, 0xee, 0x68, 0x90, 0x2d, 0xb9, 0x3d, 0x40, 0x1b, 0xf2, 0x90, 0x29, 0x15,
+	0xcc, 0xf3, 0x7b, 0x34, 0xee, 0x32, 0xa7, 0x09, 0x98, 0xdd, 0x5f, 0x1a, 0xb3, 0x2b, 0x53, 0xcc,
+	0x0c, 0x75, 0x98, 0x34, 0x23, 0x7a, 0x46, 0xa8, 0x60, 0x77, 0x60, 0x7d, 0x50, 0xfd, 0xf5, 0x13,
+	0x77, 0xe5, 0x8b, 0x27, 0xae, 0x85, 0xff, 0x64, 0xa1, 0x55, 0x48, 0x42, 0xfb, 0x26, 0x2a, 0xc7,
+	0x34, 0x62, 0x50, 0x45, 0x6b, 0xed, 0x8d, 0xc9, 0xd8, 0xad, 0x2b, 0x65, 0x92, 0x8a, 0x09, 0x30,
+	0x6d, 0x0f, 0xd5, 0x44, 0xd2, 0x09, 0x63, 0x4f, 0xd0, 0x33, 0x5d, 0x33, 0xdb, 0x4b, 0x1b, 0xa9,
+	0x2b, 0x41, 0xae, 0x08, 0x93, 0x2a, 0xfc, 0x7e, 0x40, 0xcf, 0xec, 0x6f, 0x21, 0x44, 0xbb, 0xdd,
+	0x94, 0x75, 0xa9, 0x48, 0x52, 0x5d, 0x28, 0x77, 0x26, 0x63, 0x77, 0x4b, 0x7d, 0x33, 0xe5, 0x61,
+	0x62, 0x08, 0x1e, 0x34, 0x3e, 0x79, 0xe2, 0xae, 0xe8, 0x43, 0xad, 0xe0, 0x5f, 0x5c, 0x42, 0xb5,
+	0x1c, 0xee, 0x17, 0x3b, 0xd8, 0x2d, 0xb4, 0xfa, 0xd1, 0x30, 0x11, 0x4c, 0x1f, 0x6a, 0x73, 0x32,
+	0x76, 0x1b, 0x4a, 0x0a, 0xc8, 0x98, 0x28, 0xf6, 0x82, 0xce, 0x51, 0x7a, 0xa5, 0x9d, 0x63, 0x16,
+	0x8f, 0xf2, 0xcb, 0xe0, 0x61, 0xe1, 0xdf, 0x5a, 0xe8, 0xfa, 0x7b, 0x9a, 0xc9, 0xee, 0x9e, 0xa9,
+	0xa0, 0x90, 0xe1, 0x70, 0x94, 0x32, 0xb9, 0x9d, 0x84, 0xa8, 0x47, 0x79, 0x6f, 0x1e, 0x22, 0x49,
+	0xc5, 0x04, 0x98, 0x12, 0x22, 0x29, 0x9c, 0xce, 0x43, 0x04, 0x64, 0x4c, 0x14, 0x1b, 0xca, 0xe9,
+	0xb0, 0x13, 0x85, 0xc2, 0xeb, 0xf4, 0x13, 0xff, 0xb1, 0x53, 0x9a, 0x2b, 0xa7, 0x06, 0x57, 0x96,
+	0x53, 0x58, 0xb6, 0xe5, 0xaa, 0xe0, 0xc7, 0x2f, 0x2c, 0xf4, 0xda, 0x42, 0xbb, 0x1f, 0x49, 0xa3,
+	0x7f, 0x65, 0xa1, 0x6d, 0xa6, 0x89, 0x2a, 0xda, 0xc5, 0x70, 0xd0, 0x67, 0xdc, 0xb1, 0x20, 0x5f,
+	0xbf, 0xba, 0x38, 0x5f, 0x4d, 0x35, 0x0f, 0xa4, 0x7c, 0xfb, 0xdb, 0x3a, 0x6f, 0x75, 0xe9, 0x5d,
+	0xa4, 0x52, 0x26, 0xb0, 0x3d, 0xf7, 0x25, 0x27, 0x36, 0x9b, 0xa3, 0xbd, 0x28, 0x4c, 0x85, 0xa3,
+	0xfe, 0xce, 0x42, 0x5b, 0x73, 0x1b, 0x48, 0x5d, 0x81, 0x4c, 0x4e, 0xc7, 0x2a, 0xea, 0x02, 0x32,
+	0x26, 0x8a, 0x6d, 0x3f, 0x46, 0xcd, 0x19, 0xb3, 0xf5, 0xde, 0xf7, 0x96, 0x0e, 0xca, 0xed, 0x05,
+	0x18, 0x60, 0xd2, 0x30, 0x8f, 0x59, 0x30, 0xfc, 0x0f, 0x16, 0xba, 0x72, 0x3f, 0xe4, 0x22, 0x49,
+	0x43, 0x9f, 0xf6, 0xcd, 0x23, 0xd8, 0x6f, 0xa2, 0x4a, 0x8f, 0x85, 0xdd, 0x9e, 0x00, 0xf3, 0x4b,
+	0xed, 0xad, 0xc9, 0xd8, 0x6d, 0xea, 0xb8, 0x02, 0x3a, 0x26, 0x5a, 0xe0, 0x7f, 0x7b, 0x80, 0xea,
+	0x27, 0x99, 0xf1, 0x9f, 0x5a, 0x68, 0xd7, 0x34, 0x59, 0x1d, 0xe4, 0xfc, 0x4e, 0x32, 0x8c, 0x65,
+	0x24, 0xdf, 0x42, 0xab, 0xbe, 0xfc, 0xa9, 0x27, 0x4b, 0x03, 0x7e, 0x20, 0x63, 0xa2, 0xd8, 0x32,
+	0xe2, 0x7b, 0xea, 0x4b, 0x8f, 0x87, 0x1f, 0x2b, 0xe3, 0x67, 0x22, 0xde, 0xe4, 0x62, 0x52, 0xd7,
+	0xcb, 0xe3, 0xf0, 0x63, 0xd3, 0x98, 0x3f, 0x97, 0xd0, 0x36, 0x34, 0x54, 0x99, 0xc1, 0x47, 0x2c,
+	0x3d, 0x49, 0xd2, 0x88, 0xc6, 0x3e, 0xb3, 0xdf, 0x41, 0xb5, 0xd3, 0x8c, 0xae, 0x23, 0x61, 0x7b,
+	0x5a, 0x46, 0x73, 0x16, 0x26, 0x53, 0x31, 0x89, 0xbd, 0x1e, 0x02, 0x94, 0x31, 0x06, 0xf6, 0x59,
+	0x13, 0xd7, 0x02, 0x90, 0xaf, 0x82, 0xa6, 0xc2, 0xd3, 0xce, 0x2a, 0x81, 0xb3, 0xcc, 0x7c, 0x35,
+	0xb8, 0x32, 0x5f, 0xe5, 0xf2, 0xbe, 0xf2, 0xdb, 0x01, 0x6a, 0x18, 0x43, 0x36, 0xd7, 0x33, 0xa6,
+	0xf1, 0xad, 0xc9, 0xc5, 0xa4, 0x3e, 0x9d, 0xc1, 0xf3, 0x44, 0xe1, 0xce, 0x6a, 0x11, 0x5d, 0x20,
+	0xeb, 0x44, 0xe1, 0x76, 0x0b, 0x55, 0x69, 0x87, 0x0b, 0x1a, 0xc6, 0x1c, 0x06, 0xc1, 0x72, 0xfb,
+	0xf2, 0x64, 0xec, 0x6e, 0x28, 0xd1, 0x8c, 0x83, 0x49, 0x2e, 0x24, 0xcf, 0x1e, 0x85, 0x9c, 0x33,
+	0xee, 0xac, 0x15, 0xcf, 0xae, 0xe8, 0x98, 0x68, 0x01, 0xfb, 0x21, 0xaa, 0x40, 0x06, 0x71, 0xa7,
+	0x0a, 0x45, 0xe3, 0xd6, 0x73, 0x26, 0x59, 0xc3, 0x25, 0xed, 0x1d, 0x5d, 0x33, 0x9a, 0x46, 0x36,
+	0x4a, 0xb5, 0xea, 0x47, 0x21, 0x45, 0xbe, 0xbc, 0x84, 0x36, 0x8b, 0x1a, 0x5e, 0x38, 0xb5, 0x73,
+	0x94, 0x2e, 0x3d, 0x1f, 0xa5, 0x9b, 0xa8, 0x3c, 0x92, 0x08, 0xa9, 0x6a, 0x6b, 0x94, 0xf0, 0x11,
+	0xa0, 0x03, 0x4c, 0xfb, 0x23, 0xb4, 0x21, 0x12, 0x41, 0xfb, 0x5e, 0xc0, 0x4e, 0x43, 0x0a, 0xd3,
+	0x59, 0xf9, 0x62, 0x93, 0x46, 0x41, 0x1d, 0x26, 0xeb, 0x40, 0x39, 0xcc, 0x08, 0xf6, 0x08, 0x6d,
+	0xd1, 0x53, 0x96, 0xd2, 0x2e, 0x33, 0x36, 0x5d, 0x85, 0x4d, 0xbf, 0xb7, 0xf4, 0xa6, 0x8e, 0x76,
+	0x7a, 0x51, 0x21, 0x26, 0x9b, 0x9a, 0x96, 0x6f, 0x6c, 0x24, 0xd6, 0x3f, 0x2c, 0xb4, 0xf5, 0x28,
+	0x0f, 0xbc, 0xe3, 0x61, 0x14, 0xd1, 0xf4, 0x7c, 0x99, 0xea, 0x74, 0x07, 0x6d, 0xa4, 0xec, 0x84,
+	0xa5, 0x2c, 0xf6, 0x99, 0xa7, 0xbc, 0xa6, 0xea, 0xd3, 0xee, 0x14, 0x88, 0x82, 0x00, 0x26, 0xeb,
+	0x39, 0x45, 0xcd, 0x57, 0x0f, 0xd1, 0x5a, 0x87, 0xf6, 0xfb, 0x89, 0x90, 0x3e, 0x92, 0xb1, 0x76,
+	0x73, 0x71, 0xac, 0xb5, 0x41, 0x48, 0x5b, 0xd9, 0xbe, 0xa2, 0x03, 0x6d, 0x5d, 0xed, 0xa2, 0x35,
+	0x60, 0x92, 0xe9, 0x32, 0x8e, 0xf9, 0x65, 0x05, 0x35, 0x67, 0x3e, 0x7e, 0xe1, 0x18, 0x3b, 0x40,
+	0x0d, 0xa5, 0xce, 0x1b, 0x24, 0x23, 0xdd, 0xb9, 0x66, 0x2a, 0x80, 0xc9, 0xc5, 0xa4, 0xae, 0x96,
+	0x47, 0x72, 0x25, 0xb1, 0xc9, 0xa7, 0x17, 0xfd, 0xb9, 0x2a, 0x20, 0x06, 0x36, 0x05, 0x01, 0x19,
+	0x24, 0x19, 0x45, 0x29, 0x79, 0x13, 0x55, 0x06, 0x94, 0x73, 0x16, 0x40, 0x38, 0x56, 0x4d, 0x5f,
+	0x28, 0x3a, 0x26, 0x5a, 0x40, 0x86, 0xf0, 0x08, 0xbc, 0xc2, 0x02, 0x2f, 0x62, 0x41, 0x48, 0xb3,
+	0x68, 0x7a, 0xe9, 0x10, 0x2e, 0xa8, 0xc3, 0x64, 0x3d, 0xa3, 0x7c, 0x00, 0x04, 0xd9, 0x9c, 0xf4,
+	0x55, 0x99, 0x0f, 0x52, 0x46, 0x03, 0xa7, 0x72, 0xb1, 0xe6, 0x34, 0xa3, 0x0c, 0x93, 0x86, 0x5a,
+	0x1f, 0xc3, 0x72, 0xbe, 0x13, 0xae, 0xbd, 0xba, 0x4e, 0x68, 0x7f, 0x03, 0xad, 0x8d, 0xc2, 0x38,
+	0x66, 0xa9, 0xaa, 0x7f, 0xb5, 0xb6, 0x3d, 0x0d, 0x35, 0xcd, 0xc0, 0x24, 0x13, 0xc9, 0xeb, 0x6a,
+	0xe0, 0xd4, 0x40, 0xb8, 0x58, 0x57, 0x83, 0xac, 0xae, 0x06, 0xb2, 0x65, 0xe9, 0x72, 0xcc, 0x02,
+	0x07, 0xdd, 0x28, 0xcd, 0xb6, 0xac, 0x9c, 0x85, 0xc9, 0x54, 0x4c, 0xd6, 0xf9, 0x94, 0x7d, 0xc8,
+	0x7c, 0xc1, 0x02, 0xb8, 0xb8, 0x55, 0xcd, 0x3a, 0x9f, 0x71, 0x30, 0xc9, 0x85, 0xec, 0x9f, 0x5b,
+	0x68, 0x87, 0x0e, 0x06, 0xfd, 0x90, 0x05, 0xde, 0x2c, 0x66, 0x0d, 0xc0, 0xec, 0x07, 0x4b, 0x63,
+	0x76, 0x5d, 0xdb, 0xb7, 0x48, 0x29, 0x26, 0x97, 0x35, 0xfd, 0xee, 0xe2, 0x61, 0xe2, 0x5f, 0x65,
+	0xb4, 0x95, 0xf7, 0xef, 0x63, 0x41, 0xe3, 0x20, 0x8c, 0xbb, 0x2f, 0xdb, 0xbc, 0xb9, 0xa0, 0x62,
+	0xc8, 0x75, 0x99, 0x31, 0x80, 0x56, 0x74, 0x4c, 0xb4, 0x80, 0x4c, 0x5d, 0x09, 0xb9, 0xe7, 0xab,
+	0x91, 0x65, 0x7e, 0xd8, 0x36, 0xb9, 0x98, 0xd4, 0xe5, 0x32, 0x1b, 0x6f, 0x7e, 0x8c, 0xae, 0x9a,
+	0xed, 0xd9, 0x7c, 0x39, 0x50, 0x7d, 0x1c, 0x4f, 0xc6, 0xee, 0xde, 0x7c, 0x1f, 0x9f, 0x79, 0x0a,
+	0xd8, 0x36, 0x5a, 0xfa, 0xf4, 0x25, 0x60, 0x80, 0x36, 0xd4, 0xab, 0x01, 0x7c, 0x07, 0x3e, 0xb9,
+	0x60, 0x96, 0x16, 0xd4, 0xc9, 0x8b, 0x92, 0xa4, 0xc8, 0xba, 0x0e, 0xa1, 0xfc, 0x2e, 0xaa, 0x77,
+	0x68, 0x90, 0xdf, 0xfc, 0x2b, 0xc5, 0xb7, 0x40, 0x83, 0x89, 0x09, 0xea, 0xd0, 0x20, 0xbb, 0xec,
+	0xcf, 0xbf, 0x36, 0xad, 0xbd, 0xd2, 0xd7, 0xa6, 0xfb, 0x68, 0x4b, 0xd9, 0xe1, 0xb1, 0x38, 0xc8,
+	0x66, 0xae, 0x2a, 0x94, 0xcc, 0xeb, 0xd3, 0x16, 0x37, 0x27, 0x82, 0xc9, 0x86, 0xa2, 0xdd, 0x8d,
+	0x03, 0x35, 0x7c, 0x19, 0xa1, 0xf7, 0x7b, 0x0b, 0xd5, 0xe4, 0x5d, 0x17, 0x2e, 0xbd, 0xff, 0xdd,
+	0x0b, 0xef, 0x03, 0xb4, 0x0a, 0x4f, 0x15, 0xfa, 0x9e, 0xfb, 0x9d, 0xa5, 0x51, 0x69, 0x18, 0xcf,
+	0x21, 0x98, 0x28, 0x65, 0x86, 0xe9, 0xff, 0x2c, 0xa1, 0x99, 0x9b, 0xd5, 0xb1, 0x8a, 0xeb, 0xff,
+	0xc7, 0x9b, 0x8f, 0x7c, 0x64, 0xea, 0x53, 0x2e, 0xbc, 0xe1, 0x20, 0x90, 0x77, 0xc3, 0x99, 0x79,
+	0xd9, 0x78, 0x64, 0x9a, 0x97, 0xc1, 0x64, 0x53, 0x12, 0x1f, 0x02, 0x4d, 0x8f, 0xce, 0x0c, 0xd5,
+	0x41, 0x50, 0x37, 0xb1, 0xf2, 0xc5, 0xde, 0x84, 0x0d, 0x55, 0x98, 0x20, 0xb9, 0xd2, 0xcd, 0x0b,
+	0x66, 0x17, 0x55, 0x30, 0x33, 0x83, 0x57, 0x8b, 0xfd, 0xb9, 0x20, 0x00, 0xb3, 0x8b, 0xa2, 0x68,
+	0x5b, 0xef, 0xa1, 0x4d, 0x45, 0x91, 0x4f, 0xc4, 0x29, 0xa3, 0x3c, 0x7f, 0x93, 0xbd, 0x36, 0x19,
+	0xbb, 0x57, 0x4d, 0x2d, 0x53, 0x09, 0x4c, 0x36, 0x72, 0x12, 0x01, 0xca, 0xd4, 0xed, 0xed, 0xc3,
+	0xcf, 0x9e, 0xee, 0x59, 0x9f, 0x3f, 0xdd, 0xb3, 0xfe, 0xfe, 0x74, 0xcf, 0xfa, 0xe5, 0xb3, 0xbd,
+	0x95, 0xcf, 0x9f, 0xed, 0xad, 0xfc, 0xe5, 0xd9, 0xde, 0xca, 0x4f, 0xbe, 0x66, 0x1c, 0x1d, 0x06,
+	0xa4, 0xb7, 0xa2, 0x24, 0x66, 0xe7, 0x2d, 0x3f, 0x49, 0x59, 0xeb, 0x2c, 0xfb, 0x37, 0x03, 0x40,
+	0xd0, 0xa9, 0xc0, 0xbf, 0x04, 0xbe, 0xf9, 0xef, 0x01, 0x00, 0xbf, 0x1d, 0x64, 0x40, 0x83, 0x18,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -363,28 +944,130 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
 		return false
 	}
+	if !this.WarningValidPerWindow.Equal(that1.WarningValidPerWindow) {
+		return false
+	}
+	if !this.MaxSlashFraction.Equal(that1.MaxSlashFraction) {
+		return false
+	}
+	if this.JailAfterWindows != that1.JailAfterWindows {
+		return false
+	}
+	if len(this.PriceFeeds) != len(that1.PriceFeeds) {
+		return false
+	}
+	for i := range this.PriceFeeds {
+		if !this.PriceFeeds[i].Equal(&that1.PriceFeeds[i]) {
+			return false
+		}
+	}
+	if !this.MaxRateChange.Equal(that1.MaxRateChange) {
+		return false
+	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PriceFeed) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+
+	that1, ok := that.(*PriceFeed)
+	if !ok {
+		that2, ok := that.(PriceFeed)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Quote != that1.Quote {
+		return false
+	}
+	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
+		return false
+	}
+	if this.Aggregator != that1.Aggregator {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
 func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxRateChange.Size()
+		i -= size
+		if _, err := m.MaxRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.PriceFeeds) > 0 {
+		for iNdEx := len(m.PriceFeeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceFeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.JailAfterWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.JailAfterWindows))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MaxSlashFraction.Size()
+		i -= size
+		if _, err := m.MaxSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.WarningValidPerWindow.Size()
+		i -= size
+		if _, err := m.WarningValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MinValidPerWindow.Size()
 		i -= size
@@ -477,6 +1160,13 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Aggregator) > 0 {
+		i -= len(m.Aggregator)
+		copy(dAtA[i:], m.Aggregator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Aggregator)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.TobinTax.Size()
 		i -= size
@@ -497,6 +1187,60 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aggregator) > 0 {
+		i -= len(m.Aggregator)
+		copy(dAtA[i:], m.Aggregator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Aggregator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -623,125 +1367,2849 @@ func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HistoricalExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *HistoricalExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
-	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.RewardDistributionWindow != 0 {
-		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
-	}
-	if len(m.Whitelist) > 0 {
-		for _, e := range m.Whitelist {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Denom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+func (m *ExchangeRateHistoryCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.TobinTax.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AggregateExchangeRatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExchangeRateHistoryCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateHistoryCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.HistorySize != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.HistorySize))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.SubmitBlock != 0 {
-		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	if m.Count != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRateTuples) > 0 {
-		for _, e := range m.ExchangeRateTuples {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.Misses != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *ExchangeRateTuple) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Abstains != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Abstains))
+		i--
+		dAtA[i] = 0x30
 	}
+	if m.Votes != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VotePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.AverageDeviation.Size()
+		i -= size
+		if _, err := m.AverageDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalDeviation.Size()
+		i -= size
+		if _, err := m.TotalDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Wins != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Wins))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Votes != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotePeriodSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotePeriodSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotePeriodSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ballots) > 0 {
+		for iNdEx := len(m.Ballots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ballots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BallotSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BallotSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BallotSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AppliedExchangeRate.Size()
+		i -= size
+		if _, err := m.AppliedExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.Rejected {
+		i--
+		if m.Rejected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Abstained) > 0 {
+		for iNdEx := len(m.Abstained) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Abstained[iNdEx])
+			copy(dAtA[i:], m.Abstained[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Abstained[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Missed) > 0 {
+		for iNdEx := len(m.Missed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Missed[iNdEx])
+			copy(dAtA[i:], m.Missed[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Missed[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Winners) > 0 {
+		for iNdEx := len(m.Winners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Winners[iNdEx])
+			copy(dAtA[i:], m.Winners[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Winners[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RewardSpread.Size()
+		i -= size
+		if _, err := m.RewardSpread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.WeightedMedian.Size()
+		i -= size
+		if _, err := m.WeightedMedian.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ThresholdPower != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ThresholdPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BallotPower != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BallotPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorStanding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorStanding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorStanding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowEndHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WindowEndHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.BadWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BadWindows))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ValidVoteRate.Size()
+		i -= size
+		if _, err := m.ValidVoteRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.VotePeriodsPerWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriodsPerWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissCounter != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RejectionReason) > 0 {
+		i -= len(m.RejectionReason)
+		copy(dAtA[i:], m.RejectionReason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.RejectionReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RejectedHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RejectedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.LastMedian.Size()
+		i -= size
+		if _, err := m.LastMedian.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	if len(m.Whitelist) > 0 {
+		for _, e := range m.Whitelist {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.WarningValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxSlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.JailAfterWindows != 0 {
+		n += 1 + sovOracle(uint64(m.JailAfterWindows))
+	}
+	if len(m.PriceFeeds) > 0 {
+		for _, e := range m.PriceFeeds {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.MaxRateChange.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.TobinTax.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Aggregator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Aggregator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateTuples) > 0 {
+		for _, e := range m.ExchangeRateTuples {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *HistoricalExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ExchangeRateHistoryCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovOracle(uint64(m.Count))
+	}
+	if m.HistorySize != 0 {
+		n += 1 + sovOracle(uint64(m.HistorySize))
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovOracle(uint64(m.Window))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovOracle(uint64(m.StartHeight))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriods))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovOracle(uint64(m.Votes))
+	}
+	if m.Abstains != 0 {
+		n += 1 + sovOracle(uint64(m.Abstains))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovOracle(uint64(m.Misses))
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovOracle(uint64(m.Votes))
+	}
+	if m.Wins != 0 {
+		n += 1 + sovOracle(uint64(m.Wins))
+	}
+	l = m.TotalDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.AverageDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *VotePeriodSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Ballots) > 0 {
+		for _, e := range m.Ballots {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BallotSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.BallotPower != 0 {
+		n += 1 + sovOracle(uint64(m.BallotPower))
+	}
+	if m.ThresholdPower != 0 {
+		n += 1 + sovOracle(uint64(m.ThresholdPower))
+	}
+	if m.Passed {
+		n += 2
+	}
+	l = m.WeightedMedian.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardSpread.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.Winners) > 0 {
+		for _, s := range m.Winners {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.Missed) > 0 {
+		for _, s := range m.Missed {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.Abstained) > 0 {
+		for _, s := range m.Abstained {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.Rejected {
+		n += 2
+	}
+	l = m.AppliedExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ValidatorStanding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MissCounter != 0 {
+		n += 1 + sovOracle(uint64(m.MissCounter))
+	}
+	if m.VotePeriodsPerWindow != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriodsPerWindow))
+	}
+	l = m.ValidVoteRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.BadWindows != 0 {
+		n += 1 + sovOracle(uint64(m.BadWindows))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.WindowEndHeight != 0 {
+		n += 1 + sovOracle(uint64(m.WindowEndHeight))
+	}
+	return n
+}
+
+func (m *FeedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ExchangeRateStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovOracle(uint64(m.LastUpdateHeight))
+	}
+	l = m.LastMedian.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RejectedHeight != 0 {
+		n += 1 + sovOracle(uint64(m.RejectedHeight))
+	}
+	l = len(m.RejectionReason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, Denom{})
+			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WarningValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailAfterWindows", wireType)
+			}
+			m.JailAfterWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailAfterWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceFeeds = append(m.PriceFeeds, PriceFeed{})
+			if err := m.PriceFeeds[len(m.PriceFeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TobinTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TobinTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateHistoryCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateHistoryCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateHistoryCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
+			}
+			m.HistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstains", wireType)
+			}
+			m.Abstains = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstains |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, DenomPerformance{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wins", wireType)
+			}
+			m.Wins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wins |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return nil
 }
+func (m *VotePeriodSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotePeriodSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotePeriodSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ballots = append(m.Ballots, BallotSummary{})
+			if err := m.Ballots[len(m.Ballots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BallotSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -754,27 +4222,151 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BallotSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BallotSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotPower", wireType)
+			}
+			m.BallotPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPower", wireType)
+			}
+			m.ThresholdPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedMedian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedMedian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSpread", wireType)
 			}
-			m.VotePeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -784,14 +4376,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -819,13 +4426,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Winners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -853,15 +4460,77 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Winners = append(m.Winners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Missed = append(m.Missed, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstained", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abstained = append(m.Abstained, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
 			}
-			m.RewardDistributionWindow = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -871,16 +4540,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.Rejected = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedExchangeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -890,29 +4560,181 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, Denom{})
-			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AppliedExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorStanding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorStanding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorStanding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriodsPerWindow", wireType)
+			}
+			m.VotePeriodsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriodsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidVoteRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -940,15 +4762,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidVoteRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadWindows", wireType)
 			}
-			m.SlashWindow = 0
+			m.BadWindows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -958,14 +4780,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				m.BadWindows |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -993,10 +4815,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
+			}
+			m.WindowEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1018,7 +4859,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *FeedPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1041,10 +4882,10 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1081,7 +4922,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TobinTax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1109,7 +4950,39 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TobinTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1134,7 +5007,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *ExchangeRateStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1157,15 +5030,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeRateStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeRateStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1193,11 +5066,11 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1225,82 +5098,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
-			}
-			m.SubmitBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubmitBlock |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
 			}
-			var msglen int
+			m.LastUpdateHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1310,29 +5116,14 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastUpdateHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMedian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1360,63 +5151,15 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LastMedian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedHeight", wireType)
 			}
-			var stringLen uint64
+			m.RejectedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1426,27 +5169,14 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RejectedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1474,9 +5204,7 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RejectionReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
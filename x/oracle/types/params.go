@@ -21,6 +21,11 @@ var (
 	KeySlashFraction            = []byte("SlashFraction")
 	KeySlashWindow              = []byte("SlashWindow")
 	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyWarningValidPerWindow    = []byte("WarningValidPerWindow")
+	KeyMaxSlashFraction         = []byte("MaxSlashFraction")
+	KeyJailAfterWindows         = []byte("JailAfterWindows")
+	KeyPriceFeeds               = []byte("PriceFeeds")
+	KeyMaxRateChange            = []byte("MaxRateChange")
 )
 
 // Default parameter values
@@ -28,6 +33,7 @@ const (
 	DefaultVotePeriod               = core.BlocksPerMinute / 2 // 30 seconds
 	DefaultSlashWindow              = core.BlocksPerWeek       // window for a week
 	DefaultRewardDistributionWindow = core.BlocksPerYear       // window for a year
+	DefaultJailAfterWindows         = uint64(1)                // jail on the first slash
 )
 
 // Default parameter values
@@ -40,8 +46,11 @@ var (
 		{Name: core.MicroSDRDenom, TobinTax: DefaultTobinTax},
 		{Name: core.MicroUSDDenom, TobinTax: DefaultTobinTax},
 		{Name: core.MicroMNTDenom, TobinTax: DefaultTobinTax.MulInt64(8)}}
-	DefaultSlashFraction     = sdk.NewDecWithPrec(1, 4) // 0.01%
-	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultSlashFraction         = sdk.NewDecWithPrec(1, 4)  // 0.01%
+	DefaultMinValidPerWindow     = sdk.NewDecWithPrec(5, 2)  // 5%
+	DefaultWarningValidPerWindow = sdk.NewDecWithPrec(10, 2) // 10%
+	DefaultMaxSlashFraction      = sdk.NewDecWithPrec(1, 3)  // 0.1%
+	DefaultMaxRateChange         = sdk.ZeroDec()             // unlimited
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -57,6 +66,11 @@ func DefaultParams() Params {
 		SlashFraction:            DefaultSlashFraction,
 		SlashWindow:              DefaultSlashWindow,
 		MinValidPerWindow:        DefaultMinValidPerWindow,
+		WarningValidPerWindow:    DefaultWarningValidPerWindow,
+		MaxSlashFraction:         DefaultMaxSlashFraction,
+		JailAfterWindows:         DefaultJailAfterWindows,
+		PriceFeeds:               PriceFeedList{},
+		MaxRateChange:            DefaultMaxRateChange,
 	}
 }
 
@@ -77,6 +91,11 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramstypes.NewParamSetPair(KeyWarningValidPerWindow, &p.WarningValidPerWindow, validateWarningValidPerWindow),
+		paramstypes.NewParamSetPair(KeyMaxSlashFraction, &p.MaxSlashFraction, validateMaxSlashFraction),
+		paramstypes.NewParamSetPair(KeyJailAfterWindows, &p.JailAfterWindows, validateJailAfterWindows),
+		paramstypes.NewParamSetPair(KeyPriceFeeds, &p.PriceFeeds, validatePriceFeeds),
+		paramstypes.NewParamSetPair(KeyMaxRateChange, &p.MaxRateChange, validateMaxRateChange),
 	}
 }
 
@@ -115,6 +134,14 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.WarningValidPerWindow.GT(sdk.OneDec()) || p.WarningValidPerWindow.LT(p.MinValidPerWindow) {
+		return fmt.Errorf("oracle parameter WarningValidPerWindow must be between [MinValidPerWindow, 1]")
+	}
+
+	if p.MaxSlashFraction.GT(sdk.OneDec()) || p.MaxSlashFraction.LT(p.SlashFraction) {
+		return fmt.Errorf("oracle parameter MaxSlashFraction must be between [SlashFraction, 1]")
+	}
+
 	for _, denom := range p.Whitelist {
 		if denom.TobinTax.GT(sdk.OneDec()) || denom.TobinTax.IsNegative() {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have TobinTax between [0, 1]")
@@ -122,6 +149,17 @@ func (p Params) Validate() error {
 		if len(denom.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
 		}
+		if _, err := GetAggregator(denom.Aggregator); err != nil {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s: %w", denom.Name, err)
+		}
+	}
+
+	if err := p.PriceFeeds.Validate(p.Whitelist); err != nil {
+		return fmt.Errorf("oracle parameter PriceFeeds: %w", err)
+	}
+
+	if p.MaxRateChange.IsNegative() {
+		return fmt.Errorf("oracle parameter MaxRateChange must be positive or zero")
 	}
 	return nil
 }
@@ -199,6 +237,9 @@ func validateWhitelist(i interface{}) error {
 		if len(d.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
 		}
+		if _, err := GetAggregator(d.Aggregator); err != nil {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s: %w", d.Name, err)
+		}
 	}
 
 	return nil
@@ -250,3 +291,68 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateWarningValidPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("warning valid per window must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("warning valid per window is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max slash fraction must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max slash fraction is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateJailAfterWindows(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePriceFeeds(i interface{}) error {
+	v, ok := i.(PriceFeedList)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate(nil)
+}
+
+func validateMaxRateChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max rate change must be positive or zero: %s", v)
+	}
+
+	return nil
+}
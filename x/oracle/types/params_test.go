@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -66,6 +68,45 @@ func TestParamsEqual(t *testing.T) {
 	err = p9.Validate()
 	require.Error(t, err)
 
+	// unknown aggregator
+	p11 := DefaultParams()
+	p11.Whitelist = DenomList{{Name: core.MicroKRWDenom, TobinTax: DefaultTobinTax, Aggregator: "mode"}}
+	err = p11.Validate()
+	require.Error(t, err)
+
+	p11.Whitelist[0].Aggregator = AggregatorTrimmedMean
+	err = p11.Validate()
+	require.NoError(t, err)
+
+	// warning valid per window below min valid per window
+	p12 := DefaultParams()
+	p12.WarningValidPerWindow = p12.MinValidPerWindow.QuoInt64(2)
+	err = p12.Validate()
+	require.Error(t, err)
+
+	// max slash fraction below slash fraction
+	p13 := DefaultParams()
+	p13.MaxSlashFraction = p13.SlashFraction.QuoInt64(2)
+	err = p13.Validate()
+	require.Error(t, err)
+
+	// price feed of a whitelisted denom
+	p14 := DefaultParams()
+	p14.Whitelist = DenomList{{Name: core.MicroKRWDenom, TobinTax: DefaultTobinTax}}
+	p14.PriceFeeds = PriceFeedList{{Name: core.MicroKRWDenom, Quote: core.MicroUSDDenom, VoteThreshold: sdk.ZeroDec()}}
+	err = p14.Validate()
+	require.Error(t, err)
+
+	p14.PriceFeeds[0].Name = "ubtc"
+	err = p14.Validate()
+	require.NoError(t, err)
+
+	// negative max rate change
+	p15 := DefaultParams()
+	p15.MaxRateChange = sdk.NewDecWithPrec(-1, 2)
+	err = p15.Validate()
+	require.Error(t, err)
+
 	p10 := DefaultParams()
 	require.NotNil(t, p10.ParamSetPairs())
 	require.NotNil(t, p10.String())
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"sort"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorPerformance creates an empty ValidatorPerformance of the validator
+// for the slash window starting at window * slashWindow
+func NewValidatorPerformance(validator sdk.ValAddress, window uint64, slashWindow uint64) ValidatorPerformance {
+	return ValidatorPerformance{
+		Validator:   validator.String(),
+		Window:      window,
+		StartHeight: int64(window * slashWindow),
+	}
+}
+
+// String implement stringify
+func (p ValidatorPerformance) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// AddDenomVote records a tallied exchange rate of the denom, whether it
+// won the ballot and its relative deviation from the weighted median
+func (p *ValidatorPerformance) AddDenomVote(denom string, win bool, deviation sdk.Dec) {
+	i := sort.Search(len(p.Denoms), func(i int) bool { return p.Denoms[i].Denom >= denom })
+	if i == len(p.Denoms) || p.Denoms[i].Denom != denom {
+		p.Denoms = append(p.Denoms, DenomPerformance{})
+		copy(p.Denoms[i+1:], p.Denoms[i:])
+		p.Denoms[i] = DenomPerformance{
+			Denom:            denom,
+			TotalDeviation:   sdk.ZeroDec(),
+			AverageDeviation: sdk.ZeroDec(),
+		}
+	}
+
+	dp := &p.Denoms[i]
+	dp.Votes++
+	if win {
+		dp.Wins++
+	}
+
+	dp.TotalDeviation = dp.TotalDeviation.Add(deviation)
+	dp.AverageDeviation = dp.TotalDeviation.QuoInt64(int64(dp.Votes))
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+)
+
+// String implements fmt.Stringer interface
+func (f PriceFeed) String() string {
+	out, _ := yaml.Marshal(f)
+	return string(out)
+}
+
+// Validate performs basic validation on the price feed
+func (f PriceFeed) Validate() error {
+	if err := sdk.ValidateDenom(f.Name); err != nil {
+		return fmt.Errorf("invalid price feed name: %w", err)
+	}
+
+	if f.Name == core.MicroLunaDenom {
+		return fmt.Errorf("price feed name must not be %s", core.MicroLunaDenom)
+	}
+
+	if err := sdk.ValidateDenom(f.Quote); err != nil {
+		return fmt.Errorf("invalid quote of price feed %s: %w", f.Name, err)
+	}
+
+	if f.Quote == f.Name {
+		return fmt.Errorf("price feed %s must not be quoted in itself", f.Name)
+	}
+
+	if f.VoteThreshold.IsNil() {
+		return fmt.Errorf("vote threshold of price feed %s must not be nil", f.Name)
+	}
+
+	if !f.VoteThreshold.IsZero() && (f.VoteThreshold.LTE(sdk.NewDecWithPrec(33, 2)) || f.VoteThreshold.GT(sdk.OneDec())) {
+		return fmt.Errorf("vote threshold of price feed %s must be zero or between (0.33, 1]", f.Name)
+	}
+
+	if _, err := GetAggregator(f.Aggregator); err != nil {
+		return fmt.Errorf("price feed %s: %w", f.Name, err)
+	}
+
+	return nil
+}
+
+// PriceFeedList is array of PriceFeed
+type PriceFeedList []PriceFeed
+
+// String implements fmt.Stringer interface
+func (fl PriceFeedList) String() (out string) {
+	for _, f := range fl {
+		out += f.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// Get returns the price feed of the name
+func (fl PriceFeedList) Get(name string) (PriceFeed, bool) {
+	for _, f := range fl {
+		if f.Name == name {
+			return f, true
+		}
+	}
+
+	return PriceFeed{}, false
+}
+
+// IsQuote returns true if the denom is the quote asset of a price feed
+func (fl PriceFeedList) IsQuote(denom string) bool {
+	for _, f := range fl {
+		if f.Quote == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate performs basic validation on the price feeds, which must
+// be unique and distinct from the whitelisted denoms
+func (fl PriceFeedList) Validate(whitelist DenomList) error {
+	names := make(map[string]bool, len(fl))
+	for _, d := range whitelist {
+		names[d.Name] = true
+	}
+
+	for _, f := range fl {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+
+		if names[f.Name] {
+			return fmt.Errorf("duplicated price feed %s", f.Name)
+		}
+
+		names[f.Name] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+)
+
+func TestPriceFeedValidate(t *testing.T) {
+	feed := PriceFeed{Name: "ubtc", Quote: core.MicroUSDDenom, VoteThreshold: sdk.ZeroDec()}
+	require.NoError(t, feed.Validate())
+
+	feed.VoteThreshold = sdk.NewDecWithPrec(67, 2)
+	require.NoError(t, feed.Validate())
+
+	feed.VoteThreshold = sdk.NewDecWithPrec(33, 2)
+	require.Error(t, feed.Validate())
+
+	feed.VoteThreshold = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, feed.Validate())
+
+	feed.VoteThreshold = sdk.Dec{}
+	require.Error(t, feed.Validate())
+
+	// invalid names and quotes
+	feed = PriceFeed{Name: core.MicroLunaDenom, Quote: core.MicroUSDDenom, VoteThreshold: sdk.ZeroDec()}
+	require.Error(t, feed.Validate())
+
+	feed = PriceFeed{Name: "ubtc", Quote: "ubtc", VoteThreshold: sdk.ZeroDec()}
+	require.Error(t, feed.Validate())
+
+	feed = PriceFeed{Name: "ubtc", Quote: "", VoteThreshold: sdk.ZeroDec()}
+	require.Error(t, feed.Validate())
+
+	// unknown aggregator
+	feed = PriceFeed{Name: "ubtc", Quote: core.MicroUSDDenom, VoteThreshold: sdk.ZeroDec(), Aggregator: "mode"}
+	require.Error(t, feed.Validate())
+}
+
+func TestPriceFeedListValidate(t *testing.T) {
+	whitelist := DenomList{{Name: core.MicroKRWDenom, TobinTax: DefaultTobinTax}}
+	feeds := PriceFeedList{
+		{Name: "ubtc", Quote: core.MicroUSDDenom, VoteThreshold: sdk.ZeroDec()},
+		{Name: "ueth", Quote: "ubtc", VoteThreshold: sdk.ZeroDec()},
+	}
+	require.NoError(t, feeds.Validate(whitelist))
+
+	feed, ok := feeds.Get("ueth")
+	require.True(t, ok)
+	require.Equal(t, "ubtc", feed.Quote)
+	_, ok = feeds.Get(core.MicroKRWDenom)
+	require.False(t, ok)
+
+	require.True(t, feeds.IsQuote("ubtc"))
+	require.False(t, feeds.IsQuote("ueth"))
+
+	// duplicated price feed
+	require.Error(t, append(feeds, feeds[0]).Validate(whitelist))
+
+	// price feed of a whitelisted denom
+	require.Error(t, append(feeds, PriceFeed{Name: core.MicroKRWDenom, Quote: core.MicroUSDDenom, VoteThreshold: sdk.ZeroDec()}).Validate(whitelist))
+}
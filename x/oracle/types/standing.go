@@ -0,0 +1,66 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Statuses of a validator standing, by increasing penalty
+const (
+	StandingGood    = "good"
+	StandingWarning = "warning"
+	StandingSlash   = "slash"
+	StandingJail    = "jail"
+)
+
+// NewValidatorStanding returns the penalty the validator faces at the end of the slash window
+// ending at windowEndHeight, with missCounter missed vote periods in the window and slashed
+// in the badWindows previous consecutive windows
+func NewValidatorStanding(params Params, validator sdk.ValAddress, missCounter uint64, badWindows uint64, windowEndHeight int64) ValidatorStanding {
+	// slash_window / vote_period
+	votePeriodsPerWindow := uint64(
+		sdk.NewDec(int64(params.SlashWindow)).
+			QuoInt64(int64(params.VotePeriod)).
+			TruncateInt64(),
+	)
+
+	// Calculate valid vote rate; (SlashWindow - MissCounter)/SlashWindow
+	validVoteRate := sdk.ZeroDec()
+	if votePeriodsPerWindow > missCounter {
+		validVoteRate = sdk.NewDecFromInt(
+			sdk.NewInt(int64(votePeriodsPerWindow - missCounter))).
+			QuoInt64(int64(votePeriodsPerWindow))
+	}
+
+	standing := ValidatorStanding{
+		Validator:            validator.String(),
+		Status:               StandingGood,
+		MissCounter:          missCounter,
+		VotePeriodsPerWindow: votePeriodsPerWindow,
+		ValidVoteRate:        validVoteRate,
+		BadWindows:           badWindows,
+		SlashFraction:        sdk.ZeroDec(),
+		WindowEndHeight:      windowEndHeight,
+	}
+
+	switch {
+	case validVoteRate.GTE(params.WarningValidPerWindow):
+	case validVoteRate.GTE(params.MinValidPerWindow):
+		standing.Status = StandingWarning
+	default:
+		// The slash fraction grows with the consecutive slashed windows
+		slashedWindows := badWindows + 1
+		standing.SlashFraction = sdk.MinDec(params.SlashFraction.MulInt64(int64(slashedWindows)), params.MaxSlashFraction)
+
+		standing.Status = StandingSlash
+		if params.JailAfterWindows != 0 && slashedWindows >= params.JailAfterWindows {
+			standing.Status = StandingJail
+		}
+	}
+
+	return standing
+}
+
+// IsSlashed returns true if the validator is slashed at the end of the slash window
+func (s ValidatorStanding) IsSlashed() bool {
+	return s.Status == StandingSlash || s.Status == StandingJail
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewValidatorStanding(t *testing.T) {
+	params := DefaultParams()
+	params.VotePeriod = 10
+	params.SlashWindow = 1000 // 100 vote periods
+	params.MinValidPerWindow = sdk.NewDecWithPrec(50, 2)
+	params.WarningValidPerWindow = sdk.NewDecWithPrec(80, 2)
+	params.SlashFraction = sdk.NewDecWithPrec(1, 2)
+	params.MaxSlashFraction = sdk.NewDecWithPrec(25, 3)
+	params.JailAfterWindows = 3
+
+	valAddr := sdk.ValAddress([]byte("validator"))
+
+	standing := NewValidatorStanding(params, valAddr, 20, 0, 999)
+	require.Equal(t, StandingGood, standing.Status)
+	require.Equal(t, uint64(100), standing.VotePeriodsPerWindow)
+	require.Equal(t, sdk.NewDecWithPrec(80, 2), standing.ValidVoteRate)
+	require.Equal(t, sdk.ZeroDec(), standing.SlashFraction)
+	require.Equal(t, int64(999), standing.WindowEndHeight)
+	require.False(t, standing.IsSlashed())
+
+	standing = NewValidatorStanding(params, valAddr, 21, 0, 999)
+	require.Equal(t, StandingWarning, standing.Status)
+	require.False(t, standing.IsSlashed())
+
+	standing = NewValidatorStanding(params, valAddr, 50, 0, 999)
+	require.Equal(t, StandingWarning, standing.Status)
+
+	// the slash fraction grows with the consecutive slashed windows
+	standing = NewValidatorStanding(params, valAddr, 51, 0, 999)
+	require.Equal(t, StandingSlash, standing.Status)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), standing.SlashFraction)
+	require.True(t, standing.IsSlashed())
+
+	standing = NewValidatorStanding(params, valAddr, 51, 1, 999)
+	require.Equal(t, StandingSlash, standing.Status)
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), standing.SlashFraction)
+
+	// capped and jailed from the third consecutive slashed window
+	standing = NewValidatorStanding(params, valAddr, 51, 2, 999)
+	require.Equal(t, StandingJail, standing.Status)
+	require.Equal(t, sdk.NewDecWithPrec(25, 3), standing.SlashFraction)
+	require.True(t, standing.IsSlashed())
+
+	// never jailed without JailAfterWindows
+	params.JailAfterWindows = 0
+	standing = NewValidatorStanding(params, valAddr, 200, 10, 999)
+	require.Equal(t, StandingSlash, standing.Status)
+	require.Equal(t, sdk.ZeroDec(), standing.ValidVoteRate)
+	require.Equal(t, sdk.NewDecWithPrec(25, 3), standing.SlashFraction)
+}
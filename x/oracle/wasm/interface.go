@@ -35,9 +35,16 @@ type ExchangeRateQueryParams struct {
 	QuoteDenoms []string `json:"quote_denoms"`
 }
 
+// ExchangeRateTWAPQueryParams query request params for the time weighted average exchange rate
+type ExchangeRateTWAPQueryParams struct {
+	Denom  string `json:"denom"`
+	Window uint64 `json:"window"`
+}
+
 // CosmosQuery custom query interface for oracle querier
 type CosmosQuery struct {
-	ExchangeRates *ExchangeRateQueryParams `json:"exchange_rates,omitempty"`
+	ExchangeRates    *ExchangeRateQueryParams     `json:"exchange_rates,omitempty"`
+	ExchangeRateTWAP *ExchangeRateTWAPQueryParams `json:"exchange_rate_twap,omitempty"`
 }
 
 // ExchangeRatesQueryResponseItem - exchange rates query response item
@@ -52,6 +59,13 @@ type ExchangeRatesQueryResponse struct {
 	BaseDenom     string             `json:"base_denom"`
 }
 
+// ExchangeRateTWAPQueryResponse - time weighted average exchange rate query response for wasm module
+type ExchangeRateTWAPQueryResponse struct {
+	ExchangeRate string `json:"exchange_rate"`
+	Denom        string `json:"denom"`
+	Window       uint64 `json:"window"`
+}
+
 // QueryCustom implements custom query interface
 func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
 	var params CosmosQuery
@@ -62,23 +76,21 @@ func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([
 	}
 
 	if params.ExchangeRates != nil {
-		// LUNA / BASE_DENOM
-		baseDenomExchangeRate, err := querier.keeper.GetLunaExchangeRate(ctx, params.ExchangeRates.BaseDenom)
-		if err != nil {
+		// The base denom must be priced either in Luna or in the quote asset of its price feed
+		if _, _, err := querier.keeper.GetDenomRate(ctx, params.ExchangeRates.BaseDenom); err != nil {
 			return nil, err
 		}
 
 		var items []ExchangeRateItem
 		for _, quoteDenom := range params.ExchangeRates.QuoteDenoms {
-			// LUNA / QUOTE_DENOM
-			quoteDenomExchangeRate, err := querier.keeper.GetLunaExchangeRate(ctx, quoteDenom)
+			// QUOTE_DENOM / BASE_DENOM
+			exchangeRate, err := querier.keeper.GetExchangeRate(ctx, params.ExchangeRates.BaseDenom, quoteDenom)
 			if err != nil {
 				continue
 			}
 
-			// (LUNA / QUOTE_DENOM) / (BASE_DENOM / LUNA) = BASE_DENOM / QUOTE_DENOM
 			items = append(items, ExchangeRateItem{
-				ExchangeRate: quoteDenomExchangeRate.Quo(baseDenomExchangeRate).String(),
+				ExchangeRate: exchangeRate.String(),
 				QuoteDenom:   quoteDenom,
 			})
 		}
@@ -95,5 +107,29 @@ func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([
 		return bz, nil
 	}
 
+	if params.ExchangeRateTWAP != nil {
+		if params.ExchangeRateTWAP.Window == 0 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "window must be positive")
+		}
+
+		// LUNA / DENOM averaged over the window
+		exchangeRate, err := querier.keeper.GetExchangeRateTWAP(ctx, params.ExchangeRateTWAP.Denom, params.ExchangeRateTWAP.Window)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(ExchangeRateTWAPQueryResponse{
+			ExchangeRate: exchangeRate.String(),
+			Denom:        params.ExchangeRateTWAP.Denom,
+			Window:       params.ExchangeRateTWAP.Window,
+		})
+
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+
+		return bz, nil
+	}
+
 	return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown Oracle variant"}
 }
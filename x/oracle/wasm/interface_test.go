@@ -10,6 +10,7 @@ import (
 
 	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/oracle/keeper"
+	"github.com/terra-money/core/x/oracle/types"
 	"github.com/terra-money/core/x/oracle/wasm"
 )
 
@@ -97,3 +98,77 @@ func TestQueryExchangeRates(t *testing.T) {
 		},
 	})
 }
+
+func TestQueryPriceFeedExchangeRates(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroUSDDenom, sdk.NewDec(100))
+	input.OracleKeeper.SetPrice(input.Ctx, types.FeedPrice{Name: "ubtc", Quote: core.MicroUSDDenom, Price: sdk.NewDec(25000)})
+
+	querier := wasm.NewWasmQuerier(input.OracleKeeper)
+
+	queryParams := wasm.ExchangeRateQueryParams{
+		BaseDenom:   "ubtc",
+		QuoteDenoms: []string{core.MicroUSDDenom, core.MicroLunaDenom, core.MicroKRWDenom},
+	}
+	bz, err := json.Marshal(wasm.CosmosQuery{
+		ExchangeRates: &queryParams,
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var exchangeRatesResponse wasm.ExchangeRatesQueryResponse
+	err = json.Unmarshal(res, &exchangeRatesResponse)
+	require.NoError(t, err)
+	require.Equal(t, wasm.ExchangeRatesQueryResponse{
+		BaseDenom: "ubtc",
+		ExchangeRates: []wasm.ExchangeRateItem{
+			{
+				ExchangeRate: sdk.NewDec(25000).String(),
+				QuoteDenom:   core.MicroUSDDenom,
+			},
+			{
+				ExchangeRate: sdk.NewDec(250).String(),
+				QuoteDenom:   core.MicroLunaDenom,
+			},
+		},
+	}, exchangeRatesResponse)
+}
+
+func TestQueryExchangeRateTWAP(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	querier := wasm.NewWasmQuerier(input.OracleKeeper)
+
+	query := func(window uint64) ([]byte, error) {
+		bz, err := json.Marshal(wasm.CosmosQuery{
+			ExchangeRateTWAP: &wasm.ExchangeRateTWAPQueryParams{Denom: core.MicroUSDDenom, Window: window},
+		})
+		require.NoError(t, err)
+
+		return querier.QueryCustom(input.Ctx.WithBlockHeight(20), bz)
+	}
+
+	// no history
+	_, err := query(10)
+	require.Error(t, err)
+
+	// 1 for 5 blocks and 2 for 5 blocks
+	input.OracleKeeper.AddHistoricalExchangeRate(input.Ctx.WithBlockHeight(10), core.MicroUSDDenom, sdk.NewDec(1))
+	input.OracleKeeper.AddHistoricalExchangeRate(input.Ctx.WithBlockHeight(15), core.MicroUSDDenom, sdk.NewDec(2))
+
+	_, err = query(0)
+	require.Error(t, err)
+
+	res, err := query(10)
+	require.NoError(t, err)
+
+	var twapResponse wasm.ExchangeRateTWAPQueryResponse
+	require.NoError(t, json.Unmarshal(res, &twapResponse))
+	require.Equal(t, wasm.ExchangeRateTWAPQueryResponse{
+		ExchangeRate: sdk.NewDecWithPrec(15, 1).String(),
+		Denom:        core.MicroUSDDenom,
+		Window:       10,
+	}, twapResponse)
+}
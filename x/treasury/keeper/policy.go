@@ -4,7 +4,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// UpdateTaxCap updates all denom's tax cap
+// UpdateTaxCap updates all denom's tax cap. The caps are converted at the rates the market
+// swaps at, so while the market MaxRateAge is set, a denom whose exchange rate is older
+// than it keeps its previous cap until the oracle accepts a new rate.
 func (k Keeper) UpdateTaxCap(ctx sdk.Context) sdk.Coins {
 	taxPolicyCap := sdk.NewDecCoinFromCoin(k.TaxPolicy(ctx).Cap)
 	whitelist := k.oracleKeeper.Whitelist(ctx)
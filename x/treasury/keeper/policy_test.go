@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	core "github.com/terra-money/core/types"
+	marketkeeper "github.com/terra-money/core/x/market/keeper"
+	oraclekeeper "github.com/terra-money/core/x/oracle/keeper"
 	oracletypes "github.com/terra-money/core/x/oracle/types"
 	"github.com/terra-money/core/x/treasury/types"
 
@@ -103,3 +105,42 @@ func TestUpdateTaxCap(t *testing.T) {
 	sdrCapAmt := input.TreasuryKeeper.GetParams(input.Ctx).TaxPolicy.Cap.Amount
 	require.Equal(t, krwCap, krwPrice.Quo(sdrPrice).MulInt(sdrCapAmt).TruncateInt())
 }
+
+func TestUpdateTaxCapStaleRate(t *testing.T) {
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper.(oraclekeeper.Keeper)
+	marketKeeper := input.MarketKeeper.(marketkeeper.Keeper)
+
+	oracleKeeper.SetWhitelist(input.Ctx, oracletypes.DenomList{{Name: core.MicroSDRDenom}, {Name: core.MicroKRWDenom}})
+	marketParams := marketKeeper.GetParams(input.Ctx)
+	marketParams.MaxRateAge = 5
+	marketKeeper.SetParams(input.Ctx, marketParams)
+
+	// acceptRate sets the exchange rate as accepted by the oracle at the current height
+	acceptRate := func(denom string, exchangeRate sdk.Dec) {
+		oracleKeeper.SetLunaExchangeRate(input.Ctx, denom, exchangeRate)
+		oracleKeeper.SetExchangeRateStatus(input.Ctx, oracletypes.ExchangeRateStatus{
+			Denom:            denom,
+			ExchangeRate:     exchangeRate,
+			LastUpdateHeight: input.Ctx.BlockHeight(),
+			LastMedian:       exchangeRate,
+		})
+	}
+
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	acceptRate(core.MicroSDRDenom, sdk.NewDecWithPrec(13, 1))
+	acceptRate(core.MicroKRWDenom, sdk.NewDecWithPrec(153412, 2))
+	require.Len(t, input.TreasuryKeeper.UpdateTaxCap(input.Ctx), 1)
+	krwCap := input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroKRWDenom)
+
+	// the krw rate is older than the market MaxRateAge, its cap is left in place
+	input.Ctx = input.Ctx.WithBlockHeight(20)
+	acceptRate(core.MicroSDRDenom, sdk.NewDecWithPrec(26, 1))
+	require.Empty(t, input.TreasuryKeeper.UpdateTaxCap(input.Ctx))
+	require.Equal(t, krwCap, input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroKRWDenom))
+
+	// once the rate is accepted again, the cap follows it
+	acceptRate(core.MicroKRWDenom, sdk.NewDecWithPrec(153412, 2))
+	require.Len(t, input.TreasuryKeeper.UpdateTaxCap(input.Ctx), 1)
+	require.Equal(t, krwCap.QuoRaw(2), input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroKRWDenom))
+}
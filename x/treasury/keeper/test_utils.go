@@ -136,6 +136,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(markettypes.StoreKey)
+	tKeyMarket := sdk.NewTransientStoreKey(markettypes.TStoreKey)
 	keyTreasury := sdk.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -152,6 +153,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyMarket, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
 
 	require.NoError(t, ms.LoadLatestVersion())
@@ -260,7 +262,7 @@ func CreateTestInput(t *testing.T) TestInput {
 
 	marketKeeper := marketkeeper.NewKeeper(
 		appCodec,
-		keyMarket, paramsKeeper.Subspace(markettypes.ModuleName),
+		keyMarket, tKeyMarket, paramsKeeper.Subspace(markettypes.ModuleName),
 		accountKeeper,
 		bankKeeper,
 		oracleKeeper,
@@ -163,6 +163,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
 	keyOracle := sdk.NewKVStoreKey(oracletypes.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(markettypes.StoreKey)
+	tkeyMarket := sdk.NewTransientStoreKey(markettypes.TStoreKey)
 	keyTreasury := sdk.NewKVStoreKey(treasurytypes.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -180,6 +181,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyMarket, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
 
 	require.NoError(t, ms.LoadLatestVersion())
@@ -284,7 +286,7 @@ func CreateTestInput(t *testing.T) TestInput {
 
 	marketKeeper := marketkeeper.NewKeeper(
 		appCodec,
-		keyMarket, paramsKeeper.Subspace(markettypes.ModuleName),
+		keyMarket, tkeyMarket, paramsKeeper.Subspace(markettypes.ModuleName),
 		accountKeeper, bankKeeper, oracleKeeper,
 	)
 	marketKeeper.SetParams(ctx, markettypes.DefaultParams())
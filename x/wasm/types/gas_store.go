@@ -9,6 +9,8 @@ import (
 	stypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/types/rules"
 )
 
 var _ types.KVStore = &Store{}
@@ -16,8 +18,7 @@ var _ types.KVStore = &Store{}
 // KVStore return new gas KVStore which fixed
 // https://github.com/cosmos/cosmos-sdk/issues/10243
 func KVStore(ctx sdk.Context, key sdk.StoreKey) types.KVStore {
-	if (ctx.ChainID() == "bombay-12" && ctx.BlockHeight() < 7_800_000) ||
-		(ctx.ChainID() == "columbus-5" && ctx.BlockHeight() < 6_470_000) {
+	if !rules.IsActive(ctx, rules.WasmGasStoreFix) {
 		return gaskv.NewStore(ctx.MultiStore().GetKVStore(key), ctx.GasMeter(), stypes.KVGasConfig())
 	}
 